@@ -0,0 +1,137 @@
+package jobkit
+
+import (
+	"archive/zip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/blend/go-sdk/ex"
+)
+
+// Artifact is a file collected from the working directory of an invocation.
+type Artifact struct {
+	// Name is the path of the file relative to the working directory.
+	Name string `json:"name"`
+	// Size is the size of the file in bytes.
+	Size int64 `json:"size"`
+	// Contents are the contents of the file.
+	Contents []byte `json:"contents,omitempty"`
+}
+
+// ArtifactsMetadata returns the artifacts without their contents.
+func ArtifactsMetadata(artifacts []Artifact) (output []Artifact) {
+	for _, artifact := range artifacts {
+		output = append(output, Artifact{Name: artifact.Name, Size: artifact.Size})
+	}
+	return
+}
+
+// CollectArtifacts collects the files that match a given set of glob patterns relative to a directory.
+//
+// Files are collected in name order until their total size would exceed the max bytes, at which point the remaining
+// files are returned by name as skipped. Matches that aren't regular files, including symlinks, are ignored,
+// as are matches that resolve outside the directory through a symlinked parent directory.
+func CollectArtifacts(dir string, patterns []string, maxBytes int) (artifacts []Artifact, skipped []string, err error) {
+	dir, err = filepath.Abs(dir)
+	if err != nil {
+		err = ex.New(err)
+		return
+	}
+	var resolvedDir string
+	resolvedDir, err = filepath.EvalSymlinks(dir)
+	if err != nil {
+		err = ex.New(err)
+		return
+	}
+
+	names := map[string]bool{}
+	var matches []string
+	for _, pattern := range patterns {
+		if cleaned := filepath.Clean(pattern); filepath.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+			err = ex.New(ErrArtifactPathInvalid, ex.OptMessagef("pattern: %s", pattern))
+			return
+		}
+		matches, err = filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			err = ex.New(err, ex.OptMessagef("pattern: %s", pattern))
+			return
+		}
+		for _, match := range matches {
+			names[match] = true
+		}
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	var total int64
+	var info os.FileInfo
+	var resolved, rel string
+	var contents []byte
+	for _, name := range sorted {
+		info, err = os.Lstat(name)
+		if err != nil {
+			err = ex.New(err)
+			return
+		}
+		if !info.Mode().IsRegular() {
+			continue
+		}
+		resolved, err = filepath.EvalSymlinks(name)
+		if err != nil {
+			err = ex.New(err)
+			return
+		}
+		if !strings.HasPrefix(resolved, resolvedDir+string(filepath.Separator)) {
+			continue
+		}
+		rel, err = filepath.Rel(dir, name)
+		if err != nil {
+			err = ex.New(err)
+			return
+		}
+		rel = filepath.ToSlash(rel)
+		if maxBytes > 0 && total+info.Size() > int64(maxBytes) {
+			skipped = append(skipped, rel)
+			continue
+		}
+		contents, err = ioutil.ReadFile(resolved)
+		if err != nil {
+			err = ex.New(err)
+			return
+		}
+		total += int64(len(contents))
+		artifacts = append(artifacts, Artifact{Name: rel, Size: int64(len(contents)), Contents: contents})
+	}
+	return
+}
+
+// WriteArtifactsZip writes artifacts to a zip archive.
+func WriteArtifactsZip(w io.Writer, artifacts []Artifact) error {
+	archive := zip.NewWriter(w)
+	for _, artifact := range artifacts {
+		f, err := archive.Create(artifact.Name)
+		if err != nil {
+			return ex.New(err)
+		}
+		if _, err = f.Write(artifact.Contents); err != nil {
+			return ex.New(err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return ex.New(err)
+	}
+	return nil
+}
+
+// Artifact errors.
+const (
+	ErrArtifactPathInvalid ex.Class = "artifacts; path must be relative to the working directory"
+)
@@ -0,0 +1,89 @@
+package jobkit
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/ex"
+)
+
+func TestCollectArtifacts(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "jobkit-test-")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+
+	assert.Nil(os.Mkdir(filepath.Join(dir, "out"), 0755))
+	assert.Nil(os.Mkdir(filepath.Join(dir, "out", "nested.log"), 0755))
+	assert.Nil(ioutil.WriteFile(filepath.Join(dir, "out", "a.log"), []byte("aaaa"), 0644))
+	assert.Nil(ioutil.WriteFile(filepath.Join(dir, "out", "b.log"), []byte("bbbbbbbb"), 0644))
+	assert.Nil(ioutil.WriteFile(filepath.Join(dir, "out", "c.log"), []byte("cc"), 0644))
+	assert.Nil(os.Symlink(filepath.Join(dir, "out", "a.log"), filepath.Join(dir, "out", "link.log")))
+
+	artifacts, skipped, err := CollectArtifacts(dir, []string{"out/*.log", "out/a.log"}, 8)
+	assert.Nil(err)
+	assert.Len(artifacts, 2)
+	assert.Equal("out/a.log", artifacts[0].Name)
+	assert.Equal("aaaa", string(artifacts[0].Contents))
+	assert.Equal("out/c.log", artifacts[1].Name)
+	assert.Equal([]string{"out/b.log"}, skipped)
+
+	artifacts, skipped, err = CollectArtifacts(dir, []string{"out/*.log"}, 0)
+	assert.Nil(err)
+	assert.Len(artifacts, 3)
+	assert.Empty(skipped)
+}
+
+func TestCollectArtifactsSymlinkedDir(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "jobkit-test-")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+	outside, err := ioutil.TempDir("", "jobkit-test-outside-")
+	assert.Nil(err)
+	defer os.RemoveAll(outside)
+
+	assert.Nil(ioutil.WriteFile(filepath.Join(outside, "secret.log"), []byte("secret"), 0644))
+	assert.Nil(os.Mkdir(filepath.Join(dir, "out"), 0755))
+	assert.Nil(ioutil.WriteFile(filepath.Join(dir, "out", "a.log"), []byte("aaaa"), 0644))
+	assert.Nil(os.Symlink(outside, filepath.Join(dir, "escape")))
+	assert.Nil(os.Symlink(filepath.Join(dir, "out"), filepath.Join(dir, "inside")))
+
+	artifacts, skipped, err := CollectArtifacts(dir, []string{"escape/*.log", "inside/*.log"}, 0)
+	assert.Nil(err)
+	assert.Empty(skipped)
+	assert.Len(artifacts, 1, "files through a symlinked directory outside the working directory are ignored")
+	assert.Equal("inside/a.log", artifacts[0].Name)
+}
+
+func TestCollectArtifactsInvalidPath(t *testing.T) {
+	assert := assert.New(t)
+
+	_, _, err := CollectArtifacts(".", []string{"../*"}, 0)
+	assert.True(ex.Is(err, ErrArtifactPathInvalid))
+	_, _, err = CollectArtifacts(".", []string{"/etc/*"}, 0)
+	assert.True(ex.Is(err, ErrArtifactPathInvalid))
+}
+
+func TestWriteArtifactsZip(t *testing.T) {
+	assert := assert.New(t)
+
+	buffer := new(bytes.Buffer)
+	assert.Nil(WriteArtifactsZip(buffer, []Artifact{
+		{Name: "out/a.log", Size: 4, Contents: []byte("aaaa")},
+		{Name: "b.log", Size: 2, Contents: []byte("bb")},
+	}))
+
+	archive, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	assert.Nil(err)
+	assert.Len(archive.File, 2)
+	assert.Equal("out/a.log", archive.File[0].Name)
+	assert.Equal("b.log", archive.File[1].Name)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/blend/go-sdk/slack"
 	"github.com/blend/go-sdk/stats"
 	"github.com/blend/go-sdk/stringutil"
+	"github.com/blend/go-sdk/web"
 
 	"github.com/blend/jobkit"
 )
@@ -84,9 +85,10 @@ func main() {
 
 type config struct {
 	jobkit.Config `yaml:",inline"`
-	DisablePProf  *bool              `yaml:"disablePProf"`
-	DisableServer *bool              `yaml:"disableServer"`
-	Jobs          []jobkit.JobConfig `yaml:"jobs"`
+	DisablePProf  *bool                   `yaml:"disablePProf"`
+	DisableServer *bool                   `yaml:"disableServer"`
+	Jobs          []jobkit.JobConfig      `yaml:"jobs"`
+	Pipelines     []jobkit.PipelineConfig `yaml:"pipelines"`
 }
 
 func (c *config) Resolve(ctx context.Context) error {
@@ -248,14 +250,16 @@ func run(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return err
 		}
-		log.Listen(logger.Error, "sentry", logger.NewErrorEventListener(sentryClient.Notify))
-		log.Listen(logger.Fatal, "sentry", logger.NewErrorEventListener(sentryClient.Notify))
+		log.Listen(logger.Error, "sentry", jobkit.NewSentryErrorListener(sentryClient))
+		log.Listen(logger.Fatal, "sentry", jobkit.NewSentryErrorListener(sentryClient))
 		log.Infof("adding sentry error collection")
 	}
 
 	var historyProvider jobkit.HistoryProvider
+	var pipelineHistoryProvider jobkit.PipelineHistoryProvider
+	var conn *db.Connection
 	if !cfg.DB.IsZero() {
-		conn, err := db.New(db.OptConfig(cfg.DB))
+		conn, err = db.New(db.OptConfig(cfg.DB))
 		if err != nil {
 			return err
 		}
@@ -273,20 +277,40 @@ func run(cmd *cobra.Command, args []string) error {
 		if err := historyProvider.Initialize(context.Background()); err != nil {
 			return err
 		}
+		pipelineHistoryProvider = &jobkit.PipelineHistoryPostgres{
+			Conn: conn,
+		}
+		if err := pipelineHistoryProvider.Initialize(context.Background()); err != nil {
+			return err
+		}
 	} else {
 		log.Infof("using memory history provider")
 		historyProvider = new(jobkit.HistoryMemory)
 		if err := historyProvider.Initialize(context.Background()); err != nil {
 			return err
 		}
+		pipelineHistoryProvider = new(jobkit.PipelineHistoryMemory)
+		if err := pipelineHistoryProvider.Initialize(context.Background()); err != nil {
+			return err
+		}
 	}
 
+	notifications := jobkit.NewNotificationsDispatcher(
+		jobkit.OptNotificationsDispatcherConfig(cfg.Notifications),
+		jobkit.OptNotificationsDispatcherLog(log.WithPath("notifications")),
+		jobkit.OptNotificationsDispatcherStats(statsClient),
+	)
+
 	jobs := cron.New(
 		cron.OptLog(log.WithPath("cron")),
 	)
+	triggers := jobkit.NewJobTriggers(
+		jobkit.OptJobTriggersJobManager(jobs),
+		jobkit.OptJobTriggersLog(log.WithPath("triggers")),
+	)
 
 	for _, jobCfg := range cfg.Jobs {
-		job, err := createJobFromConfig(cfg, jobCfg, jobs.Log, historyProvider)
+		job, err := createJobFromConfig(cfg, jobCfg, jobs.Log, historyProvider, conn)
 		if err != nil {
 			return err
 		}
@@ -295,9 +319,41 @@ func run(cmd *cobra.Command, args []string) error {
 		job.SlackClient = slackClient
 		job.StatsClient = statsClient
 		job.SentryClient = sentryClient
+		job.NotificationsDispatcher = notifications
+		job.Triggers = triggers
+		if err = triggers.Add(jobCfg.Name, jobCfg.Triggers...); err != nil {
+			return err
+		}
 
-		log.Infof("loading job `%s` with exec: %s", jobCfg.Name, ansi.ColorLightWhite.Apply(strings.Join(jobCfg.Exec, " ")))
-		log.Infof("loading job `%s` with schedule: %s", jobCfg.Name, ansi.ColorLightWhite.Apply(jobCfg.ScheduleOrDefault()))
+		if len(jobCfg.Steps) > 0 {
+			log.Infof("loading job `%s` with steps: %s", jobCfg.Name, ansi.ColorLightWhite.Apply(fmt.Sprint(len(jobCfg.Steps))))
+		} else if !jobCfg.HTTP.IsZero() {
+			log.Infof("loading job `%s` with http: %s", jobCfg.Name, ansi.ColorLightWhite.Apply(jobCfg.HTTP.MethodOrDefault()+" "+jobCfg.HTTP.URL))
+		} else if !jobCfg.SQL.IsZero() {
+			log.Infof("loading job `%s` with sql", jobCfg.Name)
+		} else if jobCfg.Script != "" {
+			log.Infof("loading job `%s` with script: %s", jobCfg.Name, ansi.ColorLightWhite.Apply(strings.Join(jobCfg.InterpreterOrDefault(), " ")))
+		} else {
+			log.Infof("loading job `%s` with exec: %s", jobCfg.Name, ansi.ColorLightWhite.Apply(strings.Join(jobCfg.Exec, " ")))
+		}
+		if isTriggeredOnly(jobCfg) {
+			log.Infof("loading job `%s` with schedule: %s", jobCfg.Name, ansi.ColorLightWhite.Apply("triggers only"))
+		} else {
+			log.Infof("loading job `%s` with schedule: %s", jobCfg.Name, ansi.ColorLightWhite.Apply(jobCfg.ScheduleOrDefault()))
+		}
+		for _, trigger := range jobCfg.Triggers {
+			log.Infof("loading job `%s` with trigger: after %s on %s", jobCfg.Name, ansi.ColorLightWhite.Apply(strings.Join(trigger.After, ", ")), trigger.OnOrDefault())
+		}
+		log.Infof("loading job `%s` with concurrency policy: %s", jobCfg.Name, ansi.ColorLightWhite.Apply(jobCfg.Concurrency.PolicyOrDefault()))
+		if !jobCfg.Lock.IsZero() {
+			log.Infof("loading job `%s` with lock: %s `%s`, %s on contention", jobCfg.Name, jobCfg.Lock.Provider, ansi.ColorLightWhite.Apply(jobCfg.Lock.KeyOrDefault(jobCfg.Name)), jobCfg.Lock.OnContentionOrDefault())
+		}
+		if policy := jobCfg.Misfire.PolicyOrDefault(); policy != jobkit.JobMisfirePolicyIgnore {
+			log.Infof("loading job `%s` with misfire policy: %s", jobCfg.Name, ansi.ColorLightWhite.Apply(policy))
+		}
+		if maxAttempts := jobCfg.Retry.MaxAttemptsOrDefault(); maxAttempts > 1 {
+			log.Infof("loading job `%s` with retries: %s attempts, %s backoff from %v", jobCfg.Name, ansi.ColorLightWhite.Apply(fmt.Sprint(maxAttempts)), jobCfg.Retry.BackoffOrDefault(), jobCfg.Retry.WaitOrDefault())
+		}
 		if !jobCfg.HistoryDisabledOrDefault() {
 			log.Infof("loading job `%s` with history: enabled", jobCfg.Name)
 		} else {
@@ -322,9 +378,76 @@ func run(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	hosted := []graceful.Graceful{jobs}
+	for _, jobCfg := range cfg.Jobs {
+		for _, trigger := range jobCfg.Triggers {
+			for _, after := range trigger.After {
+				if _, ok := jobs.Jobs[after]; !ok {
+					return ex.New("job trigger runs after a job that isn't loaded", ex.OptMessagef("job: %s; after: %s", jobCfg.Name, after))
+				}
+			}
+		}
+	}
+
+	var pipelines []*jobkit.Pipeline
+	for _, pipelineCfg := range cfg.Pipelines {
+		pipeline, err := jobkit.NewPipeline(pipelineCfg,
+			jobkit.OptPipelineJobManager(jobs),
+			jobkit.OptPipelineLog(log.WithPath("pipelines")),
+			jobkit.OptPipelineHistory(pipelineHistoryProvider),
+		)
+		if err != nil {
+			return err
+		}
+		for _, stage := range pipelineCfg.Stages {
+			if _, ok := jobs.Jobs[stage.Job]; !ok {
+				return ex.New("pipeline stage runs a job that isn't loaded", ex.OptMessagef("pipeline: %s; stage: %s; job: %s", pipelineCfg.Name, stage.NameOrDefault(), stage.Job))
+			}
+		}
+		log.Infof("loading pipeline `%s` with stages: %s", pipelineCfg.Name, ansi.ColorLightWhite.Apply(fmt.Sprint(len(pipelineCfg.Stages))))
+		pipelines = append(pipelines, pipeline)
+	}
+
+	hosted := []graceful.Graceful{notifications}
+	var leader *jobkit.LeaderElector
+	if cfg.LeaderElection.EnabledOrDefault() {
+		if err := cfg.LeaderElection.Validate(); err != nil {
+			return err
+		}
+		lock, err := jobkit.NewLock(cfg.LeaderElection.ProviderOrDefault(), cfg.LeaderElection.KeyOrDefault(), cfg.LeaderElection.PathOrDefault(), conn, cfg.LeaderElection.LeaseOrDefault())
+		if err != nil {
+			return err
+		}
+		leader = jobkit.NewLeaderElector(
+			jobkit.OptLeaderElectorConfig(cfg.LeaderElection),
+			jobkit.OptLeaderElectorLock(lock),
+			jobkit.OptLeaderElectorLog(log.WithPath("leader election")),
+			jobkit.OptLeaderElectorOnElected(func(ctx context.Context) error {
+				if err := jobs.StartAsync(); err != nil {
+					return err
+				}
+				// run the scheduled runs the jobs missed while no replica was the leader.
+				jobkit.CatchUpMissedRuns(ctx, jobs)
+				return nil
+			}),
+			jobkit.OptLeaderElectorOnDemoted(func(_ context.Context) error {
+				return jobs.Stop()
+			}),
+		)
+		log.Infof("using leader election: %s lock `%s` with lease %v", cfg.LeaderElection.ProviderOrDefault(), ansi.ColorLightWhite.Apply(cfg.LeaderElection.KeyOrDefault()), cfg.LeaderElection.LeaseOrDefault())
+		hosted = append(hosted, leader)
+	} else {
+		// run the scheduled runs the jobs missed while the process was down, once the jobs have started.
+		started := jobs.Latch.NotifyStarted()
+		go func() {
+			<-started
+			jobkit.CatchUpMissedRuns(context.Background(), jobs)
+		}()
+		hosted = append(hosted, jobs)
+	}
+
 	if cfg.DisableServer == nil || (cfg.DisableServer != nil && !*cfg.DisableServer) {
-		ws := jobkit.NewServer(jobs, cfg.Config)
+		ws := web.MustNew(web.OptConfig(cfg.Web))
+		ws.Register(jobkit.ManagementServer{Cron: jobs, Pipelines: pipelines, Leader: leader, Config: cfg.Config})
 		if cfg.Config.UseViewFilesOrDefault() {
 			log.Debugf("using view files loaded from disk")
 		}
@@ -345,24 +468,29 @@ func createDefaultJobConfig(ctx context.Context, args ...string) (*defaultJobCon
 	return cfg, nil
 }
 
-func createJobFromConfig(base config, cfg jobkit.JobConfig, log logger.Log, historyProvider jobkit.HistoryProvider) (*jobkit.Job, error) {
-	if len(cfg.Exec) == 0 {
-		return nil, ex.New("job exec and command unset", ex.OptMessagef("job: %s", cfg.Name))
+func createJobFromConfig(base config, cfg jobkit.JobConfig, log logger.Log, historyProvider jobkit.HistoryProvider, conn *db.Connection) (*jobkit.Job, error) {
+	action, err := createJobActionFromConfig(cfg, log, conn)
+	if err != nil {
+		return nil, err
+	}
+	options := []jobkit.JobOption{
+		jobkit.OptJobConfig(cfg),
+		jobkit.OptJobLog(log),
+		jobkit.OptJobHistory(historyProvider),
+	}
+	if !isTriggeredOnly(cfg) {
+		options = append(options, jobkit.OptJobParsedSchedule(cfg.ScheduleOrDefault()))
+	}
+	if !cfg.Lock.IsZero() {
+		options = append(options, jobkit.OptJobLockConn(conn))
 	}
-	action := jobkit.NewShellAction(cfg.Exec,
-		jobkit.OptShellActionConfig(cfg.ShellActionConfig),
-		jobkit.OptShellActionLog(log),
-	)
 	job, err := jobkit.NewJob(
 		cron.NewJob(
 			cron.OptJobName(cfg.Name),
-			cron.OptJobAction(action.Execute),
+			cron.OptJobAction(action),
 			cron.OptJobConfig(cfg.JobConfig),
 		),
-		jobkit.OptJobConfig(cfg),
-		jobkit.OptJobParsedSchedule(cfg.ScheduleOrDefault()),
-		jobkit.OptJobLog(log),
-		jobkit.OptJobHistory(historyProvider),
+		options...,
 	)
 	if err != nil {
 		return nil, err
@@ -373,6 +501,83 @@ func createJobFromConfig(base config, cfg jobkit.JobConfig, log logger.Log, hist
 	return job, nil
 }
 
+// isTriggeredOnly returns if a job only runs from its triggers, i.e. it has triggers and no schedule.
+func isTriggeredOnly(cfg jobkit.JobConfig) bool {
+	return len(cfg.Triggers) > 0 && cfg.Schedule == ""
+}
+
+func createJobActionFromConfig(cfg jobkit.JobConfig, log logger.Log, conn *db.Connection) (func(context.Context) error, error) {
+	if len(cfg.Steps) == 0 {
+		return createActionFromConfig(cfg.Name, cfg.ShellActionConfig, cfg.HTTP, cfg.SQL, log, conn)
+	}
+	if len(cfg.Exec) > 0 || cfg.Script != "" || !cfg.HTTP.IsZero() || !cfg.SQL.IsZero() {
+		return nil, ex.New("job steps and exec, script, http or sql are mutually exclusive", ex.OptMessagef("job: %s", cfg.Name))
+	}
+	var steps []jobkit.WorkflowStep
+	for index, stepCfg := range cfg.Steps {
+		name := stepCfg.NameOrDefault(index)
+		action, err := createActionFromConfig(fmt.Sprintf("%s; step: %s", cfg.Name, name), stepCfg.ShellActionConfig, stepCfg.HTTP, stepCfg.SQL, log, conn)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, jobkit.WorkflowStep{
+			Name:            name,
+			Timeout:         stepCfg.TimeoutOrDefault(),
+			ContinueOnError: stepCfg.ContinueOnErrorOrDefault(),
+			Action:          action,
+		})
+	}
+	return jobkit.NewWorkflowAction(
+		jobkit.OptWorkflowActionSteps(steps...),
+		jobkit.OptWorkflowActionLog(log),
+	).Execute, nil
+}
+
+func createActionFromConfig(name string, shellCfg jobkit.ShellActionConfig, httpCfg jobkit.HTTPActionConfig, sqlCfg jobkit.SQLActionConfig, log logger.Log, conn *db.Connection) (func(context.Context) error, error) {
+	var actions int
+	for _, isSet := range []bool{len(shellCfg.Exec) > 0 || shellCfg.Script != "", !httpCfg.IsZero(), !sqlCfg.IsZero()} {
+		if isSet {
+			actions++
+		}
+	}
+	if actions == 0 {
+		return nil, ex.New("job exec, script, http and sql unset", ex.OptMessagef("job: %s", name))
+	}
+	if actions > 1 {
+		return nil, ex.New("job exec or script, http and sql are mutually exclusive", ex.OptMessagef("job: %s", name))
+	}
+
+	if !httpCfg.IsZero() {
+		return jobkit.NewHTTPAction(
+			jobkit.OptHTTPActionConfig(httpCfg),
+			jobkit.OptHTTPActionLog(log),
+		).Execute, nil
+	}
+	if !sqlCfg.IsZero() {
+		if !sqlCfg.DB.IsZero() {
+			var err error
+			if conn, err = db.New(db.OptConfig(sqlCfg.DB)); err != nil {
+				return nil, err
+			}
+			if err = conn.Open(); err != nil {
+				return nil, err
+			}
+		}
+		if conn == nil {
+			return nil, ex.New("job sql db unset, and no jobkit db is configured", ex.OptMessagef("job: %s", name))
+		}
+		return jobkit.NewSQLAction(
+			jobkit.OptSQLActionConfig(sqlCfg),
+			jobkit.OptSQLActionConn(conn),
+			jobkit.OptSQLActionLog(log),
+		).Execute, nil
+	}
+	return jobkit.NewShellAction(shellCfg.Exec,
+		jobkit.OptShellActionConfig(shellCfg),
+		jobkit.OptShellActionLog(log),
+	).Execute, nil
+}
+
 func fatalExit(action func(*cobra.Command, []string) error) func(*cobra.Command, []string) {
 	return func(parent *cobra.Command, args []string) {
 		if err := action(parent, args); err != nil {
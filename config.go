@@ -34,10 +34,14 @@ type Config struct {
 	Datadog datadog.Config `yaml:"datadog"`
 	// Slack configures the slack webhook sender.
 	Slack slack.Config `yaml:"slack"`
+	// Notifications configures the shared notifications dispatcher.
+	Notifications NotificationsConfig `yaml:"notifications"`
 	// Sentry confgures the sentry error collector.
 	Sentry sentry.Config `yaml:"sentry"`
 	// DB controls database connections for the job manager.
 	DB db.Config `yaml:"db"`
+	// LeaderElection elects one of a group of replicas to run jobs.
+	LeaderElection LeaderElectionConfig `yaml:"leaderElection"`
 }
 
 // Resolve applies resolution steps to the config.
@@ -1,5 +1,7 @@
 package jobkit
 
+import "time"
+
 // Constants and Defaults
 const (
 	DefaultMaxLogBytes   = 10 * (1 << 10)
@@ -7,6 +9,9 @@ const (
 	DefaultSkipExpandEnv = false
 	DefaultDiscardOutput = false
 	DefaultHideOutput    = false
+	DefaultLogOutputJSON = false
+
+	DefaultLogOutputMaxLineBytes = 64 * (1 << 10)
 
 	DefaultHistoryMaxCount = 256
 	DefaultHistoryMaxAge   = 0
@@ -15,4 +20,54 @@ const (
 	DefaultHistoryPersistenceDisabled = false
 
 	DefaultSchedule = "* */1 * * * * *"
+
+	DefaultNotificationsParallelism = 4
+
+	DefaultSentryOutputTailBytes = 4 * (1 << 10)
+	DefaultStderrTailBytes       = 4 * (1 << 10)
+
+	DefaultTerminationSignal      = "SIGTERM"
+	DefaultTerminationGracePeriod = 10 * time.Second
+
+	DefaultArtifactsMaxBytes = 10 * (1 << 20)
+
+	DefaultHTTPActionTimeout          = 30 * time.Second
+	DefaultHTTPActionMaxResponseBytes = 1 << 20
+	DefaultHTTPActionOutputBodyBytes  = 4 * (1 << 10)
+
+	DefaultSQLActionTransaction = true
+
+	DefaultWorkflowStepContinueOnError = false
+
+	DefaultJobTriggerOn             = JobTriggerOnSuccess
+	DefaultJobTriggerPassParameters = true
+
+	DefaultJobRetryMaxAttempts = 1
+	DefaultJobRetryWait        = 5 * time.Second
+	DefaultJobRetryBackoff     = JobRetryBackoffConstant
+
+	DefaultJobConcurrencyPolicy        = JobConcurrencyPolicySkip
+	DefaultJobConcurrencyMaxQueueDepth = 1
+	DefaultJobConcurrencyMaxParallel   = 1
+	DefaultJobConcurrencyMaxSkipped    = 10000
+
+	DefaultJobMisfirePolicy  = JobMisfirePolicyIgnore
+	DefaultJobMisfireMaxRuns = 10
+
+	DefaultLeaderElectionEnabled  = false
+	DefaultLeaderElectionProvider = LockProviderPostgres
+	DefaultLeaderElectionKey      = "jobkit"
+	DefaultLeaderElectionLease    = 15 * time.Second
+
+	DefaultJobLockKeyPrefix      = "jobkit.job."
+	DefaultJobLockOnContention   = JobLockOnContentionSkip
+	DefaultJobLockAcquireTimeout = 30 * time.Second
+	DefaultJobLockRetryWait      = time.Second
+	DefaultJobLockLease          = 15 * time.Second
 )
+
+// DefaultInterpreter is the default interpreter for shell action scripts.
+var DefaultInterpreter = []string{"bash", "-euo", "pipefail"}
+
+// DefaultSuccessExitCodes are the default exit codes that mark a shell action as successful.
+var DefaultSuccessExitCodes = []int{0}
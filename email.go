@@ -23,6 +23,9 @@ func NewEmailMessage(flag string, emailDefaults email.Message, ji *JobInvocation
 	}
 	if ji.JobInvocationOutput.Output != nil && len(ji.JobInvocationOutput.Output.Chunks) > 0 {
 		vars["output"] = ji.JobInvocationOutput.Output.String()
+		if stderrTail := ji.JobInvocationOutput.OutputTail(OutputStreamStderr, DefaultStderrTailBytes); stderrTail != "" {
+			vars["stderrTail"] = stderrTail
+		}
 	}
 
 	var err error
@@ -77,6 +80,10 @@ const (
 	<pre>{{ .Var "err" }}</pre>
 	{{ end }}
 	</div>
+	{{ if .HasVar "stderrTail" }}
+	<h4>Stderr (tail)</h4>
+	<pre>{{ .Var "stderrTail" }}</pre>
+	{{ end }}
 	{{ if .Var "output" }}
 	<h4>Output</h4>
 	<pre>{{ .Var "output" }}</pre>
@@ -91,6 +98,8 @@ const (
 Elapsed: {{ .Var "elapsed" }}
 {{ end }}
 {{ if .HasVar "err" }}Error: {{ .Var "err" }}{{end}}
+{{ if .HasVar "stderrTail" }}Stderr (tail):
+{{ .Var "stderrTail" }}{{end}}
 {{ if .HasVar "output" }}Output:
 {{ .Var "output" }}{{end}}
 `
@@ -1,6 +1,7 @@
 package jobkit
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -52,3 +53,34 @@ func TestNewEmailMessage(t *testing.T) {
 	assert.Contains(message.TextBody, "this is another test")
 	assert.Contains(message.TextBody, "1ms")
 }
+
+func TestNewEmailMessageStderrTail(t *testing.T) {
+	assert := assert.New(t)
+
+	jio := NewJobInvocationOutput()
+	fmt.Fprint(jio.StreamWriter(OutputStreamStdout), "this is stdout\n")
+	fmt.Fprint(jio.StreamWriter(OutputStreamStderr), "this is stderr\n")
+
+	message, err := NewEmailMessage(cron.FlagErrored, email.Message{}, &JobInvocation{
+		JobInvocation: cron.JobInvocation{
+			JobName: "test",
+			Status:  cron.JobInvocationStatusErrored,
+		},
+		JobInvocationOutput: *jio,
+	})
+	assert.Nil(err)
+	assert.Contains(message.TextBody, "Stderr (tail):\nthis is stderr")
+	assert.Contains(message.HTMLBody, "Stderr (tail)")
+
+	jio = NewJobInvocationOutput()
+	fmt.Fprint(jio.StreamWriter(OutputStreamStdout), "this is stdout\n")
+	message, err = NewEmailMessage(cron.FlagComplete, email.Message{}, &JobInvocation{
+		JobInvocation: cron.JobInvocation{
+			JobName: "test",
+			Status:  cron.JobInvocationStatusSuccess,
+		},
+		JobInvocationOutput: *jio,
+	})
+	assert.Nil(err)
+	assert.NotContains(message.TextBody, "Stderr")
+}
@@ -0,0 +1,55 @@
+package jobkit
+
+import (
+	"os"
+	"time"
+)
+
+// Termination paths for processes whose invocation was cancelled.
+const (
+	// TerminationGraceful is set if the process group exited within the grace period after the termination signal.
+	TerminationGraceful = "graceful"
+	// TerminationKilled is set if the process group was killed after the grace period elapsed.
+	TerminationKilled = "killed"
+)
+
+// NewExitInfo returns the exit info for a completed process.
+// It returns nil if the process state is unset, i.e. if the process failed to start.
+func NewExitInfo(state *os.ProcessState) *ExitInfo {
+	if state == nil {
+		return nil
+	}
+	return &ExitInfo{
+		PID:        state.Pid(),
+		ExitCode:   state.ExitCode(),
+		Signal:     exitSignal(state),
+		UserTime:   state.UserTime(),
+		SystemTime: state.SystemTime(),
+		MaxRSS:     exitMaxRSS(state),
+	}
+}
+
+// ExitInfo is structured information about how a process exited.
+type ExitInfo struct {
+	// PID is the process id of the exited process.
+	PID int `json:"pid"`
+	// ExitCode is the exit code of the process, or -1 if it was terminated by a signal.
+	ExitCode int `json:"exitCode"`
+	// Signal is the name of the signal that terminated the process, if any.
+	Signal string `json:"signal,omitempty"`
+	// UserTime is the user cpu time of the process.
+	UserTime time.Duration `json:"userTime"`
+	// SystemTime is the system cpu time of the process.
+	SystemTime time.Duration `json:"systemTime"`
+	// MaxRSS is the maximum resident set size of the process in bytes.
+	MaxRSS int64 `json:"maxRSS"`
+	// Termination is how the process was terminated if the invocation was cancelled.
+	Termination string `json:"termination,omitempty"`
+	// TerminationSignal is the signal sent to the process group if the invocation was cancelled.
+	TerminationSignal string `json:"terminationSignal,omitempty"`
+}
+
+// Signaled returns if the process was terminated by a signal.
+func (ei ExitInfo) Signaled() bool {
+	return ei.Signal != ""
+}
@@ -0,0 +1,14 @@
+//go:build windows || plan9
+// +build windows plan9
+
+package jobkit
+
+import "os"
+
+func exitSignal(_ *os.ProcessState) string {
+	return ""
+}
+
+func exitMaxRSS(_ *os.ProcessState) int64 {
+	return 0
+}
@@ -0,0 +1,28 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package jobkit
+
+import (
+	"os"
+	"runtime"
+	"syscall"
+)
+
+func exitSignal(state *os.ProcessState) string {
+	if status, ok := state.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return status.Signal().String()
+	}
+	return ""
+}
+
+func exitMaxRSS(state *os.ProcessState) int64 {
+	if rusage, ok := state.SysUsage().(*syscall.Rusage); ok && rusage != nil {
+		// darwin reports max rss in bytes, everything else in kilobytes.
+		if runtime.GOOS == "darwin" {
+			return int64(rusage.Maxrss)
+		}
+		return int64(rusage.Maxrss) << 10
+	}
+	return 0
+}
@@ -17,3 +17,12 @@ func ExpandParameters(ji *cron.JobInvocation) func(string) string {
 		return os.Getenv(name)
 	}
 }
+
+// TemplateVars returns the invocation parameters as template vars, e.g. for `{{ .Var "name" }}`.
+func TemplateVars(ji *cron.JobInvocation) map[string]interface{} {
+	vars := make(map[string]interface{}, len(ji.Parameters))
+	for key, value := range ji.Parameters {
+		vars[key] = value
+	}
+	return vars
+}
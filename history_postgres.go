@@ -42,18 +42,90 @@ func (h *HistoryPostgres) Initialize(ctx context.Context) error {
 			),
 			migration.OptGroupTx(h.Tx),
 		),
+		migration.NewGroupWithAction(
+			migration.ColumnNotExists("job_invocations", "exit_info"),
+			migration.Statements(
+				`alter table job_invocations add exit_info json`,
+			),
+			migration.OptGroupTx(h.Tx),
+		),
+		migration.NewGroupWithAction(
+			migration.ColumnNotExists("job_invocations", "artifacts"),
+			migration.Statements(
+				`alter table job_invocations add artifacts json`,
+			),
+			migration.OptGroupTx(h.Tx),
+		),
+		migration.NewGroupWithAction(
+			migration.ColumnNotExists("job_invocations", "output_chunks"),
+			migration.Statements(
+				`alter table job_invocations add output_chunks json`,
+			),
+			migration.OptGroupTx(h.Tx),
+		),
+		migration.NewGroupWithAction(
+			migration.ColumnNotExists("job_invocations", "http_summary"),
+			migration.Statements(
+				`alter table job_invocations add http_summary json`,
+			),
+			migration.OptGroupTx(h.Tx),
+		),
+		migration.NewGroupWithAction(
+			migration.ColumnNotExists("job_invocations", "sql_summary"),
+			migration.Statements(
+				`alter table job_invocations add sql_summary json`,
+			),
+			migration.OptGroupTx(h.Tx),
+		),
+		migration.NewGroupWithAction(
+			migration.ColumnNotExists("job_invocations", "steps"),
+			migration.Statements(
+				`alter table job_invocations add steps json`,
+			),
+			migration.OptGroupTx(h.Tx),
+		),
+		migration.NewGroupWithAction(
+			migration.ColumnNotExists("job_invocations", "attempts"),
+			migration.Statements(
+				`alter table job_invocations add attempts json`,
+			),
+			migration.OptGroupTx(h.Tx),
+		),
+		migration.NewGroupWithAction(
+			migration.ColumnNotExists("job_invocations", "job_lock"),
+			migration.Statements(
+				`alter table job_invocations add job_lock json`,
+			),
+			migration.OptGroupTx(h.Tx),
+		),
 	).Apply(ctx, h.Conn)
 }
 
 type jobInvocationRow struct {
-	ID         uuid.UUID         `db:"id,pk"`
-	JobName    string            `db:"job_name"`
-	Started    time.Time         `db:"started"`
-	Complete   time.Time         `db:"complete"`
-	Status     string            `db:"status"`
-	Parameters map[string]string `db:"parameters,json"`
-	Err        string            `db:"err"`
-	Output     string            `db:"output"`
+	ID           uuid.UUID             `db:"id,pk"`
+	JobName      string                `db:"job_name"`
+	Started      time.Time             `db:"started"`
+	Complete     time.Time             `db:"complete"`
+	Status       string                `db:"status"`
+	Parameters   map[string]string     `db:"parameters,json"`
+	Err          string                `db:"err"`
+	Output       string                `db:"output"`
+	OutputChunks []outputChunkRow      `db:"output_chunks,json"`
+	ExitInfo     *ExitInfo             `db:"exit_info,json"`
+	HTTPSummary  *HTTPSummary          `db:"http_summary,json"`
+	SQLSummary   []SQLStatementSummary `db:"sql_summary,json"`
+	Steps        []WorkflowStepResult  `db:"steps,json"`
+	Attempts     []JobAttempt          `db:"attempts,json"`
+	Lock         *JobLockResult        `db:"job_lock,json"`
+	Artifacts    []Artifact            `db:"artifacts,json"`
+}
+
+// outputChunkRow is the timestamp, stream and size of an output chunk; the data of
+// each chunk is sliced from the output column in order.
+type outputChunkRow struct {
+	Timestamp time.Time    `json:"ts"`
+	Stream    OutputStream `json:"stream"`
+	Size      int          `json:"size"`
 }
 
 func (ji jobInvocationRow) TableName() string { return "job_invocations" }
@@ -62,9 +134,9 @@ func (ji jobInvocationRow) TableName() string { return "job_invocations" }
 // It does stuff like wires up the output handlers etc. so you can use it transparently
 // with the rest of the management server actions.
 func (ji jobInvocationRow) JobInvocation() *JobInvocation {
-	output := bufferutil.NewBuffer([]byte(ji.Output))
+	output, outputStreams := ji.output()
 	outputHandlers := new(bufferutil.BufferHandlers)
-	output.Handler = outputHandlers.Handle
+	output.Handler = outputStreams.Handler(outputHandlers.Handle)
 
 	jio := &JobInvocation{
 		JobInvocation: cron.JobInvocation{
@@ -78,6 +150,14 @@ func (ji jobInvocationRow) JobInvocation() *JobInvocation {
 		JobInvocationOutput: JobInvocationOutput{
 			Output:         output,
 			OutputHandlers: outputHandlers,
+			OutputStreams:  outputStreams,
+			ExitInfo:       ji.ExitInfo,
+			HTTPSummary:    ji.HTTPSummary,
+			SQLSummary:     ji.SQLSummary,
+			Steps:          ji.Steps,
+			Attempts:       ji.Attempts,
+			Lock:           ji.Lock,
+			Artifacts:      ji.Artifacts,
 		},
 	}
 	if ji.Err != "" {
@@ -86,16 +166,52 @@ func (ji jobInvocationRow) JobInvocation() *JobInvocation {
 	return jio
 }
 
+// output returns the output buffer, split into its chunks with their streams if they were saved.
+func (ji jobInvocationRow) output() (*bufferutil.Buffer, *OutputStreams) {
+	var size int
+	for _, chunk := range ji.OutputChunks {
+		size += chunk.Size
+	}
+	if len(ji.OutputChunks) == 0 || size != len(ji.Output) {
+		return bufferutil.NewBuffer([]byte(ji.Output)), new(OutputStreams)
+	}
+
+	output := new(bufferutil.Buffer)
+	outputStreams := new(OutputStreams)
+	var offset int
+	for _, chunk := range ji.OutputChunks {
+		_, _ = output.Write([]byte(ji.Output[offset : offset+chunk.Size]))
+		output.Chunks[len(output.Chunks)-1].Timestamp = chunk.Timestamp
+		outputStreams.Streams = append(outputStreams.Streams, chunk.Stream)
+		offset += chunk.Size
+	}
+	return output, outputStreams
+}
+
 // Add adds a result.
 func (h *HistoryPostgres) Add(ctx context.Context, ji *JobInvocation) error {
 	obj := jobInvocationRow{
-		ID:         uuid.MustParse(ji.ID),
-		JobName:    ji.JobName,
-		Started:    ji.Started,
-		Complete:   ji.Complete,
-		Status:     string(ji.Status),
-		Parameters: ji.Parameters,
-		Output:     ji.Output.String(),
+		ID:          uuid.MustParse(ji.ID),
+		JobName:     ji.JobName,
+		Started:     ji.Started,
+		Complete:    ji.Complete,
+		Status:      string(ji.Status),
+		Parameters:  ji.Parameters,
+		Output:      ji.Output.String(),
+		ExitInfo:    ji.ExitInfo,
+		HTTPSummary: ji.HTTPSummary,
+		SQLSummary:  ji.SQLSummary,
+		Steps:       ji.Steps,
+		Attempts:    ji.Attempts,
+		Lock:        ji.Lock,
+		Artifacts:   ji.Artifacts,
+	}
+	for _, chunk := range ji.OutputChunks() {
+		obj.OutputChunks = append(obj.OutputChunks, outputChunkRow{
+			Timestamp: chunk.Timestamp,
+			Stream:    chunk.Stream,
+			Size:      len(chunk.Data),
+		})
 	}
 	if ji.Err != nil {
 		obj.Err = fmt.Sprintf("%+v", ji.Err)
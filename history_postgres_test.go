@@ -89,3 +89,114 @@ func TestHistoryPostgres(t *testing.T) {
 	assert.Nil(err)
 	assert.Len(jis, 1)
 }
+
+func TestHistoryPostgresExitInfo(t *testing.T) {
+	assert := assert.New(t)
+
+	conn, err := db.New(db.OptConfig(db.Config{
+		Database: "postgres",
+		SSLMode:  db.SSLModeDisable,
+	}))
+	assert.Nil(err)
+	assert.Nil(conn.Open())
+	defer conn.Close()
+
+	tx, err := conn.Begin()
+	assert.Nil(err)
+	defer tx.Rollback()
+
+	history := HistoryPostgres{
+		Conn: conn,
+		Tx:   tx,
+	}
+	assert.Nil(history.Initialize(context.TODO()))
+
+	exitInfo := &ExitInfo{
+		PID:        1234,
+		ExitCode:   -1,
+		Signal:     "killed",
+		UserTime:   100 * time.Millisecond,
+		SystemTime: 50 * time.Millisecond,
+		MaxRSS:     1 << 20,
+	}
+	withExitInfo := createTestCompleteJobInvocation("test0", time.Second, optJobExitInfo(exitInfo))
+	withoutExitInfo := createTestCompleteJobInvocation("test0", time.Second)
+	assert.Nil(history.Add(context.TODO(), withExitInfo))
+	assert.Nil(history.Add(context.TODO(), withoutExitInfo))
+
+	ji, err := history.GetByID(context.TODO(), "test0", withExitInfo.ID)
+	assert.Nil(err)
+	assert.Equal(exitInfo, ji.ExitInfo)
+
+	ji, err = history.GetByID(context.TODO(), "test0", withoutExitInfo.ID)
+	assert.Nil(err)
+	assert.Nil(ji.ExitInfo)
+}
+
+func TestHistoryPostgresArtifacts(t *testing.T) {
+	assert := assert.New(t)
+
+	conn, err := db.New(db.OptConfig(db.Config{
+		Database: "postgres",
+		SSLMode:  db.SSLModeDisable,
+	}))
+	assert.Nil(err)
+	assert.Nil(conn.Open())
+	defer conn.Close()
+
+	tx, err := conn.Begin()
+	assert.Nil(err)
+	defer tx.Rollback()
+
+	history := HistoryPostgres{
+		Conn: conn,
+		Tx:   tx,
+	}
+	assert.Nil(history.Initialize(context.TODO()))
+
+	artifact := Artifact{Name: "reports/a.csv", Size: 4, Contents: []byte("a,1\n")}
+	withArtifacts := createTestCompleteJobInvocation("test0", time.Second, optJobArtifacts(artifact))
+	assert.Nil(history.Add(context.TODO(), withArtifacts))
+
+	ji, err := history.GetByID(context.TODO(), "test0", withArtifacts.ID)
+	assert.Nil(err)
+	assert.Equal([]Artifact{artifact}, ji.Artifacts)
+}
+
+func TestHistoryPostgresOutputStreams(t *testing.T) {
+	assert := assert.New(t)
+
+	conn, err := db.New(db.OptConfig(db.Config{
+		Database: "postgres",
+		SSLMode:  db.SSLModeDisable,
+	}))
+	assert.Nil(err)
+	assert.Nil(conn.Open())
+	defer conn.Close()
+
+	tx, err := conn.Begin()
+	assert.Nil(err)
+	defer tx.Rollback()
+
+	history := HistoryPostgres{
+		Conn: conn,
+		Tx:   tx,
+	}
+	assert.Nil(history.Initialize(context.TODO()))
+
+	withStreams := createTestCompleteJobInvocation("test0", time.Second, optJobOutputStreams(
+		OutputStreamStdout,
+		OutputStreamStderr,
+		OutputStreamStdout,
+		OutputStreamStdout,
+		OutputStreamStderr,
+	))
+	assert.Nil(history.Add(context.TODO(), withStreams))
+
+	ji, err := history.GetByID(context.TODO(), "test0", withStreams.ID)
+	assert.Nil(err)
+	assert.Equal(withStreams.Output.String(), ji.Output.String())
+	assert.Len(ji.Output.Chunks, 5)
+	assert.Equal(withStreams.OutputStreams.Values(), ji.OutputStreams.Values())
+	assert.Equal(withStreams.OutputTail(OutputStreamStderr, 1024), ji.OutputTail(OutputStreamStderr, 1024))
+}
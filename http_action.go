@@ -0,0 +1,263 @@
+package jobkit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/ex"
+	"github.com/blend/go-sdk/logger"
+	"github.com/blend/go-sdk/r2"
+	"github.com/blend/go-sdk/template"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// NewHTTPAction returns a new http action.
+func NewHTTPAction(opts ...HTTPActionOption) HTTPAction {
+	httpAction := HTTPAction{}
+	for _, opt := range opts {
+		opt(&httpAction)
+	}
+	return httpAction
+}
+
+// OptHTTPActionConfig sets the http action config.
+func OptHTTPActionConfig(cfg HTTPActionConfig) HTTPActionOption {
+	return func(ha *HTTPAction) { ha.Config = cfg }
+}
+
+// OptHTTPActionLog sets the http action logger.
+func OptHTTPActionLog(log logger.Log) HTTPActionOption {
+	return func(ha *HTTPAction) { ha.Log = log }
+}
+
+// HTTPActionOption is a mutator for an http action.
+type HTTPActionOption func(*HTTPAction)
+
+// HTTPAction is a job body that calls an http endpoint.
+type HTTPAction struct {
+	Log    logger.Log
+	Config HTTPActionConfig
+}
+
+// HTTPSummary is a summary of the request and response of an http action.
+type HTTPSummary struct {
+	// Method is the request method.
+	Method string `json:"method"`
+	// URL is the configured request url; the invocation parameters aren't expanded, as they can be secrets.
+	URL string `json:"url"`
+	// StatusCode is the response status code, and is unset if the request failed.
+	StatusCode int `json:"statusCode,omitempty"`
+	// ContentType is the response content type.
+	ContentType string `json:"contentType,omitempty"`
+	// ResponseBytes is the number of response body bytes read.
+	ResponseBytes int `json:"responseBytes"`
+	// Truncated is set if the response body was longer than the max response bytes, and only the start was read.
+	Truncated bool `json:"truncated,omitempty"`
+	// Elapsed is the time from sending the request to reading the response body.
+	Elapsed time.Duration `json:"elapsed"`
+}
+
+// Execute is the job body.
+func (ha HTTPAction) Execute(ctx context.Context) (err error) {
+	ji := cron.GetJobInvocation(ctx)
+	jio := GetJobInvocationOutput(ctx)
+
+	if ji == nil || jio == nil {
+		return fmt.Errorf("http action; invocation meta required with the output set")
+	}
+	if ha.Config.IsZero() {
+		return ex.New("http action; url unset")
+	}
+
+	assertions, err := ha.assertions()
+	if err != nil {
+		return err
+	}
+	options, err := ha.options(ji)
+	if err != nil {
+		return err
+	}
+
+	summary := &HTTPSummary{
+		Method: ha.Config.MethodOrDefault(),
+		URL:    ha.Config.URL,
+	}
+	jio.HTTPSummary = summary
+
+	ctx, span := startSpan(ctx, SpanHTTPActionExecute,
+		attribute.String("job.name", ji.JobName),
+		attribute.String("job.invocation_id", ji.ID),
+		attribute.String("http.method", summary.Method),
+		// the configured url, as the expanded url can include parameter values that are secrets.
+		attribute.String("http.url", summary.URL),
+	)
+	defer func() { endSpan(span, err) }()
+	options = append(options, r2.OptContext(ctx))
+	for key, values := range TraceContextHeaders(ctx) {
+		if len(values) > 0 {
+			options = append(options, r2.OptHeaderValue(key, values[0]))
+		}
+	}
+
+	fmt.Fprintf(jio.Output, "> %s %s\n", summary.Method, summary.URL)
+	started := time.Now()
+	res, err := r2.New(ha.expandURL(ji), options...).Do()
+	if err != nil {
+		summary.Elapsed = time.Since(started)
+		fmt.Fprintf(jio.Output, "! %v (%v)\n", err, summary.Elapsed.Round(time.Millisecond))
+		return ex.New(err)
+	}
+	defer res.Body.Close()
+
+	// read a byte past the max to tell if the body was truncated.
+	maxResponseBytes := ha.Config.MaxResponseBytesOrDefault()
+	body, err := ioutil.ReadAll(io.LimitReader(res.Body, int64(maxResponseBytes)+1))
+	if len(body) > maxResponseBytes {
+		body = body[:maxResponseBytes]
+		summary.Truncated = true
+	}
+	summary.Elapsed = time.Since(started)
+	summary.StatusCode = res.StatusCode
+	summary.ContentType = res.Header.Get("Content-Type")
+	summary.ResponseBytes = len(body)
+	span.SetAttributes(attribute.Int("http.status_code", res.StatusCode))
+
+	fmt.Fprintf(jio.Output, "< %s (%v)\n", res.Status, summary.Elapsed.Round(time.Millisecond))
+	if outputBody := body; len(outputBody) > 0 {
+		if len(outputBody) > ha.Config.OutputBodyBytesOrDefault() {
+			outputBody = outputBody[:ha.Config.OutputBodyBytesOrDefault()]
+		}
+		fmt.Fprintf(jio.Output, "%s\n", outputBody)
+	}
+	if err != nil {
+		return ex.New(err)
+	}
+
+	if !ha.Config.IsExpectedStatusCode(res.StatusCode) {
+		return ex.New(ErrHTTPActionStatusCode, ex.OptMessagef("status code: %d", res.StatusCode))
+	}
+	for _, assertion := range assertions {
+		if err = assertion.Check(body, summary.Truncated); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expandURL returns the url with the invocation parameters expanded, query escaped
+// so that they can't change the structure of the url.
+func (ha HTTPAction) expandURL(ji *cron.JobInvocation) string {
+	expand := ExpandParameters(ji)
+	return os.Expand(ha.Config.URL, func(name string) string {
+		// spaces are escaped as `%20` rather than `+`, as `+` is only a space in the query.
+		return strings.ReplaceAll(url.QueryEscape(expand(name)), "+", "%20")
+	})
+}
+
+// options returns the request options for the method, headers, body and timeout.
+func (ha HTTPAction) options(ji *cron.JobInvocation) ([]r2.Option, error) {
+	options := []r2.Option{
+		r2.OptMethod(ha.Config.MethodOrDefault()),
+		r2.OptTimeout(ha.Config.TimeoutOrDefault()),
+	}
+	if ha.Log != nil {
+		options = append(options, r2.OptLog(ha.Log))
+	}
+	for key, value := range ha.Config.Headers {
+		options = append(options, r2.OptHeaderValue(http.CanonicalHeaderKey(key), os.Expand(value, ExpandParameters(ji))))
+	}
+	if ha.Config.Body != "" {
+		body, err := template.New().WithBody(ha.Config.Body).WithVars(TemplateVars(ji)).ProcessString()
+		if err != nil {
+			return nil, ex.New(err, ex.OptMessage("http action; invalid body template"))
+		}
+		options = append(options, r2.OptBodyBytes([]byte(body)))
+	}
+	return options, nil
+}
+
+// assertions compiles the response assertions.
+func (ha HTTPAction) assertions() (output []httpActionAssertion, err error) {
+	for _, assertion := range ha.Config.Assertions {
+		compiled := httpActionAssertion{HTTPActionAssertion: assertion}
+		if assertion.Regex != "" {
+			compiled.regex, err = regexp.Compile(assertion.Regex)
+			if err != nil {
+				err = ex.New(err, ex.OptMessagef("http action; invalid assertion regex: %s", assertion.Regex))
+				return
+			}
+		}
+		if assertion.JSONPath == "" && compiled.regex == nil {
+			err = ex.New("http action; assertion must set a json path or regex")
+			return
+		}
+		output = append(output, compiled)
+	}
+	return
+}
+
+// httpActionAssertion is an assertion with its regex compiled.
+type httpActionAssertion struct {
+	HTTPActionAssertion
+	regex *regexp.Regexp
+}
+
+// Check checks the assertion against a response body, which may have been truncated to the max response bytes.
+func (ha httpActionAssertion) Check(body []byte, truncated bool) error {
+	if ha.JSONPath == "" {
+		if !ha.regex.Match(body) {
+			return ex.New(ErrHTTPActionAssertionFailed, ex.OptMessagef("body does not match regex: %s", ha.Regex))
+		}
+		return nil
+	}
+
+	if truncated {
+		return ex.New(ErrHTTPActionAssertionFailed, ex.OptMessagef("json path: %s; body was truncated to %d bytes, increase max response bytes", ha.JSONPath, len(body)))
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return ex.New(ErrHTTPActionAssertionFailed, ex.OptMessagef("json path: %s; body is not json", ha.JSONPath), ex.OptInner(err))
+	}
+	value, ok, err := jsonPathLookup(decoded, ha.JSONPath)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ex.New(ErrHTTPActionAssertionFailed, ex.OptMessagef("json path: %s; value not found", ha.JSONPath))
+	}
+	actual := jsonValueString(value)
+	if ha.Equals != nil && actual != *ha.Equals {
+		return ex.New(ErrHTTPActionAssertionFailed, ex.OptMessagef("json path: %s; expected %q, actual %q", ha.JSONPath, *ha.Equals, actual))
+	}
+	if ha.regex != nil && !ha.regex.MatchString(actual) {
+		return ex.New(ErrHTTPActionAssertionFailed, ex.OptMessagef("json path: %s; %q does not match regex: %s", ha.JSONPath, actual, ha.Regex))
+	}
+	return nil
+}
+
+// jsonValueString returns a decoded json value as a string for comparisons,
+// where strings are unquoted and other values are re-encoded as json.
+func jsonValueString(value interface{}) string {
+	if typed, ok := value.(string); ok {
+		return typed
+	}
+	contents, _ := json.Marshal(value)
+	return string(contents)
+}
+
+// HTTP action errors.
+const (
+	ErrHTTPActionStatusCode      ex.Class = "http action; status code is not an expected status code"
+	ErrHTTPActionAssertionFailed ex.Class = "http action; response assertion failed"
+	ErrHTTPActionInvalidJSONPath ex.Class = "http action; invalid json path"
+)
@@ -0,0 +1,92 @@
+package jobkit
+
+import (
+	"time"
+
+	"github.com/blend/go-sdk/r2"
+)
+
+// HTTPActionConfig is a config for http actions.
+type HTTPActionConfig struct {
+	// Method is the request method, defaulting to `GET`.
+	Method string `yaml:"method"`
+	// URL is the request url; invocation parameters are expanded in it, query escaped.
+	URL string `yaml:"url"`
+	// Headers are request headers; invocation parameters are expanded in the values.
+	Headers map[string]string `yaml:"headers"`
+	// Body is a template for the request body, with the invocation parameters as vars, e.g. `{{ .Var "name" }}`.
+	Body string `yaml:"body"`
+	// Timeout is the timeout for the request, defaulting to 30 seconds.
+	Timeout *time.Duration `yaml:"timeout"`
+	// ExpectedStatusCodes are the status codes that mark the invocation as successful, defaulting to any 2xx status code.
+	ExpectedStatusCodes []int `yaml:"expectedStatusCodes"`
+	// Assertions are checks on the response body that must all pass for the invocation to be successful.
+	Assertions []HTTPActionAssertion `yaml:"assertions"`
+	// MaxResponseBytes is the maximum number of response body bytes read for assertions.
+	MaxResponseBytes *int `yaml:"maxResponseBytes"`
+	// OutputBodyBytes is the maximum number of response body bytes written to the invocation output.
+	OutputBodyBytes *int `yaml:"outputBodyBytes"`
+}
+
+// IsZero returns if the http action is set or not.
+func (hc HTTPActionConfig) IsZero() bool {
+	return hc.URL == ""
+}
+
+// MethodOrDefault returns a value or a default.
+func (hc HTTPActionConfig) MethodOrDefault() string {
+	if hc.Method != "" {
+		return hc.Method
+	}
+	return r2.MethodGet
+}
+
+// TimeoutOrDefault returns a value or a default.
+func (hc HTTPActionConfig) TimeoutOrDefault() time.Duration {
+	if hc.Timeout != nil {
+		return *hc.Timeout
+	}
+	return DefaultHTTPActionTimeout
+}
+
+// MaxResponseBytesOrDefault returns a value or a default.
+func (hc HTTPActionConfig) MaxResponseBytesOrDefault() int {
+	if hc.MaxResponseBytes != nil {
+		return *hc.MaxResponseBytes
+	}
+	return DefaultHTTPActionMaxResponseBytes
+}
+
+// OutputBodyBytesOrDefault returns a value or a default.
+func (hc HTTPActionConfig) OutputBodyBytesOrDefault() int {
+	if hc.OutputBodyBytes != nil {
+		return *hc.OutputBodyBytes
+	}
+	return DefaultHTTPActionOutputBodyBytes
+}
+
+// IsExpectedStatusCode returns if a status code marks the invocation as successful.
+func (hc HTTPActionConfig) IsExpectedStatusCode(statusCode int) bool {
+	if len(hc.ExpectedStatusCodes) == 0 {
+		return statusCode >= 200 && statusCode < 300
+	}
+	for _, expected := range hc.ExpectedStatusCodes {
+		if statusCode == expected {
+			return true
+		}
+	}
+	return false
+}
+
+// HTTPActionAssertion is a check on the response body of an http action.
+//
+// If JSONPath is set, the value at the path, e.g. `$.status` or `$.items[0].id`, must exist, and
+// match Equals or Regex if they're set. Otherwise Regex must match the whole response body.
+type HTTPActionAssertion struct {
+	// JSONPath is the path of a value in a json response body.
+	JSONPath string `yaml:"jsonPath"`
+	// Equals is the expected value at the json path, compared as a string.
+	Equals *string `yaml:"equals"`
+	// Regex is a regular expression the value at the json path, or the response body, must match.
+	Regex string `yaml:"regex"`
+}
@@ -0,0 +1,75 @@
+package jobkit
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/blend/go-sdk/ex"
+)
+
+// jsonPathLookup returns the value at a json path in a decoded json value.
+//
+// It supports the subset of json path used by assertions; the `$` root followed by
+// dot separated keys, bracketed keys and array indexes, e.g. `$.items[0]['display name']`.
+func jsonPathLookup(value interface{}, path string) (interface{}, bool, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, false, ex.New(ErrHTTPActionInvalidJSONPath, ex.OptMessagef("json path: %s", path))
+	}
+	segments, err := parseJSONPath(path[1:])
+	if err != nil {
+		return nil, false, ex.New(ErrHTTPActionInvalidJSONPath, ex.OptMessagef("json path: %s", path), ex.OptInner(err))
+	}
+	for _, segment := range segments {
+		switch typed := value.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if value, ok = typed[segment]; !ok {
+				return nil, false, nil
+			}
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(typed) {
+				return nil, false, nil
+			}
+			value = typed[index]
+		default:
+			return nil, false, nil
+		}
+	}
+	return value, true, nil
+}
+
+// parseJSONPath splits a json path, without the root, into its keys and indexes.
+func parseJSONPath(path string) (segments []string, err error) {
+	for len(path) > 0 {
+		switch path[0] {
+		case '.':
+			path = path[1:]
+			end := strings.IndexAny(path, ".[")
+			if end < 0 {
+				end = len(path)
+			}
+			if end == 0 {
+				return nil, ex.New("empty key")
+			}
+			segments = append(segments, path[:end])
+			path = path[end:]
+		case '[':
+			end := strings.IndexByte(path, ']')
+			if end < 0 {
+				return nil, ex.New("unterminated bracket")
+			}
+			segment := path[1:end]
+			if len(segment) >= 2 && (segment[0] == '\'' || segment[0] == '"') && segment[len(segment)-1] == segment[0] {
+				segment = segment[1 : len(segment)-1]
+			} else if _, err = strconv.Atoi(segment); err != nil {
+				return nil, ex.New("invalid index", ex.OptMessagef("index: %s", segment))
+			}
+			segments = append(segments, segment)
+			path = path[end+1:]
+		default:
+			return nil, ex.New("unexpected character", ex.OptMessagef("at: %s", path))
+		}
+	}
+	return
+}
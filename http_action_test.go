@@ -0,0 +1,208 @@
+package jobkit
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/ex"
+	"github.com/blend/go-sdk/ref"
+)
+
+func TestHTTPAction(t *testing.T) {
+	assert := assert.New(t)
+
+	var method, authorization, body string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		authorization = r.Header.Get("Authorization")
+		contents, _ := ioutil.ReadAll(r.Body)
+		body = string(contents)
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusCreated)
+		fmt.Fprint(rw, `{"status":"ok","items":[{"id":1234}]}`)
+	}))
+	defer server.Close()
+
+	ctx, jio := createTestShellActionContext()
+	cron.GetJobInvocation(ctx).Parameters = cron.JobParameters{"TOKEN": "bailey", "NAME": "test"}
+
+	action := NewHTTPAction(OptHTTPActionConfig(HTTPActionConfig{
+		Method: "POST",
+		URL:    server.URL + "/trigger",
+		Headers: map[string]string{
+			"authorization": "Bearer ${TOKEN}",
+		},
+		Body: `{"name":"{{ .Var "NAME" }}"}`,
+		Assertions: []HTTPActionAssertion{
+			{JSONPath: "$.status", Equals: ref.String("ok")},
+			{JSONPath: "$.items[0].id", Regex: `^\d+$`},
+			{Regex: `"status"`},
+		},
+	}))
+	assert.Nil(action.Execute(ctx))
+
+	assert.Equal("POST", method)
+	assert.Equal("Bearer bailey", authorization)
+	assert.Equal(`{"name":"test"}`, body)
+
+	assert.NotNil(jio.HTTPSummary)
+	assert.Equal("POST", jio.HTTPSummary.Method)
+	assert.Equal(server.URL+"/trigger", jio.HTTPSummary.URL)
+	assert.Equal(http.StatusCreated, jio.HTTPSummary.StatusCode)
+	assert.Equal("application/json", jio.HTTPSummary.ContentType)
+	assert.NotZero(jio.HTTPSummary.Elapsed)
+	assert.Contains(jio.Output.String(), "> POST "+server.URL+"/trigger")
+	assert.Contains(jio.Output.String(), "< 201 Created")
+	assert.Contains(jio.Output.String(), `{"status":"ok"`)
+}
+
+func TestHTTPActionStatusCode(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx, jio := createTestShellActionContext()
+	err := NewHTTPAction(OptHTTPActionConfig(HTTPActionConfig{URL: server.URL})).Execute(ctx)
+	assert.True(ex.Is(err, ErrHTTPActionStatusCode))
+	assert.Equal(http.StatusServiceUnavailable, jio.HTTPSummary.StatusCode)
+
+	ctx, _ = createTestShellActionContext()
+	err = NewHTTPAction(OptHTTPActionConfig(HTTPActionConfig{
+		URL:                 server.URL,
+		ExpectedStatusCodes: []int{http.StatusServiceUnavailable},
+	})).Execute(ctx)
+	assert.Nil(err)
+}
+
+func TestHTTPActionAssertionFailed(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(rw, `{"status":"degraded"}`)
+	}))
+	defer server.Close()
+
+	testCases := []HTTPActionAssertion{
+		{JSONPath: "$.status", Equals: ref.String("ok")},
+		{JSONPath: "$.missing"},
+		{JSONPath: "$.status", Regex: "^ok$"},
+		{Regex: "healthy"},
+	}
+	for _, tc := range testCases {
+		ctx, _ := createTestShellActionContext()
+		err := NewHTTPAction(OptHTTPActionConfig(HTTPActionConfig{
+			URL:        server.URL,
+			Assertions: []HTTPActionAssertion{tc},
+		})).Execute(ctx)
+		assert.True(ex.Is(err, ErrHTTPActionAssertionFailed), fmt.Sprintf("%+v", tc))
+	}
+}
+
+func TestHTTPActionURLParameters(t *testing.T) {
+	assert := assert.New(t)
+
+	var path, query string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		query = r.URL.RawQuery
+	}))
+	defer server.Close()
+
+	ctx, jio := createTestShellActionContext()
+	cron.GetJobInvocation(ctx).Parameters = cron.JobParameters{"NAME": "a b/c", "TOKEN": "bailey&admin=true"}
+
+	action := NewHTTPAction(OptHTTPActionConfig(HTTPActionConfig{
+		URL: server.URL + "/items/${NAME}?token=${TOKEN}",
+	}))
+	assert.Nil(action.Execute(ctx))
+	assert.Equal("/items/a b/c", path)
+	assert.Equal("token=bailey%26admin%3Dtrue", query, "parameters can't add query values")
+	assert.Equal(server.URL+"/items/${NAME}?token=${TOKEN}", jio.HTTPSummary.URL)
+	assert.NotContains(jio.Output.String(), "bailey")
+}
+
+func TestHTTPActionTruncated(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(rw, `{"status":"ok","padding":"0123456789"}`)
+	}))
+	defer server.Close()
+
+	ctx, jio := createTestShellActionContext()
+	err := NewHTTPAction(OptHTTPActionConfig(HTTPActionConfig{
+		URL:              server.URL,
+		MaxResponseBytes: ref.Int(16),
+		Assertions: []HTTPActionAssertion{
+			{JSONPath: "$.status", Equals: ref.String("ok")},
+		},
+	})).Execute(ctx)
+	assert.True(ex.Is(err, ErrHTTPActionAssertionFailed))
+	assert.True(jio.HTTPSummary.Truncated)
+	assert.Equal(16, jio.HTTPSummary.ResponseBytes)
+
+	ctx, jio = createTestShellActionContext()
+	assert.Nil(NewHTTPAction(OptHTTPActionConfig(HTTPActionConfig{
+		URL: server.URL,
+		Assertions: []HTTPActionAssertion{
+			{JSONPath: "$.status", Equals: ref.String("ok")},
+		},
+	})).Execute(ctx))
+	assert.False(jio.HTTPSummary.Truncated)
+}
+
+func TestHTTPActionTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	ctx, jio := createTestShellActionContext()
+	err := NewHTTPAction(OptHTTPActionConfig(HTTPActionConfig{
+		URL:     server.URL,
+		Timeout: ref.Duration(10 * time.Millisecond),
+	})).Execute(ctx)
+	assert.NotNil(err)
+	assert.Zero(jio.HTTPSummary.StatusCode)
+	assert.Contains(jio.Output.String(), "! ")
+}
+
+func TestJSONPathLookup(t *testing.T) {
+	assert := assert.New(t)
+
+	value := map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"display name": "bailey"},
+		},
+	}
+
+	found, ok, err := jsonPathLookup(value, "$.items[0]['display name']")
+	assert.Nil(err)
+	assert.True(ok)
+	assert.Equal("bailey", found)
+
+	_, ok, err = jsonPathLookup(value, "$.items[1]")
+	assert.Nil(err)
+	assert.False(ok)
+
+	found, ok, err = jsonPathLookup(value, "$")
+	assert.Nil(err)
+	assert.True(ok)
+	assert.Equal(value, found)
+
+	for _, path := range []string{"items", "$.", "$.items[", "$.items[x]", "$items"} {
+		_, _, err = jsonPathLookup(value, path)
+		assert.True(ex.Is(err, ErrHTTPActionInvalidJSONPath), path)
+	}
+}
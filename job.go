@@ -3,8 +3,12 @@ package jobkit
 import (
 	"context"
 	"fmt"
+	"sort"
+	"sync/atomic"
+	"time"
 
 	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/db"
 	"github.com/blend/go-sdk/email"
 	"github.com/blend/go-sdk/ex"
 	"github.com/blend/go-sdk/logger"
@@ -13,6 +17,20 @@ import (
 	"github.com/blend/go-sdk/slack"
 	"github.com/blend/go-sdk/stats"
 	"github.com/blend/go-sdk/stringutil"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Job metric names.
+// These are prefixed with the job config stats prefix if it's set.
+const (
+	MetricJobElapsed              = "job.elapsed"
+	MetricJobOutputBytes          = "job.output_bytes"
+	MetricJobRunning              = "job.running"
+	MetricJobDisabled             = "job.disabled"
+	MetricJobLastSuccessTimestamp = "job.last_success_timestamp"
+	MetricJobRetried              = "job.retried"
 )
 
 var (
@@ -46,6 +64,27 @@ func NewJob(wrapped cron.Job, options ...JobOption) (*Job, error) {
 			return nil, err
 		}
 	}
+	if err = job.JobConfig.Concurrency.Validate(); err != nil {
+		return nil, err
+	}
+	if err = job.JobConfig.Misfire.Validate(); err != nil {
+		return nil, err
+	}
+	if err = job.JobConfig.Lock.Validate(); err != nil {
+		return nil, err
+	}
+	if err = job.JobConfig.Retry.Validate(); err != nil {
+		return nil, err
+	}
+	if job.Concurrency == nil {
+		job.Concurrency = NewJobConcurrency(job.JobConfig.Concurrency)
+	}
+	if job.LockProvider == nil && !job.JobConfig.Lock.IsZero() {
+		lock := job.JobConfig.Lock
+		if job.LockProvider, err = NewLockProvider(lock.Provider, lock.KeyOrDefault(job.Name()), lock.PathOrDefault(job.Name()), job.LockConn, lock.LeaseOrDefault()); err != nil {
+			return nil, err
+		}
+	}
 	return job, nil
 }
 
@@ -77,6 +116,24 @@ func OptJobLog(log logger.Log) JobOption {
 	}
 }
 
+// OptJobNotificationsDispatcher sets the shared notifications dispatcher.
+// If set, the job will not start its own notification queues.
+func OptJobNotificationsDispatcher(dispatcher *NotificationsDispatcher) JobOption {
+	return func(job *Job) error {
+		job.NotificationsDispatcher = dispatcher
+		return nil
+	}
+}
+
+// OptJobTracerProvider sets the tracer provider used for job spans.
+// If unset, the global opentelemetry tracer provider is used.
+func OptJobTracerProvider(provider trace.TracerProvider) JobOption {
+	return func(job *Job) error {
+		job.TracerProvider = provider
+		return nil
+	}
+}
+
 // OptJobHistory sets the job history provider.
 func OptJobHistory(provider HistoryProvider) JobOption {
 	return func(job *Job) error {
@@ -85,6 +142,33 @@ func OptJobHistory(provider HistoryProvider) JobOption {
 	}
 }
 
+// OptJobTriggers sets the shared job triggers the job reports its invocations to,
+// so that jobs triggered by the job are run after it finishes.
+func OptJobTriggers(triggers *JobTriggers) JobOption {
+	return func(job *Job) error {
+		job.Triggers = triggers
+		return nil
+	}
+}
+
+// OptJobLockProvider sets the provider of the lock the job holds while it runs.
+// If unset and the job config sets a lock, the provider is created from the config.
+func OptJobLockProvider(provider LockProvider) JobOption {
+	return func(job *Job) error {
+		job.LockProvider = provider
+		return nil
+	}
+}
+
+// OptJobLockConn sets the connection used by the lock provider created from the job config,
+// which the `postgres` provider requires.
+func OptJobLockConn(conn *db.Connection) JobOption {
+	return func(job *Job) error {
+		job.LockConn = conn
+		return nil
+	}
+}
+
 // JobOption is a function that mutates a job.
 type JobOption func(*Job) error
 
@@ -94,8 +178,9 @@ type Job struct {
 	JobConfig   JobConfig
 	JobSchedule cron.Schedule
 
-	Log         logger.Log
-	StatsClient stats.Collector
+	Log            logger.Log
+	StatsClient    stats.Collector
+	TracerProvider trace.TracerProvider
 
 	SlackDefaults   slack.Message
 	EmailDefaults   email.Message
@@ -109,7 +194,20 @@ type Job struct {
 	NotificationsQueueSlack   *RetryQueue
 	NotificationsQueueWebhook *RetryQueue
 
+	NotificationsDispatcher *NotificationsDispatcher
+	Triggers                *JobTriggers
+	Concurrency             *JobConcurrency
+	LockProvider            LockProvider
+	LockConn                *db.Connection
+
 	HistoryProvider HistoryProvider
+
+	lastSuccess int64
+	running     int32
+	catchingUp  int32
+	metrics     jobMetrics
+	outcomes    jobOutcomes
+	detached    jobDetachedInvocations
 }
 
 // Name returns the job name.
@@ -223,23 +321,30 @@ func (job *Job) Lifecycle() (output cron.JobLifecycle) {
 }
 
 // OnLoad implements job on load handler.
+// If the job does not use a shared notifications dispatcher, it starts its own notification queues.
 func (job *Job) OnLoad(ctx context.Context) error {
+	if job.NotificationsDispatcher != nil {
+		return nil
+	}
+
 	retryOptions := []RetryQueueOption{
 		OptRetryQueueMaxAttempts(job.JobConfig.Notifications.MaxRetriesOrDefault()),
 		OptRetryQueueRetryWait(job.JobConfig.Notifications.RetryWaitOrDefault()),
+		OptRetryQueueTimeout(job.JobConfig.Notifications.TimeoutOrDefault()),
+		OptRetryQueueStats(job.StatsClient, job.statsTags()...),
 	}
 
-	job.NotificationsQueueEmail = NewRetryQueue(job.notifyEmail, retryOptions...)
+	job.NotificationsQueueEmail = NewRetryQueue(job.notifyEmail, append(retryOptions, OptRetryQueueName(NotificationChannelEmail))...)
 	job.NotificationsQueueEmail.Log = job.Log
 	go job.NotificationsQueueEmail.Start()
 	<-job.NotificationsQueueEmail.NotifyStarted()
 
-	job.NotificationsQueueSlack = NewRetryQueue(job.notifySlack, retryOptions...)
+	job.NotificationsQueueSlack = NewRetryQueue(job.notifySlack, append(retryOptions, OptRetryQueueName(NotificationChannelSlack))...)
 	job.NotificationsQueueSlack.Log = job.Log
 	go job.NotificationsQueueSlack.Start()
 	<-job.NotificationsQueueSlack.NotifyStarted()
 
-	job.NotificationsQueueWebhook = NewRetryQueue(job.notifyWebhook, retryOptions...)
+	job.NotificationsQueueWebhook = NewRetryQueue(job.notifyWebhook, append(retryOptions, OptRetryQueueName(NotificationChannelWebhook))...)
 	job.NotificationsQueueWebhook.Log = job.Log
 	go job.NotificationsQueueWebhook.Start()
 	<-job.NotificationsQueueWebhook.NotifyStarted()
@@ -270,16 +375,32 @@ func (job *Job) OnBegin(ctx context.Context) {
 }
 
 // OnSuccess is a lifecycle event handler.
+// Skipped invocations complete successfully as far as the job manager is concerned,
+// so they are handled here as well.
 func (job *Job) OnSuccess(ctx context.Context) {
+	ji, previousSkipped, lastRun := job.observeOutcome(ctx)
+	if ji != nil && ji.Skipped {
+		job.sendStats(ctx, FlagSkipped)
+		return
+	}
 	job.sendStats(ctx, cron.FlagSuccess)
 	if job.JobConfig.Notifications.OnSuccessOrDefault() {
 		job.notify(ctx, cron.FlagSuccess)
 	}
+	if job.Triggers != nil {
+		job.Triggers.Observe(ctx, JobTriggerOnSuccess, ji)
+	}
+	// the job manager compares against the skipped invocation,
+	// so it won't see that the job was fixed.
+	if previousSkipped && lastRun == jobOutcomeErrored {
+		job.OnFixed(ctx)
+	}
 }
 
 // OnComplete is a lifecycle event handler.
 func (job *Job) OnComplete(ctx context.Context) {
-	if err := job.AddHistoryResult(ctx, NewJobInvocation(cron.GetJobInvocation(ctx))); err != nil {
+	ji, _, _ := job.observeOutcome(ctx)
+	if err := job.AddHistoryResult(ctx, ji); err != nil {
 		job.Error(ctx, err)
 	}
 	if err := job.CullHistory(ctx); err != nil {
@@ -289,11 +410,17 @@ func (job *Job) OnComplete(ctx context.Context) {
 	if job.JobConfig.Notifications.OnCompleteOrDefault() {
 		job.notify(ctx, cron.FlagComplete)
 	}
+	// skipped invocations don't trigger other jobs, as with `OnSuccess`.
+	if job.Triggers != nil && ji != nil && !ji.Skipped {
+		job.Triggers.Observe(ctx, JobTriggerOnComplete, ji)
+	}
 }
 
 // OnError is a lifecycle event handler.
 func (job *Job) OnError(ctx context.Context) {
+	job.observeOutcome(ctx)
 	job.sendStats(ctx, cron.FlagErrored)
+	job.notifySentry(ctx, cron.FlagErrored)
 	if job.JobConfig.Notifications.OnErrorOrDefault() {
 		job.notify(ctx, cron.FlagErrored)
 	}
@@ -301,6 +428,11 @@ func (job *Job) OnError(ctx context.Context) {
 
 // OnBroken is a lifecycle event handler.
 func (job *Job) OnBroken(ctx context.Context) {
+	// if the job manager compared against a skipped invocation
+	// and the job was already broken, it is still broken.
+	if _, previousSkipped, lastRun := job.observeOutcome(ctx); previousSkipped && lastRun == jobOutcomeErrored {
+		return
+	}
 	job.sendStats(ctx, cron.FlagBroken)
 	if job.JobConfig.Notifications.OnBrokenOrDefault() {
 		job.notify(ctx, cron.FlagBroken)
@@ -309,7 +441,12 @@ func (job *Job) OnBroken(ctx context.Context) {
 
 // OnFixed is a lifecycle event handler.
 func (job *Job) OnFixed(ctx context.Context) {
+	// skipped invocations don't fix a broken job.
+	if ji, _, _ := job.observeOutcome(ctx); ji != nil && ji.Skipped {
+		return
+	}
 	job.sendStats(ctx, cron.FlagFixed)
+	job.notifySentry(ctx, cron.FlagFixed)
 	if job.JobConfig.Notifications.OnFixedOrDefault() {
 		job.notify(ctx, cron.FlagFixed)
 	}
@@ -342,12 +479,73 @@ func (job *Job) OnDisabled(ctx context.Context) {
 // Execute is the job body.
 func (job *Job) Execute(ctx context.Context) (err error) {
 	invocationOutput := NewJobInvocationOutput()
+	ji := cron.GetJobInvocation(ctx)
+
+	ctx = WithTracerProvider(ctx, job.tracerProvider())
+	ctx, span := startSpan(ctx, SpanJobExecute, jobInvocationSpanAttributes(ji)...)
+	defer func() {
+		span.SetAttributes(attribute.String("job.status", string(jobInvocationSpanStatus(ctx, err))))
+		endSpan(span, err)
+	}()
+	invocationOutput.SpanContext = span.SpanContext()
 
 	ctx = WithJobInvocationOutput(ctx, invocationOutput)
-	ji := cron.GetJobInvocation(ctx)
 	ji.State = invocationOutput
 
-	if err = job.Job.Execute(ctx); err != nil {
+	if job.Concurrency != nil {
+		// the job manager passes over scheduled runs while its invocation is running.
+		// with the skip policy they're only recorded, once the invocation completes.
+		if job.JobSchedule != nil && !isJobDetached(ctx) {
+			if job.Concurrency.Config.PolicyOrDefault() == JobConcurrencyPolicySkip {
+				defer job.skipPassedOver(ctx, ji.Started)
+			} else {
+				passedOverCtx, stop := context.WithCancel(context.Background())
+				defer stop()
+				go job.runPassedOver(passedOverCtx)
+			}
+		}
+		concurrencyCtx, release, concurrencyErr := job.Concurrency.Begin(ctx, ji.ID)
+		if ex.Is(concurrencyErr, ErrJobConcurrencySkipped) {
+			fmt.Fprintf(invocationOutput.Output, "==> %v\n", concurrencyErr)
+			invocationOutput.Skipped = true
+			return nil
+		}
+		if concurrencyErr != nil {
+			err = concurrencyErr
+			return
+		}
+		defer release()
+		invocationCtx := ctx
+		defer func() {
+			if err != nil && invocationCtx.Err() == nil && concurrencyCtx.Err() != nil {
+				err = ex.New(cron.ErrJobCancelled, ex.OptMessage("cancelled by a later invocation"), ex.OptInner(err))
+			}
+		}()
+		ctx = concurrencyCtx
+	}
+
+	if job.LockProvider != nil {
+		lockCtx, release, lockErr := job.acquireLock(ctx, invocationOutput)
+		if ex.Is(lockErr, ErrJobLockContended) {
+			fmt.Fprintf(invocationOutput.Output, "==> %v\n", lockErr)
+			invocationOutput.Skipped = true
+			return nil
+		}
+		if lockErr != nil {
+			err = lockErr
+			return
+		}
+		// the lock result is final once the lock is released.
+		defer func() {
+			if invocationOutput.Lock.Lost {
+				err = ex.New(ErrJobLockLost, ex.OptMessagef("key: %s", invocationOutput.Lock.Key), ex.OptInner(err))
+			}
+		}()
+		defer release()
+		ctx = lockCtx
+	}
+
+	if err = job.executeAttempts(ctx, invocationOutput); err != nil {
 		return
 	}
 	return
@@ -357,6 +555,34 @@ func (job *Job) Execute(ctx context.Context) (err error) {
 // exported utility methods
 //
 
+// Metrics returns the counts of the job's invocations since the process started.
+func (job *Job) Metrics() JobMetrics {
+	output := job.metrics.metrics()
+	output.Running = int(atomic.LoadInt32(&job.running))
+	if lastSuccess := atomic.LoadInt64(&job.lastSuccess); lastSuccess > 0 {
+		output.LastSuccess = time.Unix(0, lastSuccess).UTC()
+	}
+	return output
+}
+
+// NotificationsQueueStats returns stats snapshots for the notification queues
+// that have been created for the job.
+//
+// Jobs that use the shared dispatcher don't have their own queues; the dispatcher's
+// queue stats are reported once, by the dispatcher.
+func (job *Job) NotificationsQueueStats() (output []RetryQueueStats) {
+	for _, queue := range []*RetryQueue{
+		job.NotificationsQueueEmail,
+		job.NotificationsQueueSlack,
+		job.NotificationsQueueWebhook,
+	} {
+		if queue != nil {
+			output = append(output, queue.Stats())
+		}
+	}
+	return
+}
+
 // Debugf logs a debug message if the logger is set.
 func (job *Job) Debugf(ctx context.Context, format string, args ...interface{}) {
 	if job.Log != nil {
@@ -377,38 +603,145 @@ func (job *Job) Error(ctx context.Context, err error) error {
 //
 
 func (job *Job) sendStats(ctx context.Context, flag string) {
-	if job.StatsClient != nil {
-		job.StatsClient.Increment(string(flag), fmt.Sprintf("%s:%s", stats.TagJob, job.Name()))
+	// the running invocations, completed invocation counts and last success are tracked
+	// whether or not there is a stats client.
+	switch flag {
+	case cron.FlagBegin:
+		atomic.AddInt32(&job.running, 1)
+	case cron.FlagComplete:
+		atomic.AddInt32(&job.running, -1)
 		if ji := cron.GetJobInvocation(ctx); ji != nil {
-			job.Debugf(ctx, "stats; sending stats to collector")
-			job.Error(ctx, job.StatsClient.TimeInMilliseconds(string(flag), ji.Elapsed(), fmt.Sprintf("%s:%s", stats.TagJob, job.Name())))
+			job.metrics.observe(ji)
+		}
+	case cron.FlagSuccess:
+		atomic.StoreInt64(&job.lastSuccess, time.Now().UTC().UnixNano())
+	}
+
+	if job.StatsClient == nil {
+		return
+	}
+	tags := job.statsTags()
+	job.Error(ctx, job.StatsClient.Increment(job.statsName(flag), tags...))
+
+	switch flag {
+	case cron.FlagBegin, cron.FlagComplete:
+		job.Error(ctx, job.StatsClient.Gauge(job.statsName(MetricJobRunning), float64(atomic.LoadInt32(&job.running)), tags...))
+	case cron.FlagEnabled:
+		job.Error(ctx, job.StatsClient.Gauge(job.statsName(MetricJobDisabled), 0, tags...))
+	case cron.FlagDisabled:
+		job.Error(ctx, job.StatsClient.Gauge(job.statsName(MetricJobDisabled), 1, tags...))
+	}
+
+	if ji := cron.GetJobInvocation(ctx); ji != nil {
+		job.Debugf(ctx, "stats; sending stats to collector")
+		job.Error(ctx, job.StatsClient.TimeInMilliseconds(job.statsName(flag), ji.Elapsed(), tags...))
+		if flag == cron.FlagComplete {
+			job.Error(ctx, job.StatsClient.Histogram(job.statsName(MetricJobElapsed), float64(ji.Elapsed())/float64(time.Millisecond), tags...))
+			if jio, ok := ji.State.(*JobInvocationOutput); ok && jio != nil && jio.Output != nil {
+				job.Error(ctx, job.StatsClient.Histogram(job.statsName(MetricJobOutputBytes), float64(len(jio.Output.Bytes())), tags...))
+			}
 		}
 	}
+
+	// the timestamp, rather than the time since, so the value stays correct between sends.
+	if lastSuccess := atomic.LoadInt64(&job.lastSuccess); lastSuccess > 0 {
+		job.Error(ctx, job.StatsClient.Gauge(job.statsName(MetricJobLastSuccessTimestamp), float64(lastSuccess)/float64(time.Second), tags...))
+	}
+}
+
+// statsName returns a metric name with the stats prefix applied.
+func (job *Job) statsName(name string) string {
+	if job.JobConfig.StatsPrefix != "" {
+		return job.JobConfig.StatsPrefix + "." + name
+	}
+	return name
+}
+
+// statsTags returns the tags sent with each metric; the job name
+// and the job labels as `key:value` pairs.
+func (job *Job) statsTags() []string {
+	tags := []string{fmt.Sprintf("%s:%s", stats.TagJob, job.Name())}
+	labels := job.JobConfig.Labels
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		tags = append(tags, fmt.Sprintf("%s:%s", key, labels[key]))
+	}
+	return tags
+}
+
+// notifySentry reports a job failure to sentry with the invocation details.
+// Each failure is reported once, from `OnError`; a job breaking isn't reported again.
+// When a job is fixed, it sends an info level note with the same fingerprint
+// so the resolution shows up on the job's sentry issue.
+func (job *Job) notifySentry(ctx context.Context, flag string) {
+	if job.SentryClient == nil {
+		return
+	}
+	ji := NewJobInvocation(cron.GetJobInvocation(ctx))
+	if ji == nil {
+		return
+	}
+	sentryCtx := NewSentryContext(ctx, flag, job.JobConfig.Labels, ji)
+	if flag == cron.FlagFixed {
+		job.Debugf(ctx, "notify (sentry); sending sentry resolution note")
+		job.SentryClient.Notify(sentryCtx, logger.NewErrorEvent(logger.Info, ex.New(fmt.Sprintf("%s fixed", ji.JobName))))
+		return
+	}
+	err := ji.Err
+	if err == nil {
+		err = ex.New(fmt.Sprintf("%s %s", ji.JobName, flag))
+	}
+	job.Debugf(ctx, "notify (sentry); sending sentry error")
+	job.SentryClient.Notify(sentryCtx, logger.NewErrorEvent(logger.Error, err))
 }
 
-func (job *Job) notifySlack(ctx context.Context, item interface{}) error {
+func (job *Job) notifySlack(ctx context.Context, item interface{}) (err error) {
+	ctx, span := job.startNotifySpan(ctx, NotificationChannelSlack)
+	defer func() { endSpan(span, err) }()
+
 	if ji := cron.GetJobInvocation(ctx); ji != nil {
+		message, ok := item.(slack.Message)
+		if !ok {
+			return ex.New("notify (slack); invalid work item; not a `slack.Message`")
+		}
 		job.Debugf(ctx, "notify (slack); sending slack notification")
-		return job.SlackClient.Send(context.Background(), item.(slack.Message))
+		return job.SlackClient.Send(ctx, message)
 	}
 	return nil
 }
 
-func (job *Job) notifyEmail(ctx context.Context, item interface{}) error {
+func (job *Job) notifyEmail(ctx context.Context, item interface{}) (err error) {
+	ctx, span := job.startNotifySpan(ctx, NotificationChannelEmail)
+	defer func() { endSpan(span, err) }()
+
 	if ji := cron.GetJobInvocation(ctx); ji != nil {
 		message, ok := item.(email.Message)
 		if !ok {
 			return ex.New("notify (email); invalid work item; not a `email.Message`")
 		}
 		job.Debugf(ctx, "notify (email); sending email notification to %s (%s)", stringutil.CSV(message.To), message.Subject)
-		return job.EmailClient.Send(context.Background(), message)
+		return job.EmailClient.Send(ctx, message)
 	}
 	return nil
 }
 
-func (job *Job) notifyWebhook(ctx context.Context, _ interface{}) error {
+func (job *Job) notifyWebhook(ctx context.Context, _ interface{}) (err error) {
+	ctx, span := job.startNotifySpan(ctx, NotificationChannelWebhook)
+	defer func() { endSpan(span, err) }()
+
+	options := []r2.Option{r2.OptLog(job.Log), r2.OptContext(ctx)}
+	for key, values := range TraceContextHeaders(ctx) {
+		if len(values) > 0 {
+			options = append(options, r2.OptHeaderValue(key, values[0]))
+		}
+	}
+
 	job.Debugf(ctx, "notify (webhook); sending webhook notification")
-	res, err := job.WebhookDefaults.Request(r2.OptLog(job.Log)).Discard()
+	res, err := job.WebhookDefaults.Request(options...).Discard()
 	if err != nil {
 		return err
 	}
@@ -420,17 +753,12 @@ func (job *Job) notifyWebhook(ctx context.Context, _ interface{}) error {
 
 func (job *Job) notify(ctx context.Context, flag string) {
 	ji := NewJobInvocation(cron.GetJobInvocation(ctx))
+	ctx = job.notifyContext(ctx, ji)
 
 	if job.SlackClient != nil {
 		if ji != nil {
 			message := NewSlackMessage(flag, job.SlackDefaults, ji)
-			if job.NotificationsQueueSlack != nil && job.NotificationsQueueSlack.Latch.IsStarted() {
-				job.Debugf(ctx, "notify (slack); queueing slack notification")
-				job.NotificationsQueueSlack.Add(ctx, message)
-			} else {
-				job.Debugf(ctx, "notify (slack); sending slack notification")
-				job.Error(ctx, job.SlackClient.Send(ctx, message))
-			}
+			job.enqueueNotification(ctx, NotificationChannelSlack, job.NotificationsQueueSlack, message, job.notifySlack)
 		}
 	} else {
 		job.Debugf(ctx, "notify (slack); sender unset skipping queuing slack notification")
@@ -442,30 +770,76 @@ func (job *Job) notify(ctx context.Context, flag string) {
 			if err != nil {
 				job.Error(ctx, err)
 			}
-			if job.NotificationsQueueEmail != nil && job.NotificationsQueueEmail.Latch.IsStarted() {
-				job.Debugf(ctx, "notify (email); queueing email notification")
-				job.NotificationsQueueEmail.Add(ctx, message)
-			} else {
-				job.Debugf(ctx, "notify (email); sending email notification")
-				job.Error(ctx, job.EmailClient.Send(ctx, message))
-			}
+			job.enqueueNotification(ctx, NotificationChannelEmail, job.NotificationsQueueEmail, message, job.notifyEmail)
 		}
 	} else {
 		job.Debugf(ctx, "notify (email); sender unset, skipping sending email notification")
 	}
 
 	if !job.WebhookDefaults.IsZero() {
-		if job.NotificationsQueueEmail != nil && job.NotificationsQueueEmail.Latch.IsStarted() {
-			job.Debugf(ctx, "notify (webhook); queueing webhook notification")
-			job.NotificationsQueueWebhook.Add(ctx, flag)
-		} else {
-			job.Error(ctx, job.notifyWebhook(ctx, flag))
-		}
+		job.enqueueNotification(ctx, NotificationChannelWebhook, job.NotificationsQueueWebhook, flag, job.notifyWebhook)
 	} else {
 		job.Debugf(ctx, "notify (webhook); sender unset, skipping sending webhook notification")
 	}
 }
 
+// enqueueNotification adds a notification to the shared dispatcher if it's set, then the job's own queue
+// if it's started, and otherwise sends the notification synchronously.
+func (job *Job) enqueueNotification(ctx context.Context, channel string, queue *RetryQueue, item interface{}, send func(context.Context, interface{}) error) {
+	if job.NotificationsDispatcher != nil && job.NotificationsDispatcher.IsStarted() {
+		job.Debugf(ctx, "notify (%s); dispatching %s notification", channel, channel)
+		job.Error(ctx, job.NotificationsDispatcher.Add(ctx, channel, job, item))
+		return
+	}
+	if queue != nil && queue.Latch.IsStarted() {
+		job.Debugf(ctx, "notify (%s); queueing %s notification", channel, channel)
+		queue.Add(ctx, item)
+		return
+	}
+	job.Debugf(ctx, "notify (%s); sending %s notification", channel, channel)
+	job.Error(ctx, send(ctx, item))
+}
+
+// observeOutcome records the outcome of the invocation in a context, returning the invocation,
+// if the previous invocation was skipped, and the outcome of the last invocation that wasn't skipped.
+func (job *Job) observeOutcome(ctx context.Context) (ji *JobInvocation, previousSkipped bool, lastRun jobOutcome) {
+	if ji = NewJobInvocation(cron.GetJobInvocation(ctx)); ji != nil {
+		previousSkipped, lastRun = job.outcomes.Observe(ji)
+	}
+	return
+}
+
+// notifyContext returns a context for sending notifications that parents notification spans
+// to the job invocation span, as lifecycle hooks are not called with the execute context.
+func (job *Job) notifyContext(ctx context.Context, ji *JobInvocation) context.Context {
+	ctx = WithTracerProvider(ctx, job.tracerProvider())
+	if ji != nil && ji.SpanContext.IsValid() {
+		ctx = trace.ContextWithSpanContext(ctx, ji.SpanContext)
+	}
+	return ctx
+}
+
+// startNotifySpan starts a span for sending a notification on a given channel.
+func (job *Job) startNotifySpan(ctx context.Context, channel string) (context.Context, trace.Span) {
+	attributes := []attribute.KeyValue{
+		attribute.String("notification.channel", channel),
+	}
+	if ji := cron.GetJobInvocation(ctx); ji != nil {
+		attributes = append(attributes,
+			attribute.String("job.name", ji.JobName),
+			attribute.String("job.invocation_id", ji.ID),
+		)
+	}
+	return startSpan(ctx, SpanJobNotify, attributes...)
+}
+
+func (job *Job) tracerProvider() trace.TracerProvider {
+	if job.TracerProvider != nil {
+		return job.TracerProvider
+	}
+	return otel.GetTracerProvider()
+}
+
 //
 // history utils
 //
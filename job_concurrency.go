@@ -0,0 +1,419 @@
+package jobkit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/ex"
+	"github.com/blend/go-sdk/logger"
+)
+
+// Concurrency errors.
+const (
+	ErrJobConcurrencyInvalid ex.Class = "job concurrency policy invalid"
+	ErrJobConcurrencySkipped ex.Class = "job invocation skipped; previous invocation still running"
+)
+
+// NewJobConcurrency returns a new job concurrency gate for a given config.
+func NewJobConcurrency(cfg JobConcurrencyConfig) *JobConcurrency {
+	return &JobConcurrency{
+		Config:  cfg,
+		running: make(map[string]jobConcurrencyInvocation),
+		changed: make(chan struct{}),
+	}
+}
+
+// JobConcurrency applies a job's concurrency policy to its invocations as they start.
+//
+// The job manager only runs one invocation of a job at a time, so invocations that start while the
+// job manager's invocation is running are run detached from it (see `RunJob`), and scheduled runs
+// the job manager passes over while its invocation is running are run detached as well, unless the
+// policy is `skip`, in which case they're only counted as skipped.
+type JobConcurrency struct {
+	sync.Mutex
+	Config JobConcurrencyConfig
+
+	running map[string]jobConcurrencyInvocation
+	queue   []string
+	changed chan struct{}
+}
+
+// jobConcurrencyInvocation is a running invocation.
+type jobConcurrencyInvocation struct {
+	cancel context.CancelFunc
+}
+
+// Begin admits an invocation under the concurrency policy, waiting if the policy is `queue` or `cancelPrevious`.
+//
+// It returns a context that is cancelled if a later invocation cancels it, and a function to call when the
+// invocation completes. If the invocation isn't admitted, it returns an `ErrJobConcurrencySkipped` error.
+func (jc *JobConcurrency) Begin(ctx context.Context, id string) (context.Context, func(), error) {
+	jc.Lock()
+	defer jc.Unlock()
+
+	switch jc.Config.PolicyOrDefault() {
+	case JobConcurrencyPolicyQueue:
+		if len(jc.running) > 0 || len(jc.queue) > 0 {
+			if len(jc.queue) >= jc.Config.MaxQueueDepthOrDefault() {
+				return nil, nil, ex.New(ErrJobConcurrencySkipped, ex.OptMessagef("running: %d; queued: %d", len(jc.running), len(jc.queue)))
+			}
+			jc.queue = append(jc.queue, id)
+			for len(jc.running) > 0 || jc.queue[0] != id {
+				if err := jc.wait(ctx); err != nil {
+					jc.dequeue(id)
+					return nil, nil, err
+				}
+			}
+			jc.dequeue(id)
+		}
+	case JobConcurrencyPolicyCancelPrevious:
+		// another waiting invocation may have been admitted while this one waited, so
+		// whatever is running is cancelled each time.
+		for len(jc.running) > 0 {
+			for _, invocation := range jc.running {
+				invocation.cancel()
+			}
+			if err := jc.wait(ctx); err != nil {
+				return nil, nil, err
+			}
+		}
+	case JobConcurrencyPolicyParallel:
+		if len(jc.running) >= jc.Config.MaxParallelOrDefault() {
+			return nil, nil, ex.New(ErrJobConcurrencySkipped, ex.OptMessagef("running: %d", len(jc.running)))
+		}
+	default:
+		if len(jc.running) > 0 {
+			return nil, nil, ex.New(ErrJobConcurrencySkipped, ex.OptMessagef("running: %d", len(jc.running)))
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	jc.running[id] = jobConcurrencyInvocation{
+		cancel: cancel,
+	}
+	return ctx, func() {
+		jc.Lock()
+		defer jc.Unlock()
+		cancel()
+		delete(jc.running, id)
+		jc.notify()
+	}, nil
+}
+
+// Running returns the number of running invocations.
+func (jc *JobConcurrency) Running() int {
+	jc.Lock()
+	defer jc.Unlock()
+	return len(jc.running)
+}
+
+// Queued returns the number of invocations waiting to run.
+func (jc *JobConcurrency) Queued() int {
+	jc.Lock()
+	defer jc.Unlock()
+	return len(jc.queue)
+}
+
+// wait waits for a running invocation to complete or for the context to be cancelled.
+//
+// It must be called with the lock held.
+func (jc *JobConcurrency) wait(ctx context.Context) error {
+	changed := jc.changed
+	jc.Unlock()
+	defer jc.Lock()
+	select {
+	case <-changed:
+		return nil
+	case <-ctx.Done():
+		return ex.New(ctx.Err())
+	}
+}
+
+// dequeue removes an invocation from the queue, either because it's next or because it stopped waiting.
+//
+// It must be called with the lock held.
+func (jc *JobConcurrency) dequeue(id string) {
+	for index, queued := range jc.queue {
+		if queued == id {
+			jc.queue = append(jc.queue[:index], jc.queue[index+1:]...)
+			break
+		}
+	}
+	jc.notify()
+}
+
+// notify wakes the invocations waiting for a change.
+//
+// It must be called with the lock held.
+func (jc *JobConcurrency) notify() {
+	close(jc.changed)
+	jc.changed = make(chan struct{})
+}
+
+// jobDetachedInvocations are the cancel functions of a job's running detached invocations by id,
+// as the job manager only tracks its own invocation.
+type jobDetachedInvocations struct {
+	sync.Mutex
+	invocations map[string]*cron.JobInvocation
+	cancels     map[string]context.CancelFunc
+}
+
+// add registers a running detached invocation.
+func (jdi *jobDetachedInvocations) add(ji *cron.JobInvocation, cancel context.CancelFunc) {
+	jdi.Lock()
+	defer jdi.Unlock()
+	if jdi.cancels == nil {
+		jdi.invocations = make(map[string]*cron.JobInvocation)
+		jdi.cancels = make(map[string]context.CancelFunc)
+	}
+	jdi.invocations[ji.ID] = ji
+	jdi.cancels[ji.ID] = cancel
+}
+
+// remove unregisters a detached invocation once it completes.
+func (jdi *jobDetachedInvocations) remove(id string) {
+	jdi.Lock()
+	defer jdi.Unlock()
+	delete(jdi.invocations, id)
+	delete(jdi.cancels, id)
+}
+
+// invocation returns a running detached invocation by id, or nil if it isn't running.
+func (jdi *jobDetachedInvocations) invocation(id string) *cron.JobInvocation {
+	jdi.Lock()
+	defer jdi.Unlock()
+	return jdi.invocations[id]
+}
+
+// cancel cancels a running detached invocation by id, returning if it was running.
+func (jdi *jobDetachedInvocations) cancel(id string) bool {
+	jdi.Lock()
+	defer jdi.Unlock()
+	cancel, ok := jdi.cancels[id]
+	if ok {
+		cancel()
+	}
+	return ok
+}
+
+// cancelAll cancels the running detached invocations, returning how many were running.
+func (jdi *jobDetachedInvocations) cancelAll() int {
+	jdi.Lock()
+	defer jdi.Unlock()
+	for _, cancel := range jdi.cancels {
+		cancel()
+	}
+	return len(jdi.cancels)
+}
+
+type jobDetachedKey struct{}
+
+// withJobDetached marks a context as a detached invocation.
+func withJobDetached(ctx context.Context) context.Context {
+	return context.WithValue(ctx, jobDetachedKey{}, true)
+}
+
+// isJobDetached returns if a context is a detached invocation.
+func isJobDetached(ctx context.Context) bool {
+	detached, _ := ctx.Value(jobDetachedKey{}).(bool)
+	return detached
+}
+
+// RunJob runs a job by name with the parameters in a given context, honoring the job's concurrency policy.
+//
+// If the job is a jobkit job and the job manager's invocation of it is still running, the job is run
+// detached from the job manager, otherwise it's run by the job manager.
+//
+// It returns the invocation before it completes, and a channel that's closed when it completes;
+// as with the job manager, the invocation is updated as it runs.
+func RunJob(ctx context.Context, jm *cron.JobManager, jobName string) (*cron.JobInvocation, <-chan struct{}, error) {
+	js, err := jm.Job(jobName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if job, ok := js.Job.(*Job); ok && job.Concurrency != nil && js.Current() != nil {
+		ji, done := job.runDetached(ctx)
+		return ji, done, nil
+	}
+	return js.RunAsyncContext(ctx)
+}
+
+// CancelJob cancels the running invocations of a job by name, including the invocations
+// run detached from the job manager.
+func CancelJob(jm *cron.JobManager, jobName string) error {
+	js, err := jm.Job(jobName)
+	if err != nil {
+		return err
+	}
+	if job, ok := js.Job.(*Job); ok && job.detached.cancelAll() > 0 && js.Current() == nil {
+		return nil
+	}
+	return jm.CancelJob(jobName)
+}
+
+// CancelJobInvocation cancels a running invocation of a job by id.
+//
+// It isn't an error if the invocation has already completed.
+func CancelJobInvocation(jm *cron.JobManager, jobName, invocationID string) error {
+	js, err := jm.Job(jobName)
+	if err != nil {
+		return err
+	}
+	if job, ok := js.Job.(*Job); ok && job.detached.cancel(invocationID) {
+		return nil
+	}
+	if current := js.Current(); current != nil && current.ID == invocationID {
+		return jm.CancelJob(jobName)
+	}
+	return nil
+}
+
+// DetachedJobInvocation returns a running invocation of a job by id that was run detached from the
+// job manager, or nil if there isn't one.
+func DetachedJobInvocation(jm *cron.JobManager, jobName, invocationID string) *cron.JobInvocation {
+	js, err := jm.Job(jobName)
+	if err != nil {
+		return nil
+	}
+	if job, ok := js.Job.(*Job); ok {
+		return job.detached.invocation(invocationID)
+	}
+	return nil
+}
+
+// IsJobInvocationRunning returns if an invocation of a job is running, either as the job manager's
+// current invocation or detached from it.
+func IsJobInvocationRunning(jm *cron.JobManager, jobName, invocationID string) bool {
+	if DetachedJobInvocation(jm, jobName, invocationID) != nil {
+		return true
+	}
+	js, err := jm.Job(jobName)
+	if err != nil {
+		return false
+	}
+	current := js.Current()
+	return current != nil && current.ID == invocationID
+}
+
+// runPassedOver runs the scheduled runs the job manager passes over while its invocation of the job is
+// running, detached from the job manager so the job's concurrency policy applies to them.
+//
+// It's called by the job manager's invocation and returns when the context is cancelled as it completes.
+func (job *Job) runPassedOver(ctx context.Context) {
+	after := cron.Now()
+	for {
+		next := job.JobSchedule.Next(after)
+		if next.IsZero() {
+			return
+		}
+		timer := time.NewTimer(next.Sub(cron.Now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		// the invocation may have completed as the timer fired, in which case the job manager runs it.
+		if ctx.Err() != nil {
+			return
+		}
+		job.runDetached(context.Background())
+		after = next
+	}
+}
+
+// skipPassedOver records the scheduled runs passed over since a given start as skipped, without
+// creating invocations for them, so no history is written and no lifecycle handlers are called.
+func (job *Job) skipPassedOver(ctx context.Context, started time.Time) {
+	skipped := len(job.MissedRuns(started, cron.Now(), DefaultJobConcurrencyMaxSkipped))
+	if skipped == 0 {
+		return
+	}
+	logger.MaybeInfofContext(ctx, job.Log, "%s: skipped %d scheduled run(s) while the previous invocation was running", job.Name(), skipped)
+	job.metrics.skipped(int64(skipped))
+	if job.StatsClient != nil {
+		job.Error(ctx, job.StatsClient.Count(job.statsName(FlagSkipped), int64(skipped), job.statsTags()...))
+	}
+}
+
+// runDetached runs an invocation of the job outside of the job manager, applying the job timeout and
+// calling the lifecycle handlers the job manager would. It can be cancelled with `CancelJob` or
+// `CancelJobInvocation`.
+//
+// It returns the invocation before it completes, and a channel that's closed when it completes.
+func (job *Job) runDetached(ctx context.Context) (*cron.JobInvocation, <-chan struct{}) {
+	ji := &cron.JobInvocation{
+		ID:         cron.NewJobInvocationID(),
+		JobName:    job.Name(),
+		Started:    cron.Now(),
+		Status:     cron.JobInvocationStatusRunning,
+		Parameters: cron.MergeJobParameterValues(job.Config().ParameterValues, cron.GetJobParameterValues(ctx)),
+	}
+	invocationCtx := cron.WithJobParameterValues(context.Background(), ji.Parameters)
+	invocationCtx = cron.WithJobInvocation(invocationCtx, ji)
+	invocationCtx = withJobDetached(invocationCtx)
+
+	var cancel context.CancelFunc
+	if timeout := job.Config().TimeoutOrDefault(); timeout > 0 {
+		invocationCtx, cancel = context.WithTimeout(invocationCtx, timeout)
+	} else {
+		invocationCtx, cancel = context.WithCancel(invocationCtx)
+	}
+	job.detached.add(ji, cancel)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer cancel()
+		defer job.detached.remove(ji.ID)
+		job.executeDetached(invocationCtx, ji)
+	}()
+	return ji, done
+}
+
+// executeDetached executes a detached invocation and calls the lifecycle handlers for its result.
+func (job *Job) executeDetached(ctx context.Context, ji *cron.JobInvocation) {
+	lifecycle := job.Lifecycle()
+	lifecycle.OnBegin(ctx)
+
+	err := job.executeRecover(ctx)
+	if err != nil && !cron.IsJobCancelled(err) && ctx.Err() == context.Canceled {
+		err = ex.New(cron.ErrJobCancelled, ex.OptInner(err))
+	}
+	ji.Complete = cron.Now()
+	ji.Err = err
+
+	// as with the job manager, the job is broken or fixed relative to the last invocation that ran;
+	// `OnSuccess` handles the job being fixed when the previous invocation was skipped.
+	_, previousSkipped, lastRun := job.observeOutcome(ctx)
+	switch {
+	case err == nil:
+		ji.Status = cron.JobInvocationStatusSuccess
+		lifecycle.OnSuccess(ctx)
+		if !previousSkipped && lastRun == jobOutcomeErrored {
+			lifecycle.OnFixed(ctx)
+		}
+	case cron.IsJobCancelled(err):
+		ji.Status = cron.JobInvocationStatusCancelled
+		lifecycle.OnCancellation(ctx)
+	default:
+		ji.Status = cron.JobInvocationStatusErrored
+		lifecycle.OnError(ctx)
+		if lastRun == jobOutcomeSuccess {
+			lifecycle.OnBroken(ctx)
+		}
+	}
+	lifecycle.OnComplete(ctx)
+}
+
+// executeRecover executes the job, recovering panics as errors.
+func (job *Job) executeRecover(ctx context.Context) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = ex.New(r)
+		}
+	}()
+	return job.Execute(ctx)
+}
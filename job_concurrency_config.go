@@ -0,0 +1,63 @@
+package jobkit
+
+import (
+	"github.com/blend/go-sdk/ex"
+)
+
+// JobConcurrencyPolicy values.
+const (
+	JobConcurrencyPolicySkip           = "skip"
+	JobConcurrencyPolicyQueue          = "queue"
+	JobConcurrencyPolicyCancelPrevious = "cancelPrevious"
+	JobConcurrencyPolicyParallel       = "parallel"
+)
+
+// JobConcurrencyConfig is a config for what happens when a job is run while a previous invocation is still running,
+// whether from its schedule or a manual run.
+type JobConcurrencyConfig struct {
+	// Policy is one of `skip`, `queue`, `cancelPrevious` or `parallel`, defaulting to `skip`.
+	//
+	// - `skip` records the invocation as skipped.
+	// - `queue` waits for the running invocation to finish, recording the invocation as skipped if the queue is full.
+	// - `cancelPrevious` cancels the running invocations and waits for them to finish.
+	// - `parallel` runs up to `maxParallel` invocations at once, recording the invocation as skipped past that.
+	Policy string `yaml:"policy"`
+	// MaxQueueDepth is the maximum number of invocations waiting to run with the `queue` policy, defaulting to 1.
+	MaxQueueDepth *int `yaml:"maxQueueDepth"`
+	// MaxParallel is the maximum number of invocations running at once with the `parallel` policy, defaulting to 1.
+	MaxParallel *int `yaml:"maxParallel"`
+}
+
+// PolicyOrDefault returns a value or a default.
+func (jcc JobConcurrencyConfig) PolicyOrDefault() string {
+	if jcc.Policy != "" {
+		return jcc.Policy
+	}
+	return DefaultJobConcurrencyPolicy
+}
+
+// MaxQueueDepthOrDefault returns a value or a default.
+func (jcc JobConcurrencyConfig) MaxQueueDepthOrDefault() int {
+	if jcc.MaxQueueDepth != nil {
+		return *jcc.MaxQueueDepth
+	}
+	return DefaultJobConcurrencyMaxQueueDepth
+}
+
+// MaxParallelOrDefault returns a value or a default.
+func (jcc JobConcurrencyConfig) MaxParallelOrDefault() int {
+	if jcc.MaxParallel != nil && *jcc.MaxParallel > 0 {
+		return *jcc.MaxParallel
+	}
+	return DefaultJobConcurrencyMaxParallel
+}
+
+// Validate returns an error if the policy is unknown.
+func (jcc JobConcurrencyConfig) Validate() error {
+	switch jcc.PolicyOrDefault() {
+	case JobConcurrencyPolicySkip, JobConcurrencyPolicyQueue, JobConcurrencyPolicyCancelPrevious, JobConcurrencyPolicyParallel:
+		return nil
+	default:
+		return ex.New(ErrJobConcurrencyInvalid, ex.OptMessagef("policy: %s", jcc.Policy))
+	}
+}
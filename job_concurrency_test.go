@@ -0,0 +1,283 @@
+package jobkit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/ex"
+	"github.com/blend/go-sdk/ref"
+)
+
+func TestJobConcurrencySkip(t *testing.T) {
+	assert := assert.New(t)
+
+	jc := NewJobConcurrency(JobConcurrencyConfig{})
+	_, release, err := jc.Begin(context.Background(), "first")
+	assert.Nil(err)
+	_, _, err = jc.Begin(context.Background(), "second")
+	assert.True(ex.Is(err, ErrJobConcurrencySkipped))
+
+	release()
+	assert.Zero(jc.Running())
+	_, _, err = jc.Begin(context.Background(), "third")
+	assert.Nil(err)
+}
+
+func TestJobConcurrencyParallel(t *testing.T) {
+	assert := assert.New(t)
+
+	jc := NewJobConcurrency(JobConcurrencyConfig{Policy: JobConcurrencyPolicyParallel, MaxParallel: ref.Int(2)})
+	_, _, err := jc.Begin(context.Background(), "first")
+	assert.Nil(err)
+	_, _, err = jc.Begin(context.Background(), "second")
+	assert.Nil(err)
+	_, _, err = jc.Begin(context.Background(), "third")
+	assert.True(ex.Is(err, ErrJobConcurrencySkipped))
+	assert.Equal(2, jc.Running())
+}
+
+func TestJobConcurrencyQueue(t *testing.T) {
+	assert := assert.New(t)
+
+	jc := NewJobConcurrency(JobConcurrencyConfig{Policy: JobConcurrencyPolicyQueue, MaxQueueDepth: ref.Int(1)})
+	_, release, err := jc.Begin(context.Background(), "first")
+	assert.Nil(err)
+
+	admitted := make(chan error)
+	go func() {
+		_, _, err := jc.Begin(context.Background(), "second")
+		admitted <- err
+	}()
+	for jc.Queued() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	_, _, err = jc.Begin(context.Background(), "third")
+	assert.True(ex.Is(err, ErrJobConcurrencySkipped), "the queue should be full")
+
+	release()
+	assert.Nil(<-admitted)
+	assert.Equal(1, jc.Running())
+	assert.Zero(jc.Queued())
+}
+
+func TestJobConcurrencyQueueCancelled(t *testing.T) {
+	assert := assert.New(t)
+
+	jc := NewJobConcurrency(JobConcurrencyConfig{Policy: JobConcurrencyPolicyQueue})
+	_, _, err := jc.Begin(context.Background(), "first")
+	assert.Nil(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err = jc.Begin(ctx, "second")
+	assert.NotNil(err)
+	assert.Zero(jc.Queued())
+}
+
+func TestJobConcurrencyCancelPrevious(t *testing.T) {
+	assert := assert.New(t)
+
+	jc := NewJobConcurrency(JobConcurrencyConfig{Policy: JobConcurrencyPolicyCancelPrevious})
+	firstCtx, release, err := jc.Begin(context.Background(), "first")
+	assert.Nil(err)
+	go func() {
+		<-firstCtx.Done()
+		release()
+	}()
+
+	_, _, err = jc.Begin(context.Background(), "second")
+	assert.Nil(err)
+	assert.NotNil(firstCtx.Err())
+	assert.Equal(1, jc.Running())
+}
+
+func TestJobConcurrencyCancelPreviousOverlapping(t *testing.T) {
+	assert := assert.New(t)
+
+	jc := NewJobConcurrency(JobConcurrencyConfig{Policy: JobConcurrencyPolicyCancelPrevious})
+	begin := func(id string) (context.Context, error) {
+		ctx, release, err := jc.Begin(context.Background(), id)
+		if err != nil {
+			return nil, err
+		}
+		go func() {
+			<-ctx.Done()
+			release()
+		}()
+		return ctx, nil
+	}
+
+	firstCtx, err := begin("first")
+	assert.Nil(err)
+
+	type admitted struct {
+		ctx context.Context
+		err error
+	}
+	results := make(chan admitted, 2)
+	for _, id := range []string{"second", "third"} {
+		go func(id string) {
+			ctx, err := begin(id)
+			results <- admitted{ctx, err}
+		}(id)
+	}
+	earlier, later := <-results, <-results
+	assert.Nil(earlier.err)
+	assert.Nil(later.err)
+	assert.NotNil(firstCtx.Err())
+	assert.NotNil(earlier.ctx.Err(), "the last invocation admitted should cancel the one admitted before it")
+	assert.Nil(later.ctx.Err())
+	assert.Equal(1, jc.Running())
+}
+
+func TestJobExecuteConcurrencySkipped(t *testing.T) {
+	assert := assert.New(t)
+
+	started := make(chan struct{})
+	finish := make(chan struct{})
+	job := MustNewJob(cron.NewJob(cron.OptJobName("test-job"), cron.OptJobAction(func(_ context.Context) error {
+		close(started)
+		<-finish
+		return nil
+	})))
+
+	firstCtx, _ := createTestRetryJobContext(job)
+	done := make(chan error)
+	go func() { done <- job.Execute(firstCtx) }()
+	<-started
+
+	secondCtx, second := createTestRetryJobContext(job)
+	assert.Nil(job.Execute(secondCtx))
+	assert.True(second.State.(*JobInvocationOutput).Skipped)
+	assert.Equal(JobInvocationStatusSkipped, NewJobInvocation(&cron.JobInvocation{Status: cron.JobInvocationStatusSuccess, State: second.State}).Status)
+
+	close(finish)
+	assert.Nil(<-done)
+}
+
+func TestJobExecuteConcurrencyCancelPrevious(t *testing.T) {
+	assert := assert.New(t)
+
+	started := make(chan struct{}, 2)
+	job := MustNewJob(cron.NewJob(cron.OptJobName("test-job"), cron.OptJobAction(func(ctx context.Context) error {
+		started <- struct{}{}
+		<-ctx.Done()
+		return ctx.Err()
+	})), OptJobConfig(JobConfig{Concurrency: JobConcurrencyConfig{Policy: JobConcurrencyPolicyCancelPrevious}}))
+
+	firstCtx, _ := createTestRetryJobContext(job)
+	done := make(chan error)
+	go func() { done <- job.Execute(firstCtx) }()
+	<-started
+
+	secondCtx, _ := createTestRetryJobContext(job)
+	secondCtx, cancel := context.WithCancel(secondCtx)
+	secondDone := make(chan error)
+	go func() { secondDone <- job.Execute(secondCtx) }()
+	assert.True(cron.IsJobCancelled(<-done), "the first invocation should be cancelled by the second")
+
+	<-started
+	cancel()
+	assert.NotNil(<-secondDone)
+}
+
+func TestJobConcurrencyConfigValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(JobConcurrencyConfig{}.Validate())
+	assert.Nil(JobConcurrencyConfig{Policy: JobConcurrencyPolicyCancelPrevious}.Validate())
+	assert.True(ex.Is(JobConcurrencyConfig{Policy: "sometimes"}.Validate(), ErrJobConcurrencyInvalid))
+
+	_, err := NewJob(cron.NewJob(cron.OptJobName("test-job")), OptJobConfig(JobConfig{Concurrency: JobConcurrencyConfig{Policy: "sometimes"}}))
+	assert.True(ex.Is(err, ErrJobConcurrencyInvalid))
+}
+
+func TestJobSkipPassedOver(t *testing.T) {
+	assert := assert.New(t)
+
+	stats := newMockStatsCollector()
+	job := MustNewJob(cron.NewJob(cron.OptJobName("test-job")), OptJobParsedSchedule("@every 1m"))
+	job.StatsClient = stats
+
+	job.skipPassedOver(context.Background(), cron.Now().Add(-(3*time.Minute + 30*time.Second)))
+	assert.Equal(int64(3), job.Metrics().Runs[JobInvocationStatusSkipped])
+	metric := <-stats.Metrics
+	assert.Equal("count", metric.Kind)
+	assert.Equal(job.statsName(FlagSkipped), metric.Name)
+	assert.Equal(3.0, metric.Value)
+	assert.Empty(job.detached.cancels, "skipped runs should not create invocations")
+
+	job.skipPassedOver(context.Background(), cron.Now())
+	assert.Equal(int64(3), job.Metrics().Runs[JobInvocationStatusSkipped])
+	assert.Empty(stats.Metrics)
+}
+
+func TestJobRunDetachedTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	job := MustNewJob(cron.NewJob(cron.OptJobName("test-job"), cron.OptJobAction(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})), OptJobConfig(JobConfig{JobConfig: cron.JobConfig{Timeout: 10 * time.Millisecond}}))
+
+	ji, done := job.runDetached(context.Background())
+	<-done
+	assert.Equal(cron.JobInvocationStatusErrored, ji.Status)
+	assert.NotNil(ji.Err)
+}
+
+func TestJobRunDetachedCancel(t *testing.T) {
+	assert := assert.New(t)
+
+	started := make(chan struct{})
+	job := MustNewJob(cron.NewJob(cron.OptJobName("test-job"), cron.OptJobAction(func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})))
+
+	ji, done := job.runDetached(context.Background())
+	<-started
+	assert.False(job.detached.cancel("not-an-invocation"))
+	assert.True(job.detached.cancel(ji.ID))
+	<-done
+	assert.Equal(cron.JobInvocationStatusCancelled, ji.Status)
+	assert.True(cron.IsJobCancelled(ji.Err))
+	assert.False(job.detached.cancel(ji.ID), "completed invocations should be unregistered")
+}
+
+func TestJobRunDetachedBroken(t *testing.T) {
+	assert := assert.New(t)
+
+	var fail bool
+	job := MustNewJob(cron.NewJob(cron.OptJobName("test-job"), cron.OptJobAction(func(_ context.Context) error {
+		if fail {
+			return fmt.Errorf("only a test")
+		}
+		return nil
+	})))
+	collector := newMockStatsCollector()
+	job.StatsClient = collector
+
+	_, done := job.runDetached(context.Background())
+	<-done
+	fail = true
+	_, done = job.runDetached(context.Background())
+	<-done
+	fail = false
+	_, done = job.runDetached(context.Background())
+	<-done
+
+	flags := make(map[string]int)
+	for len(collector.Metrics) > 0 {
+		if metric := <-collector.Metrics; metric.Kind == "count" {
+			flags[metric.Name]++
+		}
+	}
+	assert.Equal(1, flags[cron.FlagBroken])
+	assert.Equal(1, flags[cron.FlagFixed])
+}
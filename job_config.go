@@ -18,8 +18,17 @@ type JobConfig struct {
 	HistoryDisabled   *bool                  `yaml:"historyDisabled"`
 	HistoryMaxAge     *time.Duration         `yaml:"historyMaxAge"`
 	HistoryMaxCount   *int                   `yaml:"historyMaxCount"`
+	StatsPrefix       string                 `yaml:"statsPrefix"`
 	Parameters        []Parameter            `yaml:"parameters"`
 	Notifications     JobNotificationsConfig `yaml:"notifications"`
+	HTTP              HTTPActionConfig       `yaml:"http"`
+	SQL               SQLActionConfig        `yaml:"sql"`
+	Steps             []WorkflowStepConfig   `yaml:"steps"`
+	Triggers          []JobTriggerConfig     `yaml:"triggers"`
+	Retry             JobRetryConfig         `yaml:"retry"`
+	Concurrency       JobConcurrencyConfig   `yaml:"concurrency"`
+	Misfire           JobMisfireConfig       `yaml:"misfire"`
+	Lock              JobLockConfig          `yaml:"lock"`
 }
 
 // ScheduleOrDefault returns a value or a default.
@@ -0,0 +1,154 @@
+package jobkit
+
+import (
+	"sort"
+	"time"
+)
+
+// NewJobGraph returns the graph of jobs and the triggers between them, from
+// the triggers of each job by job name.
+func NewJobGraph(triggers map[string][]JobTriggerConfig) JobGraph {
+	names := make(map[string]bool)
+	var graph JobGraph
+	for jobName, jobTriggers := range triggers {
+		names[jobName] = true
+		for _, trigger := range jobTriggers {
+			for _, upstream := range trigger.After {
+				names[upstream] = true
+				graph.Edges = append(graph.Edges, JobGraphEdge{
+					From:   upstream,
+					To:     jobName,
+					On:     trigger.OnOrDefault(),
+					Window: trigger.WindowOrDefault(),
+				})
+			}
+		}
+	}
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].To != graph.Edges[j].To {
+			return graph.Edges[i].To < graph.Edges[j].To
+		}
+		return graph.Edges[i].From < graph.Edges[j].From
+	})
+
+	levels := make(map[string]int)
+	for name := range names {
+		graph.Nodes = append(graph.Nodes, JobGraphNode{
+			Name:  name,
+			Level: graph.level(name, levels, make(map[string]bool)),
+		})
+	}
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		if graph.Nodes[i].Level != graph.Nodes[j].Level {
+			return graph.Nodes[i].Level < graph.Nodes[j].Level
+		}
+		return graph.Nodes[i].Name < graph.Nodes[j].Name
+	})
+	return graph
+}
+
+// JobGraph is the graph of jobs and the triggers between them.
+type JobGraph struct {
+	Nodes []JobGraphNode `json:"nodes"`
+	Edges []JobGraphEdge `json:"edges"`
+}
+
+// JobGraphNode is a job in a job graph.
+type JobGraphNode struct {
+	Name string `json:"name"`
+	// Level is the length of the longest chain of triggers that runs the job; jobs that no trigger runs are level 0.
+	Level int `json:"level"`
+}
+
+// JobGraphEdge is a trigger in a job graph; the `To` job runs after the `From` job finishes.
+type JobGraphEdge struct {
+	From   string        `json:"from"`
+	To     string        `json:"to"`
+	On     string        `json:"on"`
+	Window time.Duration `json:"window,omitempty"`
+}
+
+// Levels returns the nodes grouped by level.
+func (jg JobGraph) Levels() (output [][]JobGraphNode) {
+	for _, node := range jg.Nodes {
+		for len(output) <= node.Level {
+			output = append(output, nil)
+		}
+		output[node.Level] = append(output[node.Level], node)
+	}
+	return
+}
+
+// Upstream returns the edges to a given job.
+func (jg JobGraph) Upstream(jobName string) (output []JobGraphEdge) {
+	for _, edge := range jg.Edges {
+		if edge.To == jobName {
+			output = append(output, edge)
+		}
+	}
+	return
+}
+
+// Downstream returns the edges from a given job.
+func (jg JobGraph) Downstream(jobName string) (output []JobGraphEdge) {
+	for _, edge := range jg.Edges {
+		if edge.From == jobName {
+			output = append(output, edge)
+		}
+	}
+	return
+}
+
+// Cycle returns the names of the jobs in a cycle of triggers, starting and ending with the same job,
+// or nil if there are no cycles.
+func (jg JobGraph) Cycle() []string {
+	visited := make(map[string]bool)
+	for _, node := range jg.Nodes {
+		if cycle := jg.cycle(node.Name, visited, nil); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// cycle walks the downstream edges of a job depth first, returning the path
+// of the first cycle it finds.
+func (jg JobGraph) cycle(jobName string, visited map[string]bool, path []string) []string {
+	for index, name := range path {
+		if name == jobName {
+			return append(append([]string(nil), path[index:]...), jobName)
+		}
+	}
+	if visited[jobName] {
+		return nil
+	}
+	visited[jobName] = true
+	path = append(path, jobName)
+	for _, edge := range jg.Downstream(jobName) {
+		if cycle := jg.cycle(edge.To, visited, path); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// level returns the level of a job, ignoring edges that would complete a cycle.
+func (jg JobGraph) level(jobName string, levels map[string]int, visiting map[string]bool) int {
+	if level, ok := levels[jobName]; ok {
+		return level
+	}
+	visiting[jobName] = true
+	defer delete(visiting, jobName)
+
+	var level int
+	for _, edge := range jg.Upstream(jobName) {
+		if visiting[edge.From] {
+			continue
+		}
+		if upstream := jg.level(edge.From, levels, visiting) + 1; upstream > level {
+			level = upstream
+		}
+	}
+	levels[jobName] = level
+	return level
+}
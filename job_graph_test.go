@@ -0,0 +1,64 @@
+package jobkit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/ref"
+)
+
+func TestNewJobGraph(t *testing.T) {
+	assert := assert.New(t)
+
+	graph := NewJobGraph(map[string][]JobTriggerConfig{
+		"extract": nil,
+		"load":    {{After: []string{"transform"}}},
+		"transform": {
+			{After: []string{"extract"}, On: JobTriggerOnComplete, Window: ref.Duration(time.Hour)},
+		},
+		"report": {{After: []string{"extract", "load"}}},
+		"other":  nil,
+	})
+
+	assert.Len(graph.Nodes, 5)
+	assert.Equal("extract", graph.Nodes[0].Name)
+	assert.Equal(0, graph.Nodes[0].Level)
+	assert.Equal("other", graph.Nodes[1].Name)
+	assert.Equal(0, graph.Nodes[1].Level)
+	assert.Equal("transform", graph.Nodes[2].Name)
+	assert.Equal(1, graph.Nodes[2].Level)
+	assert.Equal("load", graph.Nodes[3].Name)
+	assert.Equal(2, graph.Nodes[3].Level)
+	assert.Equal("report", graph.Nodes[4].Name)
+	assert.Equal(3, graph.Nodes[4].Level)
+
+	assert.Len(graph.Levels(), 4)
+	assert.Len(graph.Levels()[0], 2)
+
+	assert.Len(graph.Edges, 4)
+	upstream := graph.Upstream("transform")
+	assert.Len(upstream, 1)
+	assert.Equal("extract", upstream[0].From)
+	assert.Equal(JobTriggerOnComplete, upstream[0].On)
+	assert.Equal(time.Hour, upstream[0].Window)
+	assert.Len(graph.Downstream("extract"), 2)
+
+	assert.Nil(graph.Cycle())
+}
+
+func TestJobGraphCycle(t *testing.T) {
+	assert := assert.New(t)
+
+	graph := NewJobGraph(map[string][]JobTriggerConfig{
+		"a": {{After: []string{"c"}}},
+		"b": {{After: []string{"a"}}},
+		"c": {{After: []string{"b"}}},
+	})
+	assert.Equal([]string{"a", "b", "c", "a"}, graph.Cycle())
+
+	graph = NewJobGraph(map[string][]JobTriggerConfig{
+		"a": {{After: []string{"a"}}},
+	})
+	assert.Equal([]string{"a", "a"}, graph.Cycle())
+}
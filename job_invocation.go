@@ -10,6 +10,13 @@ import (
 	"github.com/blend/go-sdk/ex"
 )
 
+// JobInvocationStatusSkipped is the status of invocations that completed without errors but were
+// skipped by the action; they count towards neither success nor failure.
+const JobInvocationStatusSkipped cron.JobInvocationStatus = "skipped"
+
+// FlagSkipped is the stats flag for skipped invocations.
+const FlagSkipped = "cron.skipped"
+
 // NewJobInvocation creates a new jobkit job invocation from a cron job invocation.
 func NewJobInvocation(ji *cron.JobInvocation) *JobInvocation {
 	if ji == nil {
@@ -20,6 +27,9 @@ func NewJobInvocation(ji *cron.JobInvocation) *JobInvocation {
 	}
 	if typed, ok := invocation.State.(*JobInvocationOutput); ok && typed != nil {
 		invocation.JobInvocationOutput = *typed
+		if typed.Skipped && invocation.Status == cron.JobInvocationStatusSuccess {
+			invocation.Status = JobInvocationStatusSkipped
+		}
 	}
 	return invocation
 }
@@ -51,6 +61,30 @@ func (ji JobInvocation) MarshalJSON() ([]byte, error) {
 	if ji.Err != nil {
 		values["err"] = ji.Err.Error()
 	}
+	if ji.ExitInfo != nil {
+		values["exitInfo"] = ji.ExitInfo
+	}
+	if ji.HTTPSummary != nil {
+		values["httpSummary"] = ji.HTTPSummary
+	}
+	if len(ji.SQLSummary) > 0 {
+		values["sqlSummary"] = ji.SQLSummary
+	}
+	if len(ji.Steps) > 0 {
+		values["steps"] = ji.Steps
+	}
+	if len(ji.Attempts) > 0 {
+		values["attempts"] = ji.Attempts
+	}
+	if ji.Lock != nil {
+		values["lock"] = ji.Lock
+	}
+	if len(ji.Artifacts) > 0 {
+		values["artifacts"] = ArtifactsMetadata(ji.Artifacts)
+	}
+	if streams := ji.OutputStreams.Values(); len(streams) > 0 {
+		values["outputStreams"] = streams
+	}
 	contents, err := json.Marshal(values)
 	if err != nil {
 		return nil, ex.New(err)
@@ -69,6 +103,14 @@ func (ji *JobInvocation) UnmarshalJSON(contents []byte) error {
 		Error      string                   `json:"err"`
 		Parameters map[string]string        `json:"parameters"`
 		Output     json.RawMessage          `json:"output"`
+		ExitInfo   *ExitInfo                `json:"exitInfo"`
+		Artifacts  []Artifact               `json:"artifacts"`
+		Streams    []OutputStream           `json:"outputStreams"`
+		HTTP       *HTTPSummary             `json:"httpSummary"`
+		SQL        []SQLStatementSummary    `json:"sqlSummary"`
+		Steps      []WorkflowStepResult     `json:"steps"`
+		Attempts   []JobAttempt             `json:"attempts"`
+		Lock       *JobLockResult           `json:"lock"`
 	}
 	if err := json.Unmarshal(contents, &values); err != nil {
 		return ex.New(err)
@@ -82,12 +124,20 @@ func (ji *JobInvocation) UnmarshalJSON(contents []byte) error {
 		ji.Err = errors.New(values.Error)
 	}
 	ji.Parameters = values.Parameters
+	ji.ExitInfo = values.ExitInfo
+	ji.Artifacts = values.Artifacts
+	ji.HTTPSummary = values.HTTP
+	ji.SQLSummary = values.SQL
+	ji.Steps = values.Steps
+	ji.Attempts = values.Attempts
+	ji.Lock = values.Lock
 	ji.Output = new(bufferutil.Buffer)
 	if err := json.Unmarshal([]byte(values.Output), ji.JobInvocationOutput.Output); err != nil {
 		return ex.New(err)
 	}
 	handlers := new(bufferutil.BufferHandlers)
-	ji.Output.Handler = handlers.Handle
+	ji.OutputStreams = &OutputStreams{Streams: values.Streams}
+	ji.Output.Handler = ji.OutputStreams.Handler(handlers.Handle)
 	ji.OutputHandlers = handlers
 	return nil
 }
@@ -2,8 +2,10 @@ package jobkit
 
 import (
 	"context"
+	"io"
 
 	"github.com/blend/go-sdk/bufferutil"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type jobInvocationOutputKey struct{}
@@ -26,11 +28,13 @@ func WithJobInvocationOutput(ctx context.Context, jio *JobInvocationOutput) cont
 // NewJobInvocationOutput returns a new job invocation output.
 func NewJobInvocationOutput() *JobInvocationOutput {
 	outputHandlers := new(bufferutil.BufferHandlers)
+	outputStreams := new(OutputStreams)
 	output := new(bufferutil.Buffer)
-	output.Handler = outputHandlers.Handle
+	output.Handler = outputStreams.Handler(outputHandlers.Handle)
 	return &JobInvocationOutput{
 		Output:         output,
 		OutputHandlers: outputHandlers,
+		OutputStreams:  outputStreams,
 	}
 }
 
@@ -38,4 +42,96 @@ func NewJobInvocationOutput() *JobInvocationOutput {
 type JobInvocationOutput struct {
 	Output         *bufferutil.Buffer
 	OutputHandlers *bufferutil.BufferHandlers
+	// OutputStreams are the streams the output chunks were written to.
+	OutputStreams *OutputStreams
+	// Skipped is set if the invocation had nothing to do, e.g. a shell action exited with its skip exit code.
+	Skipped bool
+	// ExitInfo is set for invocations that ran a process, e.g. a shell action.
+	ExitInfo *ExitInfo
+	// HTTPSummary is set for invocations that made an http request, e.g. an http action.
+	HTTPSummary *HTTPSummary
+	// SQLSummary is set for invocations that executed sql statements, e.g. a sql action.
+	SQLSummary []SQLStatementSummary
+	// Steps are the results of each step for invocations that ran a workflow action.
+	Steps []WorkflowStepResult
+	// Attempts are the results of each attempt for invocations of jobs that retry failed attempts.
+	Attempts []JobAttempt
+	// Lock is the result of acquiring the job's lock, for invocations of jobs that hold a lock while they run.
+	Lock *JobLockResult
+	// Artifacts are files collected after the invocation, e.g. by a shell action.
+	Artifacts []Artifact
+	// SpanContext is the span context of the job execute span, if tracing is enabled.
+	SpanContext trace.SpanContext
+}
+
+// StreamWriter returns a writer to the output that tags what it writes with a given stream.
+func (jio *JobInvocationOutput) StreamWriter(stream OutputStream) io.Writer {
+	if jio.OutputStreams == nil {
+		return jio.Output
+	}
+	return jio.OutputStreams.Writer(jio.Output, stream)
+}
+
+// OutputChunks returns the output chunks tagged with their streams, optionally only those of a given set of streams.
+func (jio JobInvocationOutput) OutputChunks(streams ...OutputStream) (output []OutputChunk) {
+	if jio.Output == nil {
+		return nil
+	}
+	for index, chunk := range jio.Output.Chunks {
+		stream := jio.OutputStreams.Stream(index)
+		if len(streams) > 0 && !hasOutputStream(streams, stream) {
+			continue
+		}
+		output = append(output, OutputChunk{
+			Timestamp: chunk.Timestamp,
+			Stream:    stream,
+			Data:      string(chunk.Data),
+		})
+	}
+	return
+}
+
+// OutputTail returns at most the last max bytes of the output written to a given stream.
+func (jio JobInvocationOutput) OutputTail(stream OutputStream, maxBytes int) string {
+	var output []byte
+	for _, chunk := range jio.OutputChunks(stream) {
+		output = append(output, chunk.Data...)
+	}
+	if len(output) > maxBytes {
+		output = output[len(output)-maxBytes:]
+	}
+	return string(output)
+}
+
+// StepOutput returns the output a workflow step wrote, from the range of the invocation output it was forwarded to.
+func (jio JobInvocationOutput) StepOutput(index int) string {
+	if jio.Output == nil || index < 0 || index >= len(jio.Steps) {
+		return ""
+	}
+	output := jio.Output.Bytes()
+	start, end := jio.Steps[index].OutputStart, jio.Steps[index].OutputEnd
+	if start < 0 || end > len(output) || start >= end {
+		return ""
+	}
+	return string(output[start:end])
+}
+
+// resetAttempt clears the results of a failed attempt before the invocation is retried;
+// the output and the attempts are kept.
+func (jio *JobInvocationOutput) resetAttempt() {
+	jio.Skipped = false
+	jio.ExitInfo = nil
+	jio.HTTPSummary = nil
+	jio.SQLSummary = nil
+	jio.Steps = nil
+	jio.Artifacts = nil
+}
+
+func hasOutputStream(streams []OutputStream, stream OutputStream) bool {
+	for _, value := range streams {
+		if value == stream {
+			return true
+		}
+	}
+	return false
 }
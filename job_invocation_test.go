@@ -0,0 +1,44 @@
+package jobkit
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestJobInvocationExitInfoJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	ji := createTestCompleteJobInvocation("test0", time.Second, optJobExitInfo(&ExitInfo{
+		PID:      1234,
+		ExitCode: 3,
+		MaxRSS:   1 << 20,
+	}))
+	contents, err := json.Marshal(ji)
+	assert.Nil(err)
+
+	var verify JobInvocation
+	assert.Nil(json.Unmarshal(contents, &verify))
+	assert.Equal(ji.ExitInfo, verify.ExitInfo)
+}
+
+func TestJobInvocationOutputStreamsJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	ji := createTestCompleteJobInvocation("test0", time.Second, optJobOutputStreams(
+		OutputStreamStdout,
+		OutputStreamStderr,
+		OutputStreamStdout,
+		OutputStreamStderr,
+		OutputStreamStdout,
+	))
+	contents, err := json.Marshal(ji)
+	assert.Nil(err)
+
+	var verify JobInvocation
+	assert.Nil(json.Unmarshal(contents, &verify))
+	assert.Equal(ji.OutputStreams.Values(), verify.OutputStreams.Values())
+	assert.Equal(ji.OutputChunks(OutputStreamStderr), verify.OutputChunks(OutputStreamStderr))
+}
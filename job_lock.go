@@ -0,0 +1,123 @@
+package jobkit
+
+import (
+	"context"
+	"time"
+
+	"github.com/blend/go-sdk/ex"
+)
+
+// Job lock errors.
+const (
+	ErrJobLockInvalid   ex.Class = "job lock config invalid"
+	ErrJobLockContended ex.Class = "job invocation skipped; job lock held elsewhere"
+	ErrJobLockTimeout   ex.Class = "job lock acquire timed out"
+	ErrJobLockLost      ex.Class = "job lock lost while the invocation was running"
+)
+
+// JobLockResult is the result of acquiring a job's lock for an invocation.
+type JobLockResult struct {
+	// Provider is the lock provider.
+	Provider string `json:"provider"`
+	// Key identifies the lock.
+	Key string `json:"key"`
+	// Acquired is set if the invocation acquired the lock.
+	Acquired bool `json:"acquired"`
+	// Lost is set if the invocation stopped holding the lock while it ran, and was cancelled.
+	Lost bool `json:"lost,omitempty"`
+	// Waited is how long the invocation spent acquiring the lock.
+	Waited time.Duration `json:"waited"`
+	// Err is the error acquiring the lock, if any.
+	Err string `json:"err,omitempty"`
+}
+
+// acquireLock acquires a new lock from the job's lock provider for an invocation, recording the
+// result on the invocation output.
+//
+// It returns a context for the invocation that is cancelled if the lock is lost while it runs (see `checkLock`),
+// and a function that releases the lock.
+//
+// If the lock is held elsewhere, it returns an `ErrJobLockContended` error, or with the `wait` contention
+// behavior, tries again until the acquire timeout and then returns an `ErrJobLockTimeout` error.
+func (job *Job) acquireLock(ctx context.Context, jio *JobInvocationOutput) (context.Context, func(), error) {
+	cfg := job.JobConfig.Lock
+	timeout := cfg.AcquireTimeoutOrDefault()
+	result := &JobLockResult{
+		Provider: cfg.Provider,
+		Key:      cfg.KeyOrDefault(job.Name()),
+	}
+	jio.Lock = result
+
+	lock := job.LockProvider()
+	started := time.Now().UTC()
+	acquireCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	acquired, err := lock.TryLock(acquireCtx)
+	for err == nil && !acquired && cfg.OnContentionOrDefault() == JobLockOnContentionWait {
+		retry := time.NewTimer(DefaultJobLockRetryWait)
+		select {
+		case <-acquireCtx.Done():
+			retry.Stop()
+			err = ex.New(acquireCtx.Err())
+		case <-retry.C:
+			acquired, err = lock.TryLock(acquireCtx)
+		}
+	}
+	result.Waited = time.Since(started)
+
+	if err != nil && ctx.Err() == nil && acquireCtx.Err() != nil {
+		err = ex.New(ErrJobLockTimeout, ex.OptMessagef("key: %s; timeout: %v", result.Key, timeout), ex.OptInner(err))
+	}
+	if err != nil {
+		result.Err = err.Error()
+		return nil, nil, err
+	}
+	if !acquired {
+		return nil, nil, ex.New(ErrJobLockContended, ex.OptMessagef("key: %s", result.Key))
+	}
+	result.Acquired = true
+
+	lockCtx, cancelLock := context.WithCancel(ctx)
+	checked := make(chan struct{})
+	go func() {
+		defer close(checked)
+		job.checkLock(lockCtx, cancelLock, lock, result)
+	}()
+	return lockCtx, func() {
+		cancelLock()
+		<-checked
+		releaseCtx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		if err := lock.Release(releaseCtx); err != nil {
+			job.Error(ctx, err)
+		}
+	}, nil
+}
+
+// checkLock checks the invocation still holds the lock each check interval until the context is cancelled.
+//
+// If it doesn't, e.g. because the lock's connection was dropped, the lock is recorded as lost and the
+// invocation is cancelled, as another replica may have acquired the lock.
+func (job *Job) checkLock(ctx context.Context, cancel context.CancelFunc, lock Lock, result *JobLockResult) {
+	interval := job.JobConfig.Lock.CheckInterval()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		checkCtx, checkCancel := context.WithTimeout(ctx, interval)
+		err := lock.Check(checkCtx)
+		checkCancel()
+		if err != nil && ctx.Err() == nil {
+			result.Lost = true
+			result.Err = err.Error()
+			job.Error(ctx, err)
+			cancel()
+			return
+		}
+	}
+}
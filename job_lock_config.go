@@ -0,0 +1,113 @@
+package jobkit
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/blend/go-sdk/ex"
+)
+
+// JobLock contention behaviors.
+const (
+	JobLockOnContentionSkip = "skip"
+	JobLockOnContentionWait = "wait"
+)
+
+// JobLockConfig is a config for a lock a job holds while it runs, so invocations
+// of the job don't overlap across replicas.
+type JobLockConfig struct {
+	// Provider is the lock provider, one of `postgres` or `file`; the job isn't locked if it's unset.
+	//
+	// - `postgres` uses an advisory lock taken with the `db` config connection. Each invocation holding the lock
+	//   keeps a connection from the pool while it runs, so the pool needs a connection for each locked job that
+	//   can run at once on top of the connections other jobs and history use; if the pool is exhausted,
+	//   acquiring the lock waits up to the acquire timeout.
+	// - `file` uses a lock file, and only keeps invocations from overlapping on a single host.
+	Provider string `yaml:"provider"`
+	// Key identifies the lock, defaulting to `jobkit.job.<job name>`.
+	Key string `yaml:"key"`
+	// Path is the lock file path for the `file` provider, defaulting to `<key>.lock` in the temp directory.
+	Path string `yaml:"path"`
+	// OnContention is one of `skip` or `wait`, defaulting to `skip`.
+	//
+	// - `skip` skips the invocation if the lock is held elsewhere.
+	// - `wait` waits for the lock to be released, up to the acquire timeout.
+	OnContention string `yaml:"onContention"`
+	// AcquireTimeout is how long an invocation tries to acquire the lock before it fails, defaulting to 30s.
+	AcquireTimeout *time.Duration `yaml:"acquireTimeout"`
+	// Lease bounds how long the lock is held for an invocation that can't reach it, defaulting to 15s.
+	//
+	// The invocation checks it still holds the lock three times per lease, and is cancelled if it doesn't.
+	// With the `postgres` provider the database releases the lock of an invocation it can't reach after
+	// about the lease (see `LockPostgres.Lease`).
+	Lease *time.Duration `yaml:"lease"`
+}
+
+// IsZero returns if the job isn't locked.
+func (jlc JobLockConfig) IsZero() bool {
+	return jlc.Provider == ""
+}
+
+// KeyOrDefault returns a value or a default.
+func (jlc JobLockConfig) KeyOrDefault(jobName string) string {
+	if jlc.Key != "" {
+		return jlc.Key
+	}
+	return DefaultJobLockKeyPrefix + jobName
+}
+
+// PathOrDefault returns a value or a default.
+func (jlc JobLockConfig) PathOrDefault(jobName string) string {
+	if jlc.Path != "" {
+		return jlc.Path
+	}
+	return filepath.Join(os.TempDir(), jlc.KeyOrDefault(jobName)+".lock")
+}
+
+// OnContentionOrDefault returns a value or a default.
+func (jlc JobLockConfig) OnContentionOrDefault() string {
+	if jlc.OnContention != "" {
+		return jlc.OnContention
+	}
+	return DefaultJobLockOnContention
+}
+
+// AcquireTimeoutOrDefault returns a value or a default.
+func (jlc JobLockConfig) AcquireTimeoutOrDefault() time.Duration {
+	if jlc.AcquireTimeout != nil && *jlc.AcquireTimeout > 0 {
+		return *jlc.AcquireTimeout
+	}
+	return DefaultJobLockAcquireTimeout
+}
+
+// LeaseOrDefault returns a value or a default.
+func (jlc JobLockConfig) LeaseOrDefault() time.Duration {
+	if jlc.Lease != nil && *jlc.Lease > 0 {
+		return *jlc.Lease
+	}
+	return DefaultJobLockLease
+}
+
+// CheckInterval returns how often a running invocation checks it still holds the lock.
+func (jlc JobLockConfig) CheckInterval() time.Duration {
+	return jlc.LeaseOrDefault() / 3
+}
+
+// Validate returns an error if the provider or contention behavior is unknown.
+func (jlc JobLockConfig) Validate() error {
+	if jlc.IsZero() {
+		return nil
+	}
+	switch jlc.Provider {
+	case LockProviderPostgres, LockProviderFile:
+	default:
+		return ex.New(ErrJobLockInvalid, ex.OptMessagef("provider: %s", jlc.Provider))
+	}
+	switch jlc.OnContentionOrDefault() {
+	case JobLockOnContentionSkip, JobLockOnContentionWait:
+		return nil
+	default:
+		return ex.New(ErrJobLockInvalid, ex.OptMessagef("onContention: %s", jlc.OnContention))
+	}
+}
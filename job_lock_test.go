@@ -0,0 +1,158 @@
+package jobkit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/db"
+	"github.com/blend/go-sdk/ex"
+	"github.com/blend/go-sdk/ref"
+)
+
+func createTestLockedJob(cfg JobLockConfig, shared *testLockHolder, action func(context.Context) error) *Job {
+	return MustNewJob(cron.NewJob(cron.OptJobName("test-job"), cron.OptJobAction(action)),
+		OptJobConfig(JobConfig{Lock: cfg}),
+		OptJobLockProvider(func() Lock { return &testLock{shared} }),
+	)
+}
+
+func TestJobExecuteLock(t *testing.T) {
+	assert := assert.New(t)
+
+	shared := new(testLockHolder)
+	job := createTestLockedJob(JobLockConfig{Provider: LockProviderPostgres}, shared, func(_ context.Context) error {
+		assert.NotNil(shared.holder, "the lock should be held while the job runs")
+		return nil
+	})
+
+	ctx, ji := createTestRetryJobContext(job)
+	assert.Nil(job.Execute(ctx))
+	assert.Nil(shared.holder, "the lock should be released after the job runs")
+
+	jio := ji.State.(*JobInvocationOutput)
+	assert.False(jio.Skipped)
+	assert.NotNil(jio.Lock)
+	assert.True(jio.Lock.Acquired)
+	assert.Equal(LockProviderPostgres, jio.Lock.Provider)
+	assert.Equal("jobkit.job.test-job", jio.Lock.Key)
+}
+
+func TestJobExecuteLockContendedSkip(t *testing.T) {
+	assert := assert.New(t)
+
+	shared := new(testLockHolder)
+	job := createTestLockedJob(JobLockConfig{Provider: LockProviderPostgres}, shared, func(_ context.Context) error {
+		return ex.New("should not run")
+	})
+	elsewhere := &testLock{shared}
+	acquired, err := elsewhere.TryLock(context.Background())
+	assert.Nil(err)
+	assert.True(acquired)
+
+	ctx, ji := createTestRetryJobContext(job)
+	assert.Nil(job.Execute(ctx))
+
+	jio := ji.State.(*JobInvocationOutput)
+	assert.True(jio.Skipped)
+	assert.False(jio.Lock.Acquired)
+	assert.Empty(jio.Lock.Err)
+	assert.Contains(jio.Output.String(), string(ErrJobLockContended))
+}
+
+func TestJobExecuteLockContendedWait(t *testing.T) {
+	assert := assert.New(t)
+
+	shared := new(testLockHolder)
+	var ran bool
+	job := createTestLockedJob(JobLockConfig{Provider: LockProviderPostgres, OnContention: JobLockOnContentionWait}, shared, func(_ context.Context) error {
+		ran = true
+		return nil
+	})
+	elsewhere := &testLock{shared}
+	_, _ = elsewhere.TryLock(context.Background())
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		_ = elsewhere.Release(context.Background())
+	}()
+
+	ctx, ji := createTestRetryJobContext(job)
+	assert.Nil(job.Execute(ctx))
+	assert.True(ran)
+
+	jio := ji.State.(*JobInvocationOutput)
+	assert.True(jio.Lock.Acquired)
+	assert.True(jio.Lock.Waited >= 100*time.Millisecond)
+}
+
+func TestJobExecuteLockContendedWaitTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	shared := new(testLockHolder)
+	job := createTestLockedJob(JobLockConfig{
+		Provider:       LockProviderPostgres,
+		OnContention:   JobLockOnContentionWait,
+		AcquireTimeout: ref.Duration(50 * time.Millisecond),
+	}, shared, func(_ context.Context) error {
+		return ex.New("should not run")
+	})
+	_, _ = (&testLock{shared}).TryLock(context.Background())
+
+	ctx, ji := createTestRetryJobContext(job)
+	err := job.Execute(ctx)
+	assert.True(ex.Is(err, ErrJobLockTimeout))
+
+	jio := ji.State.(*JobInvocationOutput)
+	assert.False(jio.Skipped)
+	assert.False(jio.Lock.Acquired)
+	assert.NotEmpty(jio.Lock.Err)
+}
+
+func TestJobExecuteLockLost(t *testing.T) {
+	assert := assert.New(t)
+
+	shared := new(testLockHolder)
+	started := make(chan struct{})
+	job := createTestLockedJob(JobLockConfig{Provider: LockProviderPostgres, Lease: ref.Duration(30 * time.Millisecond)}, shared, func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	ctx, ji := createTestRetryJobContext(job)
+	done := make(chan error)
+	go func() { done <- job.Execute(ctx) }()
+	<-started
+	shared.holder.lose()
+
+	err := <-done
+	assert.True(ex.Is(err, ErrJobLockLost))
+
+	jio := ji.State.(*JobInvocationOutput)
+	assert.True(jio.Lock.Acquired)
+	assert.True(jio.Lock.Lost)
+	assert.NotEmpty(jio.Lock.Err)
+}
+
+func TestJobLockConfigValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(JobLockConfig{}.Validate())
+	assert.Nil(JobLockConfig{Provider: LockProviderFile, OnContention: JobLockOnContentionWait}.Validate())
+	assert.True(ex.Is(JobLockConfig{Provider: "zookeeper"}.Validate(), ErrJobLockInvalid))
+	assert.True(ex.Is(JobLockConfig{Provider: LockProviderFile, OnContention: "sometimes"}.Validate(), ErrJobLockInvalid))
+
+	job, err := NewJob(cron.NewJob(cron.OptJobName("test-job")), OptJobConfig(JobConfig{Lock: JobLockConfig{Provider: LockProviderFile}}))
+	assert.Nil(err)
+	assert.NotNil(job.LockProvider, "file locks don't need a connection")
+
+	_, err = NewJob(cron.NewJob(cron.OptJobName("test-job")), OptJobConfig(JobConfig{Lock: JobLockConfig{Provider: LockProviderPostgres}}))
+	assert.True(ex.Is(err, ErrLockInvalid), "postgres locks need a connection")
+
+	job, err = NewJob(cron.NewJob(cron.OptJobName("test-job")), OptJobConfig(JobConfig{Lock: JobLockConfig{Provider: LockProviderPostgres}}), OptJobLockConn(new(db.Connection)))
+	assert.Nil(err)
+	assert.NotNil(job.LockProvider)
+	assert.Equal(DefaultJobLockLease/3, JobLockConfig{}.CheckInterval())
+}
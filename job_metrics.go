@@ -0,0 +1,88 @@
+package jobkit
+
+import (
+	"sync"
+	"time"
+
+	"github.com/blend/go-sdk/cron"
+)
+
+// JobMetrics are counts of a job's invocations since the process started.
+//
+// Unlike the job stats, which are derived from history and so are subject to the history
+// retention settings, the counts only ever increase, and are cheap to read on each scrape.
+type JobMetrics struct {
+	// Runs are the completed invocations by status.
+	Runs map[cron.JobInvocationStatus]int64
+	// ElapsedBuckets are the cumulative counts of completed invocations at or under each of `DefaultPrometheusElapsedBuckets`.
+	ElapsedBuckets []int64
+	// ElapsedSum is the total elapsed time of completed invocations.
+	ElapsedSum time.Duration
+	// ElapsedCount is the number of completed invocations.
+	ElapsedCount int64
+	// Running is the number of running invocations.
+	Running int
+	// LastSuccess is when the last successful invocation completed.
+	LastSuccess time.Time
+}
+
+// jobMetrics accumulates the invocation counts of a job.
+type jobMetrics struct {
+	sync.Mutex
+	runs           map[cron.JobInvocationStatus]int64
+	elapsedBuckets []int64
+	elapsedSum     time.Duration
+	elapsedCount   int64
+}
+
+// observe counts a completed invocation.
+func (jm *jobMetrics) observe(ji *cron.JobInvocation) {
+	status := ji.Status
+	if typed, ok := ji.State.(*JobInvocationOutput); ok && typed != nil && typed.Skipped && status == cron.JobInvocationStatusSuccess {
+		status = JobInvocationStatusSkipped
+	}
+	elapsed := ji.Elapsed()
+
+	jm.Lock()
+	defer jm.Unlock()
+	if jm.runs == nil {
+		jm.runs = make(map[cron.JobInvocationStatus]int64)
+		jm.elapsedBuckets = make([]int64, len(DefaultPrometheusElapsedBuckets))
+	}
+	jm.runs[status]++
+	for index, bucket := range DefaultPrometheusElapsedBuckets {
+		if elapsed.Seconds() <= bucket {
+			jm.elapsedBuckets[index]++
+		}
+	}
+	jm.elapsedSum += elapsed
+	jm.elapsedCount++
+}
+
+// skipped counts scheduled runs that were passed over without an invocation.
+func (jm *jobMetrics) skipped(count int64) {
+	jm.Lock()
+	defer jm.Unlock()
+	if jm.runs == nil {
+		jm.runs = make(map[cron.JobInvocationStatus]int64)
+		jm.elapsedBuckets = make([]int64, len(DefaultPrometheusElapsedBuckets))
+	}
+	jm.runs[JobInvocationStatusSkipped] += count
+}
+
+// metrics returns a copy of the counts.
+func (jm *jobMetrics) metrics() JobMetrics {
+	jm.Lock()
+	defer jm.Unlock()
+	output := JobMetrics{
+		Runs:           make(map[cron.JobInvocationStatus]int64, len(jm.runs)),
+		ElapsedBuckets: make([]int64, len(DefaultPrometheusElapsedBuckets)),
+		ElapsedSum:     jm.elapsedSum,
+		ElapsedCount:   jm.elapsedCount,
+	}
+	for status, count := range jm.runs {
+		output.Runs[status] = count
+	}
+	copy(output.ElapsedBuckets, jm.elapsedBuckets)
+	return output
+}
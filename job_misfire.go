@@ -0,0 +1,116 @@
+package jobkit
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/ex"
+	"github.com/blend/go-sdk/logger"
+)
+
+// ErrJobMisfireInvalid is returned if a job misfire policy is unknown.
+const ErrJobMisfireInvalid ex.Class = "job misfire policy invalid"
+
+// JobParameterMisfireScheduled is the parameter set on invocations that catch up a missed run,
+// with the time the run was scheduled for in RFC3339 format.
+const JobParameterMisfireScheduled = "MISFIRE_SCHEDULED"
+
+// CatchUpMissedRuns runs the scheduled runs that the jobkit jobs in a job manager missed, e.g. while
+// the process was down or the job manager was paused, following each job's misfire policy.
+//
+// The missed runs are found from the last invocation of each job, either in the job history or the job manager,
+// and are run in the background one at a time with `RunJob`, so they follow the job's concurrency policy.
+// Disabled jobs are skipped.
+func CatchUpMissedRuns(ctx context.Context, jm *cron.JobManager) {
+	for _, js := range jm.Jobs {
+		job, ok := js.Job.(*Job)
+		if !ok || js.Disabled() || job.JobConfig.Misfire.PolicyOrDefault() == JobMisfirePolicyIgnore {
+			continue
+		}
+		go job.catchUp(ctx, jm, js)
+	}
+}
+
+// MissedRuns returns the times the job was scheduled to run after a given last run and before a given time,
+// oldest first and at most a given limit.
+func (job *Job) MissedRuns(last, now time.Time, limit int) (output []time.Time) {
+	if job.JobSchedule == nil || last.IsZero() {
+		return nil
+	}
+	previous := last
+	for len(output) < limit {
+		next := job.JobSchedule.Next(previous)
+		if next.IsZero() || !next.After(previous) || !next.Before(now) {
+			return
+		}
+		output = append(output, next)
+		previous = next
+	}
+	return
+}
+
+// LastRun returns when the last invocation of the job in history started, or a zero time if
+// history is disabled or empty.
+func (job *Job) LastRun(ctx context.Context) (last time.Time, err error) {
+	if job.JobConfig.HistoryDisabledOrDefault() || job.HistoryProvider == nil {
+		return
+	}
+	history, err := job.HistoryProvider.Get(ctx, job.Name())
+	if err != nil {
+		return
+	}
+	for _, ji := range history {
+		if ji.Started.After(last) {
+			last = ji.Started
+		}
+	}
+	return
+}
+
+// catchUp runs the missed runs of a job one at a time; it does nothing if the job is already catching up,
+// and stops if the job is disabled.
+func (job *Job) catchUp(ctx context.Context, jm *cron.JobManager, js *cron.JobScheduler) {
+	if !atomic.CompareAndSwapInt32(&job.catchingUp, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&job.catchingUp, 0)
+
+	last, err := job.LastRun(ctx)
+	if err != nil {
+		job.Error(ctx, ex.New(err, ex.OptMessagef("misfire; job: %s", job.Name())))
+	}
+	if ji := js.Last(); ji != nil && ji.Started.After(last) {
+		last = ji.Started
+	}
+
+	limit := 1
+	if job.JobConfig.Misfire.PolicyOrDefault() == JobMisfirePolicyRunAll {
+		limit = job.JobConfig.Misfire.MaxRunsOrDefault()
+	}
+	missed := job.MissedRuns(last, time.Now().UTC(), limit)
+	if len(missed) == 0 {
+		return
+	}
+	logger.MaybeInfofContext(ctx, job.Log, "misfire; job %s missed runs since %v, running %d", job.Name(), last.Format(time.RFC3339), len(missed))
+
+	for _, scheduled := range missed {
+		if ctx.Err() != nil || js.Disabled() {
+			return
+		}
+		parameters := cron.JobParameters{
+			JobParameterMisfireScheduled: scheduled.Format(time.RFC3339),
+		}
+		_, done, err := RunJob(cron.WithJobParameterValues(context.Background(), parameters), jm, job.Name())
+		if err != nil {
+			job.Error(ctx, ex.New(err, ex.OptMessagef("misfire; job: %s; scheduled: %v", job.Name(), scheduled.Format(time.RFC3339))))
+			return
+		}
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package jobkit
+
+import (
+	"github.com/blend/go-sdk/ex"
+)
+
+// JobMisfirePolicy values.
+const (
+	JobMisfirePolicyIgnore  = "ignore"
+	JobMisfirePolicyRunOnce = "runOnce"
+	JobMisfirePolicyRunAll  = "runAll"
+)
+
+// JobMisfireConfig is a config for what happens to the scheduled runs a job missed while the process
+// was down or the job manager was paused.
+type JobMisfireConfig struct {
+	// Policy is one of `ignore`, `runOnce` or `runAll`, defaulting to `ignore`.
+	//
+	// - `ignore` drops the missed runs.
+	// - `runOnce` runs the job once for the missed runs.
+	// - `runAll` runs the job for each missed run, oldest first, up to `maxRuns`.
+	Policy string `yaml:"policy"`
+	// MaxRuns is the maximum number of missed runs to run with the `runAll` policy, defaulting to 10.
+	MaxRuns *int `yaml:"maxRuns"`
+}
+
+// PolicyOrDefault returns a value or a default.
+func (jmc JobMisfireConfig) PolicyOrDefault() string {
+	if jmc.Policy != "" {
+		return jmc.Policy
+	}
+	return DefaultJobMisfirePolicy
+}
+
+// MaxRunsOrDefault returns a value or a default.
+func (jmc JobMisfireConfig) MaxRunsOrDefault() int {
+	if jmc.MaxRuns != nil && *jmc.MaxRuns > 0 {
+		return *jmc.MaxRuns
+	}
+	return DefaultJobMisfireMaxRuns
+}
+
+// Validate returns an error if the policy is unknown.
+func (jmc JobMisfireConfig) Validate() error {
+	switch jmc.PolicyOrDefault() {
+	case JobMisfirePolicyIgnore, JobMisfirePolicyRunOnce, JobMisfirePolicyRunAll:
+		return nil
+	default:
+		return ex.New(ErrJobMisfireInvalid, ex.OptMessagef("policy: %s", jmc.Policy))
+	}
+}
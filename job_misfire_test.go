@@ -0,0 +1,82 @@
+package jobkit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/ex"
+)
+
+func TestJobMissedRuns(t *testing.T) {
+	assert := assert.New(t)
+
+	job := MustNewJob(cron.NewJob(cron.OptJobName("test-job")), OptJobParsedSchedule("@every 1m"))
+
+	last := time.Date(2020, 01, 01, 12, 00, 00, 00, time.UTC)
+	missed := job.MissedRuns(last, last.Add(3*time.Minute+30*time.Second), 10)
+	assert.Len(missed, 3)
+	assert.Equal(last.Add(time.Minute), missed[0])
+	assert.Equal(last.Add(3*time.Minute), missed[2])
+
+	assert.Len(job.MissedRuns(last, last.Add(time.Hour), 5), 5)
+	assert.Empty(job.MissedRuns(last, last.Add(30*time.Second), 10))
+	assert.Empty(job.MissedRuns(time.Time{}, last, 10), "a job that never ran has no missed runs")
+}
+
+func TestJobLastRun(t *testing.T) {
+	assert := assert.New(t)
+
+	history := new(HistoryMemory)
+	assert.Nil(history.Initialize(context.Background()))
+	job := MustNewJob(cron.NewJob(cron.OptJobName("test-job")), OptJobHistory(history))
+
+	last, err := job.LastRun(context.Background())
+	assert.Nil(err)
+	assert.True(last.IsZero())
+
+	started := time.Date(2020, 01, 01, 12, 00, 00, 00, time.UTC)
+	assert.Nil(history.Add(context.Background(), createTestCompleteJobInvocation("test-job", time.Second, optJobStarted(started.Add(time.Minute)))))
+	assert.Nil(history.Add(context.Background(), createTestCompleteJobInvocation("test-job", time.Second, optJobStarted(started))))
+
+	last, err = job.LastRun(context.Background())
+	assert.Nil(err)
+	assert.Equal(started.Add(time.Minute), last)
+}
+
+func TestJobMisfireConfigValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(JobMisfireConfig{}.Validate())
+	assert.Nil(JobMisfireConfig{Policy: JobMisfirePolicyRunAll}.Validate())
+	assert.True(ex.Is(JobMisfireConfig{Policy: "sometimes"}.Validate(), ErrJobMisfireInvalid))
+	assert.Equal(DefaultJobMisfireMaxRuns, JobMisfireConfig{}.MaxRunsOrDefault())
+}
+
+func TestJobCatchUpDisabled(t *testing.T) {
+	assert := assert.New(t)
+
+	ran := make(chan struct{}, 1)
+	history := new(HistoryMemory)
+	assert.Nil(history.Initialize(context.Background()))
+	assert.Nil(history.Add(context.Background(), createTestCompleteJobInvocation("test-job", time.Second, optJobStarted(time.Now().UTC().Add(-time.Hour)))))
+	job := MustNewJob(cron.NewJob(cron.OptJobName("test-job"), cron.OptJobAction(func(_ context.Context) error {
+		ran <- struct{}{}
+		return nil
+	})), OptJobParsedSchedule("@every 1m"), OptJobHistory(history))
+
+	jm := cron.New()
+	assert.Nil(jm.LoadJobs(job))
+	js, err := jm.Job("test-job")
+	assert.Nil(err)
+
+	assert.Nil(jm.DisableJobs("test-job"))
+	job.catchUp(context.Background(), jm, js)
+	assert.Empty(ran, "disabled jobs should not catch up")
+
+	assert.Nil(jm.EnableJobs("test-job"))
+	job.catchUp(context.Background(), jm, js)
+	assert.Len(ran, 1)
+}
@@ -19,6 +19,8 @@ type JobNotificationsConfig struct {
 	MaxRetries *int `yaml:"maxRetries"`
 	// RetryWait is the time between attempts.
 	RetryWait *time.Duration `yaml:"retryWait"`
+	// Timeout is the maximum time a single attempt to send a notification can take.
+	Timeout *time.Duration `yaml:"timeout"`
 
 	// OnBegin governs if we should send notifications job start.
 	OnBegin *bool `yaml:"onBegin"`
@@ -56,6 +58,14 @@ func (jnc JobNotificationsConfig) RetryWaitOrDefault() time.Duration {
 	return 5 * time.Second
 }
 
+// TimeoutOrDefault returns a value or a default.
+func (jnc JobNotificationsConfig) TimeoutOrDefault() time.Duration {
+	if jnc.Timeout != nil {
+		return *jnc.Timeout
+	}
+	return 30 * time.Second
+}
+
 // OnBeginOrDefault returns a value or a default.
 func (jnc JobNotificationsConfig) OnBeginOrDefault() bool {
 	if jnc.OnBegin != nil {
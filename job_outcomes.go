@@ -0,0 +1,98 @@
+package jobkit
+
+import (
+	"sync"
+
+	"github.com/blend/go-sdk/cron"
+)
+
+// jobOutcome is the outcome of a job invocation for the purposes of broken and fixed transitions.
+type jobOutcome int
+
+// jobOutcome values.
+const (
+	jobOutcomeUnknown jobOutcome = iota
+	jobOutcomeSuccess
+	jobOutcomeErrored
+	jobOutcomeSkipped
+)
+
+// jobOutcomesTracked is the number of recent invocations whose preceding outcomes are kept.
+//
+// An invocation's lifecycle hooks are all called shortly after it completes, so this only
+// needs to cover the invocations that can complete while another's hooks are being called.
+const jobOutcomesTracked = 64
+
+// jobOutcomes tracks the outcomes of recent invocations so that broken and fixed transitions
+// can look past skipped invocations, which the job manager treats as successful.
+//
+// Invocations are ordered by when they are first observed, i.e. by completion order, and each
+// is compared against the invocations that completed before it. The comparison is kept for the
+// invocation's later hooks, so hooks for invocations running in parallel can interleave, and the
+// hooks for a given invocation can be called in any order.
+type jobOutcomes struct {
+	sync.Mutex
+
+	// observed are the recent invocations by id, with the outcomes that preceded them.
+	observed map[string]jobOutcomePrevious
+	// order are the ids of the recent invocations in completion order.
+	order []string
+
+	// last is the outcome of the last invocation to complete.
+	last jobOutcome
+	// lastRun is the outcome of the last invocation to complete that wasn't skipped.
+	lastRun jobOutcome
+}
+
+// jobOutcomePrevious are the outcomes that preceded an invocation.
+type jobOutcomePrevious struct {
+	// previousSkipped is set if the invocation that completed before this one was skipped.
+	previousSkipped bool
+	// lastRun is the outcome of the last invocation that completed before this one that wasn't skipped.
+	lastRun jobOutcome
+}
+
+// Observe records the outcome of an invocation and returns if the previous invocation was skipped,
+// and the outcome of the last invocation before it that was not skipped.
+func (jo *jobOutcomes) Observe(ji *JobInvocation) (previousSkipped bool, lastRun jobOutcome) {
+	jo.Lock()
+	defer jo.Unlock()
+
+	if previous, ok := jo.observed[ji.ID]; ok {
+		return previous.previousSkipped, previous.lastRun
+	}
+
+	previous := jobOutcomePrevious{
+		previousSkipped: jo.last == jobOutcomeSkipped,
+		lastRun:         jo.lastRun,
+	}
+	if jo.observed == nil {
+		jo.observed = make(map[string]jobOutcomePrevious)
+	}
+	jo.observed[ji.ID] = previous
+	jo.order = append(jo.order, ji.ID)
+	if len(jo.order) > jobOutcomesTracked {
+		delete(jo.observed, jo.order[0])
+		jo.order = jo.order[1:]
+	}
+
+	current := newJobOutcome(ji)
+	jo.last = current
+	if current == jobOutcomeSuccess || current == jobOutcomeErrored {
+		jo.lastRun = current
+	}
+	return previous.previousSkipped, previous.lastRun
+}
+
+func newJobOutcome(ji *JobInvocation) jobOutcome {
+	switch {
+	case ji.Skipped:
+		return jobOutcomeSkipped
+	case ji.Err != nil && cron.IsJobCancelled(ji.Err):
+		return jobOutcomeUnknown
+	case ji.Err != nil:
+		return jobOutcomeErrored
+	default:
+		return jobOutcomeSuccess
+	}
+}
@@ -0,0 +1,68 @@
+package jobkit
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/cron"
+)
+
+func createTestOutcomeJobInvocation(id string, skipped bool, err error) *JobInvocation {
+	ji := &JobInvocation{}
+	ji.ID = id
+	ji.Err = err
+	ji.Skipped = skipped
+	return ji
+}
+
+func TestJobOutcomesInterleaved(t *testing.T) {
+	assert := assert.New(t)
+
+	var jo jobOutcomes
+
+	failed := createTestOutcomeJobInvocation("failed", false, fmt.Errorf("only a test"))
+	previousSkipped, lastRun := jo.Observe(failed)
+	assert.False(previousSkipped)
+	assert.Equal(jobOutcomeUnknown, lastRun)
+
+	// two invocations running in parallel complete, and their hooks interleave.
+	skipped := createTestOutcomeJobInvocation("skipped", true, nil)
+	succeeded := createTestOutcomeJobInvocation("succeeded", false, nil)
+
+	previousSkipped, lastRun = jo.Observe(skipped)
+	assert.False(previousSkipped)
+	assert.Equal(jobOutcomeErrored, lastRun)
+
+	previousSkipped, lastRun = jo.Observe(succeeded)
+	assert.True(previousSkipped)
+	assert.Equal(jobOutcomeErrored, lastRun)
+
+	previousSkipped, lastRun = jo.Observe(skipped)
+	assert.False(previousSkipped, "later hooks compare against the same invocations")
+	assert.Equal(jobOutcomeErrored, lastRun)
+
+	previousSkipped, lastRun = jo.Observe(succeeded)
+	assert.True(previousSkipped)
+	assert.Equal(jobOutcomeErrored, lastRun)
+
+	next := createTestOutcomeJobInvocation("next", false, nil)
+	previousSkipped, lastRun = jo.Observe(next)
+	assert.False(previousSkipped)
+	assert.Equal(jobOutcomeSuccess, lastRun)
+}
+
+func TestJobOutcomesTracked(t *testing.T) {
+	assert := assert.New(t)
+
+	var jo jobOutcomes
+	for index := 0; index < 2*jobOutcomesTracked; index++ {
+		jo.Observe(createTestOutcomeJobInvocation(fmt.Sprint(index), false, nil))
+	}
+	assert.Len(jo.observed, jobOutcomesTracked)
+	assert.Len(jo.order, jobOutcomesTracked)
+
+	cancelled := createTestOutcomeJobInvocation("cancelled", false, cron.ErrJobCancelled)
+	jo.Observe(cancelled)
+	assert.Equal(jobOutcomeSuccess, jo.lastRun, "cancelled invocations aren't runs")
+}
@@ -0,0 +1,95 @@
+package jobkit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blend/go-sdk/ex"
+	"github.com/blend/go-sdk/logger"
+)
+
+// JobAttempt is the result of an attempt of an invocation of a job that retries failed attempts.
+type JobAttempt struct {
+	// Attempt is the attempt number, starting at 1.
+	Attempt int `json:"attempt"`
+	// Wait is how long the invocation waited after the previous attempt failed.
+	Wait time.Duration `json:"wait,omitempty"`
+	// Started is when the attempt started.
+	Started time.Time `json:"started"`
+	// Complete is when the attempt completed.
+	Complete time.Time `json:"complete"`
+	// Err is the error the attempt returned, if any.
+	Err string `json:"err,omitempty"`
+	// ExitInfo is set for attempts that ran a process.
+	ExitInfo *ExitInfo `json:"exitInfo,omitempty"`
+}
+
+// Elapsed returns the time the attempt took.
+func (ja JobAttempt) Elapsed() time.Duration {
+	if ja.Complete.IsZero() {
+		return 0
+	}
+	return ja.Complete.Sub(ja.Started)
+}
+
+// executeAttempts runs the job body, retrying failed attempts as set by the job retry config
+// and recording each attempt on the invocation output.
+//
+// The job manager only sees the result of the last attempt, so lifecycle events like `OnError`
+// and `OnBroken` fire once the retries are exhausted.
+func (job *Job) executeAttempts(ctx context.Context, jio *JobInvocationOutput) (err error) {
+	retry := job.JobConfig.Retry
+	maxAttempts := retry.MaxAttemptsOrDefault()
+	if maxAttempts < 2 {
+		return job.Job.Execute(ctx)
+	}
+
+	var wait time.Duration
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			jio.resetAttempt()
+			fmt.Fprintf(jio.Output, "==> attempt %d/%d\n", attempt, maxAttempts)
+		}
+		result := JobAttempt{
+			Attempt: attempt,
+			Wait:    wait,
+			Started: time.Now().UTC(),
+		}
+		err = job.Job.Execute(ctx)
+		result.Complete = time.Now().UTC()
+		result.ExitInfo = jio.ExitInfo
+		if err != nil {
+			result.Err = err.Error()
+		}
+		jio.Attempts = append(jio.Attempts, result)
+
+		if err == nil || attempt >= maxAttempts || ctx.Err() != nil {
+			return
+		}
+		retryable, retryErr := retry.ShouldRetry(err, jio.ExitInfo)
+		if retryErr != nil {
+			job.Error(ctx, ex.New(retryErr, ex.OptMessage("job retry; invalid error pattern")))
+			return
+		}
+		if !retryable {
+			return
+		}
+
+		wait = retry.WaitFor(attempt)
+		logger.MaybeWarningfContext(ctx, job.Log, "job retry; attempt %d/%d failed, retrying in %v: %v", attempt, maxAttempts, wait, err)
+		fmt.Fprintf(jio.StreamWriter(OutputStreamStderr), "==> attempt %d/%d failed, retrying in %v: %v\n", attempt, maxAttempts, wait, err)
+		if job.StatsClient != nil {
+			job.Error(ctx, job.StatsClient.Increment(job.statsName(MetricJobRetried), job.statsTags()...))
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			err = ex.New(ctx.Err(), ex.OptInner(err))
+			return
+		}
+	}
+}
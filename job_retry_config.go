@@ -0,0 +1,145 @@
+package jobkit
+
+import (
+	"math"
+	"regexp"
+	"time"
+
+	"github.com/blend/go-sdk/ex"
+)
+
+// ErrJobRetryInvalid is returned if a job retry config is invalid.
+const ErrJobRetryInvalid ex.Class = "job retry config invalid"
+
+// JobRetryBackoff values.
+const (
+	JobRetryBackoffConstant    = "constant"
+	JobRetryBackoffLinear      = "linear"
+	JobRetryBackoffExponential = "exponential"
+)
+
+// JobRetryConfig is a config for retrying failed invocations of a job.
+//
+// Retries run as further attempts of the same invocation, so the job is only errored (and broken)
+// once the last attempt fails.
+type JobRetryConfig struct {
+	// MaxAttempts is the maximum number of attempts of an invocation, including the first, defaulting to 1 (no retries).
+	MaxAttempts *int `yaml:"maxAttempts"`
+	// Wait is the time to wait before retrying a failed attempt.
+	Wait *time.Duration `yaml:"wait"`
+	// Backoff is how the wait grows with each failed attempt, one of `constant`, `linear` or `exponential`, defaulting to `constant`.
+	Backoff string `yaml:"backoff"`
+	// MaxWait is the maximum time to wait before retrying a failed attempt; if unset, the wait is unbounded.
+	MaxWait *time.Duration `yaml:"maxWait"`
+	// OnExitCodes are the process exit codes to retry on; if unset, any exit code is retried.
+	OnExitCodes []int `yaml:"onExitCodes"`
+	// OnErrors are regular expressions matched against the attempt error to retry on; if unset, any error is retried.
+	OnErrors []string `yaml:"onErrors"`
+}
+
+// MaxAttemptsOrDefault returns a value or a default.
+func (jrc JobRetryConfig) MaxAttemptsOrDefault() int {
+	if jrc.MaxAttempts != nil && *jrc.MaxAttempts > 0 {
+		return *jrc.MaxAttempts
+	}
+	return DefaultJobRetryMaxAttempts
+}
+
+// WaitOrDefault returns a value or a default.
+func (jrc JobRetryConfig) WaitOrDefault() time.Duration {
+	if jrc.Wait != nil {
+		return *jrc.Wait
+	}
+	return DefaultJobRetryWait
+}
+
+// BackoffOrDefault returns a value or a default.
+func (jrc JobRetryConfig) BackoffOrDefault() string {
+	if jrc.Backoff != "" {
+		return jrc.Backoff
+	}
+	return DefaultJobRetryBackoff
+}
+
+// MaxWaitOrDefault returns a value or a default.
+func (jrc JobRetryConfig) MaxWaitOrDefault() time.Duration {
+	if jrc.MaxWait != nil {
+		return *jrc.MaxWait
+	}
+	return 0
+}
+
+// WaitFor returns the time to wait before retrying after a given number of failed attempts.
+//
+// The wait saturates at the longest duration rather than overflowing if it's unbounded.
+func (jrc JobRetryConfig) WaitFor(failedAttempts int) (wait time.Duration) {
+	wait = jrc.WaitOrDefault()
+	switch jrc.BackoffOrDefault() {
+	case JobRetryBackoffLinear:
+		if wait > 0 && failedAttempts > int(math.MaxInt64/wait) {
+			wait = math.MaxInt64
+		} else {
+			wait = time.Duration(failedAttempts) * wait
+		}
+	case JobRetryBackoffExponential:
+		for x := 1; x < failedAttempts; x++ {
+			if wait > math.MaxInt64/2 {
+				wait = math.MaxInt64
+				break
+			}
+			wait = wait * 2
+			if maxWait := jrc.MaxWaitOrDefault(); maxWait > 0 && wait > maxWait {
+				break
+			}
+		}
+	}
+	if maxWait := jrc.MaxWaitOrDefault(); maxWait > 0 && wait > maxWait {
+		wait = maxWait
+	}
+	return
+}
+
+// Validate returns an error if the backoff is unknown or an error expression doesn't compile.
+func (jrc JobRetryConfig) Validate() error {
+	switch jrc.BackoffOrDefault() {
+	case JobRetryBackoffConstant, JobRetryBackoffLinear, JobRetryBackoffExponential:
+	default:
+		return ex.New(ErrJobRetryInvalid, ex.OptMessagef("backoff: %s", jrc.Backoff))
+	}
+	for _, expr := range jrc.OnErrors {
+		if _, err := regexp.Compile(expr); err != nil {
+			return ex.New(ErrJobRetryInvalid, ex.OptMessagef("on error: %s", expr), ex.OptInner(err))
+		}
+	}
+	return nil
+}
+
+// ShouldRetry returns if a failed attempt that returned a given error, and exited with a given
+// exit info if it ran a process, should be retried.
+//
+// If both exit codes and errors are set, an attempt is retried if either matches.
+func (jrc JobRetryConfig) ShouldRetry(err error, exitInfo *ExitInfo) (bool, error) {
+	if err == nil {
+		return false, nil
+	}
+	if len(jrc.OnExitCodes) == 0 && len(jrc.OnErrors) == 0 {
+		return true, nil
+	}
+	if exitInfo != nil {
+		for _, exitCode := range jrc.OnExitCodes {
+			if exitInfo.ExitCode == exitCode {
+				return true, nil
+			}
+		}
+	}
+	for _, expr := range jrc.OnErrors {
+		pattern, compileErr := regexp.Compile(expr)
+		if compileErr != nil {
+			return false, compileErr
+		}
+		if pattern.MatchString(err.Error()) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
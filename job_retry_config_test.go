@@ -0,0 +1,76 @@
+package jobkit
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/ex"
+	"github.com/blend/go-sdk/ref"
+)
+
+func TestJobRetryConfigWaitFor(t *testing.T) {
+	assert := assert.New(t)
+
+	var cfg JobRetryConfig
+	assert.Equal(1, cfg.MaxAttemptsOrDefault())
+	assert.Equal(DefaultJobRetryWait, cfg.WaitFor(3))
+
+	cfg = JobRetryConfig{Wait: ref.Duration(time.Second), Backoff: JobRetryBackoffLinear}
+	assert.Equal(time.Second, cfg.WaitFor(1))
+	assert.Equal(3*time.Second, cfg.WaitFor(3))
+
+	cfg = JobRetryConfig{Wait: ref.Duration(time.Second), Backoff: JobRetryBackoffExponential, MaxWait: ref.Duration(10 * time.Second)}
+	assert.Equal(time.Second, cfg.WaitFor(1))
+	assert.Equal(2*time.Second, cfg.WaitFor(2))
+	assert.Equal(8*time.Second, cfg.WaitFor(4))
+	assert.Equal(10*time.Second, cfg.WaitFor(5))
+	assert.Equal(10*time.Second, cfg.WaitFor(100))
+
+	cfg = JobRetryConfig{Wait: ref.Duration(time.Second), Backoff: JobRetryBackoffExponential}
+	assert.Equal(time.Duration(math.MaxInt64), cfg.WaitFor(100), "an unbounded wait saturates rather than overflowing")
+	cfg = JobRetryConfig{Wait: ref.Duration(time.Duration(math.MaxInt64 / 2)), Backoff: JobRetryBackoffLinear}
+	assert.Equal(time.Duration(math.MaxInt64), cfg.WaitFor(3))
+}
+
+func TestJobRetryConfigValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(JobRetryConfig{}.Validate())
+	assert.Nil(JobRetryConfig{Backoff: JobRetryBackoffExponential, OnErrors: []string{"connection (refused|reset)"}}.Validate())
+	assert.True(ex.Is(JobRetryConfig{Backoff: "sometimes"}.Validate(), ErrJobRetryInvalid))
+	assert.True(ex.Is(JobRetryConfig{OnErrors: []string{"("}}.Validate(), ErrJobRetryInvalid))
+
+	_, err := NewJob(cron.NewJob(cron.OptJobName("test-job")), OptJobConfig(JobConfig{Retry: JobRetryConfig{OnErrors: []string{"("}}}))
+	assert.True(ex.Is(err, ErrJobRetryInvalid))
+}
+
+func TestJobRetryConfigShouldRetry(t *testing.T) {
+	assert := assert.New(t)
+
+	var cfg JobRetryConfig
+	retryable, err := cfg.ShouldRetry(nil, nil)
+	assert.Nil(err)
+	assert.False(retryable)
+	retryable, err = cfg.ShouldRetry(fmt.Errorf("connection refused"), nil)
+	assert.Nil(err)
+	assert.True(retryable)
+
+	cfg = JobRetryConfig{OnExitCodes: []int{75}, OnErrors: []string{"connection (refused|reset)"}}
+	retryable, err = cfg.ShouldRetry(fmt.Errorf("exit status 75"), &ExitInfo{ExitCode: 75})
+	assert.Nil(err)
+	assert.True(retryable)
+	retryable, err = cfg.ShouldRetry(fmt.Errorf("exit status 1"), &ExitInfo{ExitCode: 1})
+	assert.Nil(err)
+	assert.False(retryable)
+	retryable, err = cfg.ShouldRetry(fmt.Errorf("dial tcp: connection reset by peer"), nil)
+	assert.Nil(err)
+	assert.True(retryable)
+
+	cfg = JobRetryConfig{OnErrors: []string{"("}}
+	_, err = cfg.ShouldRetry(fmt.Errorf("connection refused"), nil)
+	assert.NotNil(err)
+}
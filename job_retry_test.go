@@ -0,0 +1,105 @@
+package jobkit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/ref"
+)
+
+func createTestRetryJob(cfg JobRetryConfig, errs ...error) (*Job, *int) {
+	var attempts int
+	action := func(ctx context.Context) error {
+		attempts++
+		if jio := GetJobInvocationOutput(ctx); jio != nil {
+			fmt.Fprintf(jio.Output, "attempt %d\n", attempts)
+		}
+		if attempts <= len(errs) {
+			return errs[attempts-1]
+		}
+		return nil
+	}
+	job := MustNewJob(cron.NewJob(cron.OptJobName("test-job"), cron.OptJobAction(action)),
+		OptJobConfig(JobConfig{Retry: cfg}),
+	)
+	return job, &attempts
+}
+
+func createTestRetryJobContext(job *Job) (context.Context, *cron.JobInvocation) {
+	ji := &cron.JobInvocation{
+		ID:      cron.NewJobInvocationID(),
+		JobName: job.Name(),
+		Started: cron.Now(),
+	}
+	return cron.WithJobInvocation(context.Background(), ji), ji
+}
+
+func TestJobExecuteRetries(t *testing.T) {
+	assert := assert.New(t)
+
+	job, attempts := createTestRetryJob(JobRetryConfig{
+		MaxAttempts: ref.Int(3),
+		Wait:        ref.Duration(time.Millisecond),
+	}, fmt.Errorf("first"), fmt.Errorf("second"))
+	ctx, ji := createTestRetryJobContext(job)
+
+	assert.Nil(job.Execute(ctx))
+	assert.Equal(3, *attempts)
+
+	jio := ji.State.(*JobInvocationOutput)
+	assert.Len(jio.Attempts, 3)
+	assert.Equal("first", jio.Attempts[0].Err)
+	assert.Zero(jio.Attempts[0].Wait)
+	assert.Equal("second", jio.Attempts[1].Err)
+	assert.Equal(time.Millisecond, jio.Attempts[1].Wait)
+	assert.Empty(jio.Attempts[2].Err)
+	assert.Equal(3, jio.Attempts[2].Attempt)
+	assert.Contains(jio.Output.String(), "attempt 1\n")
+	assert.Contains(jio.Output.String(), "==> attempt 3/3\n")
+}
+
+func TestJobExecuteRetriesExhausted(t *testing.T) {
+	assert := assert.New(t)
+
+	job, attempts := createTestRetryJob(JobRetryConfig{
+		MaxAttempts: ref.Int(2),
+		Wait:        ref.Duration(time.Millisecond),
+	}, fmt.Errorf("first"), fmt.Errorf("second"), fmt.Errorf("third"))
+	ctx, ji := createTestRetryJobContext(job)
+
+	err := job.Execute(ctx)
+	assert.NotNil(err)
+	assert.Equal("second", err.Error())
+	assert.Equal(2, *attempts)
+	assert.Len(ji.State.(*JobInvocationOutput).Attempts, 2)
+}
+
+func TestJobExecuteRetriesNotRetryable(t *testing.T) {
+	assert := assert.New(t)
+
+	job, attempts := createTestRetryJob(JobRetryConfig{
+		MaxAttempts: ref.Int(3),
+		Wait:        ref.Duration(time.Millisecond),
+		OnErrors:    []string{"timeout"},
+	}, fmt.Errorf("bad request"))
+	ctx, ji := createTestRetryJobContext(job)
+
+	assert.NotNil(job.Execute(ctx))
+	assert.Equal(1, *attempts)
+	assert.Len(ji.State.(*JobInvocationOutput).Attempts, 1)
+}
+
+func TestJobExecuteRetriesDisabled(t *testing.T) {
+	assert := assert.New(t)
+
+	job, attempts := createTestRetryJob(JobRetryConfig{}, fmt.Errorf("first"))
+	ctx, ji := createTestRetryJobContext(job)
+
+	assert.NotNil(job.Execute(ctx))
+	assert.Equal(1, *attempts)
+	assert.Empty(ji.State.(*JobInvocationOutput).Attempts)
+}
@@ -21,6 +21,8 @@ func HistoryStats(history []*JobInvocation) JobStats {
 			output.RunsErrored++
 		case cron.JobInvocationStatusCancelled:
 			output.RunsCancelled++
+		case JobInvocationStatusSkipped:
+			output.RunsSkipped++
 		}
 
 		elapsedTimes = append(elapsedTimes, ji.JobInvocation.Elapsed())
@@ -31,8 +33,8 @@ func HistoryStats(history []*JobInvocation) JobStats {
 			output.OutputBytes += len(ji.JobInvocationOutput.Output.Bytes())
 		}
 	}
-	if output.RunsTotal > 0 {
-		output.SuccessRate = float64(output.RunsSuccessful) / float64(output.RunsTotal)
+	if runs := output.RunsTotal - output.RunsSkipped; runs > 0 {
+		output.SuccessRate = float64(output.RunsSuccessful) / float64(runs)
 	}
 	output.Elapsed50th = mathutil.PercentileOfDuration(elapsedTimes, 50.0)
 	output.Elapsed95th = mathutil.PercentileOfDuration(elapsedTimes, 95.0)
@@ -47,6 +49,7 @@ type JobStats struct {
 	RunsSuccessful int           `json:"runsSuccessful"`
 	RunsErrored    int           `json:"runsErrored"`
 	RunsCancelled  int           `json:"runsCancelled"`
+	RunsSkipped    int           `json:"runsSkipped"`
 	ElapsedMax     time.Duration `json:"elapsedMax"`
 	ElapsedMin     time.Duration `json:"elapsedMin"`
 	Elapsed50th    time.Duration `json:"elapsed50th"`
@@ -270,3 +270,155 @@ func TestJobLifecycleHooksWebhookNotifications(t *testing.T) {
 
 	assert.Len(webhooks, 6)
 }
+
+func TestJobNotificationsQueueStats(t *testing.T) {
+	assert := assert.New(t)
+
+	job := MustNewJob(cron.NewJob(cron.OptJobName("test-job")))
+	assert.Empty(job.NotificationsQueueStats())
+
+	assert.Nil(job.OnLoad(context.Background()))
+	queueStats := job.NotificationsQueueStats()
+	assert.Len(queueStats, 3)
+	assert.Equal("email", queueStats[0].Name)
+	assert.Equal("slack", queueStats[1].Name)
+	assert.Equal("webhook", queueStats[2].Name)
+	for _, queue := range queueStats {
+		assert.True(queue.Running)
+	}
+
+	assert.Nil(job.OnUnload(context.Background()))
+	for _, queue := range job.NotificationsQueueStats() {
+		assert.False(queue.Running)
+	}
+}
+
+func TestJobSendStats(t *testing.T) {
+	assert := assert.New(t)
+
+	collector := newMockStatsCollector()
+	job := MustNewJob(cron.NewJob(cron.OptJobName("test-job")),
+		OptJobConfig(JobConfig{
+			JobConfig: cron.JobConfig{
+				Labels: map[string]string{"team": "test", "env": "sandbox"},
+			},
+			StatsPrefix: "jobkit",
+		}),
+	)
+	job.StatsClient = collector
+
+	output := NewJobInvocationOutput()
+	output.Output.Write([]byte("hello world"))
+	started := time.Now().UTC()
+	ctx := cron.WithJobInvocation(context.Background(), &cron.JobInvocation{
+		ID:       uuid.V4().String(),
+		JobName:  job.Name(),
+		Started:  started,
+		Complete: started.Add(500 * time.Millisecond),
+		Status:   cron.JobInvocationStatusSuccess,
+		State:    output,
+	})
+
+	job.sendStats(ctx, cron.FlagBegin)
+	metric := collector.waitForMetric("jobkit." + cron.FlagBegin)
+	assert.Equal([]string{"job:test-job", "env:sandbox", "team:test"}, metric.Tags)
+	metric = collector.waitForMetric("jobkit." + MetricJobRunning)
+	assert.Equal(1.0, metric.Value)
+
+	job.sendStats(ctx, cron.FlagBegin)
+	metric = collector.waitForMetric("jobkit." + MetricJobRunning)
+	assert.Equal(2.0, metric.Value, "the running gauge counts the running invocations")
+
+	job.sendStats(ctx, cron.FlagSuccess)
+	metric = collector.waitForMetric("jobkit." + MetricJobLastSuccessTimestamp)
+	assert.Equal("gauge", metric.Kind)
+	assert.InDelta(float64(time.Now().Unix()), metric.Value, 5)
+
+	job.sendStats(ctx, cron.FlagComplete)
+	metric = collector.waitForMetric("jobkit." + MetricJobRunning)
+	assert.Equal(1.0, metric.Value)
+	job.sendStats(ctx, cron.FlagComplete)
+	metric = collector.waitForMetric("jobkit." + MetricJobRunning)
+	assert.Equal(0.0, metric.Value)
+	metric = collector.waitForMetric("jobkit." + MetricJobElapsed)
+	assert.Equal("histogram", metric.Kind)
+	assert.Equal(500.0, metric.Value)
+	metric = collector.waitForMetric("jobkit." + MetricJobOutputBytes)
+	assert.Equal(float64(len("hello world")), metric.Value)
+
+	job.sendStats(context.Background(), cron.FlagDisabled)
+	metric = collector.waitForMetric("jobkit." + MetricJobDisabled)
+	assert.Equal(1.0, metric.Value)
+}
+
+func TestJobSkippedTransitions(t *testing.T) {
+	assert := assert.New(t)
+
+	collector := newMockStatsCollector()
+	job := MustNewJob(cron.NewJob(cron.OptJobName("test-job")))
+	job.StatsClient = collector
+
+	var last cron.JobInvocationStatus
+	run := func(err error, skipped bool) (broken, fixed int) {
+		output := NewJobInvocationOutput()
+		output.Skipped = skipped
+		ji := &cron.JobInvocation{
+			ID:      uuid.V4().String(),
+			JobName: job.Name(),
+			Err:     err,
+			State:   output,
+		}
+		ctx := cron.WithJobInvocation(context.Background(), ji)
+
+		// call the lifecycle hooks the way the job manager does,
+		// which treats skipped invocations as successful.
+		if err != nil {
+			ji.Status = cron.JobInvocationStatusErrored
+			job.OnError(ctx)
+			if last == cron.JobInvocationStatusSuccess {
+				job.OnBroken(ctx)
+			}
+		} else {
+			ji.Status = cron.JobInvocationStatusSuccess
+			job.OnSuccess(ctx)
+			if last == cron.JobInvocationStatusErrored {
+				job.OnFixed(ctx)
+			}
+		}
+		job.OnComplete(ctx)
+		last = ji.Status
+
+		for {
+			select {
+			case metric := <-collector.Metrics:
+				switch metric.Name {
+				case cron.FlagBroken:
+					broken++
+				case cron.FlagFixed:
+					fixed++
+				}
+			default:
+				return
+			}
+		}
+	}
+
+	testErr := fmt.Errorf("only a test")
+
+	broken, fixed := run(nil, false)
+	assert.Zero(broken + fixed)
+	broken, _ = run(testErr, false)
+	assert.Equal(1, broken)
+	broken, fixed = run(nil, true)
+	assert.Zero(broken+fixed, "skipped invocations should not fix the job")
+	broken, fixed = run(testErr, false)
+	assert.Zero(broken+fixed, "the job was already broken")
+	broken, fixed = run(nil, true)
+	assert.Zero(broken + fixed)
+	_, fixed = run(nil, false)
+	assert.Equal(1, fixed, "the job should be fixed past the skipped invocations")
+	broken, fixed = run(nil, true)
+	assert.Zero(broken + fixed)
+	broken, _ = run(testErr, false)
+	assert.Equal(1, broken, "the job should be broken past the skipped invocation")
+}
@@ -0,0 +1,50 @@
+package jobkit
+
+import (
+	"time"
+)
+
+// JobTriggerOn values.
+const (
+	JobTriggerOnSuccess  = "success"
+	JobTriggerOnComplete = "complete"
+)
+
+// JobTriggerConfig is a config for running a job after other jobs finish.
+//
+// A trigger fires once each of the jobs it runs after has finished since the trigger last fired,
+// e.g. "after `extract` succeeds", or "after `extract` and `load` both complete within an hour".
+type JobTriggerConfig struct {
+	// After are the names of the jobs that must finish for the trigger to fire.
+	After []string `yaml:"after"`
+	// On is what the jobs must finish with, one of `success` or `complete`, defaulting to `success`.
+	On string `yaml:"on"`
+	// Window is the time all the jobs must finish within; if unset, they can finish at any time.
+	Window *time.Duration `yaml:"window"`
+	// PassParameters passes the parameters of the invocations that fired the trigger to the job.
+	PassParameters *bool `yaml:"passParameters"`
+}
+
+// OnOrDefault returns a value or a default.
+func (jtc JobTriggerConfig) OnOrDefault() string {
+	if jtc.On != "" {
+		return jtc.On
+	}
+	return DefaultJobTriggerOn
+}
+
+// WindowOrDefault returns a value or a default.
+func (jtc JobTriggerConfig) WindowOrDefault() time.Duration {
+	if jtc.Window != nil {
+		return *jtc.Window
+	}
+	return 0
+}
+
+// PassParametersOrDefault returns a value or a default.
+func (jtc JobTriggerConfig) PassParametersOrDefault() bool {
+	if jtc.PassParameters != nil {
+		return *jtc.PassParameters
+	}
+	return DefaultJobTriggerPassParameters
+}
@@ -0,0 +1,199 @@
+package jobkit
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/ex"
+	"github.com/blend/go-sdk/logger"
+)
+
+// NewJobTriggers returns a new job triggers.
+func NewJobTriggers(options ...JobTriggersOption) *JobTriggers {
+	jt := &JobTriggers{
+		Triggers: make(map[string][]JobTriggerConfig),
+		state:    make(map[string][]map[string]jobTriggerFinished),
+	}
+	for _, opt := range options {
+		opt(jt)
+	}
+	return jt
+}
+
+// JobTriggersOption is an option or mutator for job triggers.
+type JobTriggersOption func(*JobTriggers)
+
+// OptJobTriggersJobManager sets the job manager the triggered jobs are run with.
+func OptJobTriggersJobManager(jm *cron.JobManager) JobTriggersOption {
+	return func(jt *JobTriggers) {
+		jt.JobManager = jm
+	}
+}
+
+// OptJobTriggersLog sets the job triggers logger.
+func OptJobTriggersLog(log logger.Log) JobTriggersOption {
+	return func(jt *JobTriggers) {
+		jt.Log = log
+	}
+}
+
+// JobTriggers is a process wide set of triggers that run jobs after other jobs finish.
+//
+// Jobs report their invocations from their success and complete lifecycle hooks, and
+// a trigger runs its job once each of the jobs it runs after has finished since it last fired.
+type JobTriggers struct {
+	sync.Mutex
+	Log        logger.Log
+	JobManager *cron.JobManager
+	// Triggers are the triggers of each job by job name.
+	Triggers map[string][]JobTriggerConfig
+
+	// state is the invocations that have finished for each trigger since it last fired,
+	// by the name of the job the trigger runs, then the name of the job that finished.
+	state map[string][]map[string]jobTriggerFinished
+}
+
+// jobTriggerFinished is an invocation that finished for a trigger.
+type jobTriggerFinished struct {
+	InvocationID string
+	Finished     time.Time
+	Parameters   cron.JobParameters
+}
+
+// Add adds the triggers for a job, returning an error if they are invalid or would add a cycle.
+func (jt *JobTriggers) Add(jobName string, triggers ...JobTriggerConfig) error {
+	if len(triggers) == 0 {
+		return nil
+	}
+	for _, trigger := range triggers {
+		if len(trigger.After) == 0 {
+			return ex.New(ErrJobTriggerInvalid, ex.OptMessagef("job: %s; after unset", jobName))
+		}
+		for index, upstream := range trigger.After {
+			if stringsContain(trigger.After[:index], upstream) {
+				return ex.New(ErrJobTriggerInvalid, ex.OptMessagef("job: %s; after: %s listed more than once", jobName, upstream))
+			}
+		}
+		if on := trigger.OnOrDefault(); on != JobTriggerOnSuccess && on != JobTriggerOnComplete {
+			return ex.New(ErrJobTriggerInvalid, ex.OptMessagef("job: %s; on: %s", jobName, on))
+		}
+	}
+
+	jt.Lock()
+	defer jt.Unlock()
+
+	proposed := make(map[string][]JobTriggerConfig)
+	for name, existing := range jt.Triggers {
+		proposed[name] = existing
+	}
+	proposed[jobName] = append(proposed[jobName], triggers...)
+	if cycle := NewJobGraph(proposed).Cycle(); cycle != nil {
+		return ex.New(ErrJobTriggerCycle, ex.OptMessagef("job: %s; cycle: %s", jobName, strings.Join(cycle, " -> ")))
+	}
+
+	jt.Triggers = proposed
+	for range triggers {
+		jt.state[jobName] = append(jt.state[jobName], make(map[string]jobTriggerFinished))
+	}
+	return nil
+}
+
+// Graph returns the graph of jobs and the triggers between them.
+func (jt *JobTriggers) Graph() JobGraph {
+	jt.Lock()
+	defer jt.Unlock()
+	return NewJobGraph(jt.Triggers)
+}
+
+// Observe records that an invocation finished with a given outcome, one of `success` or `complete`,
+// and runs the jobs whose triggers fire as a result.
+func (jt *JobTriggers) Observe(ctx context.Context, on string, ji *JobInvocation) {
+	if ji == nil {
+		return
+	}
+	for _, fired := range jt.observe(on, ji) {
+		jt.run(ctx, fired.jobName, fired.parameters, ji)
+	}
+}
+
+// jobTriggerFired is a job to run because one of its triggers fired.
+type jobTriggerFired struct {
+	jobName    string
+	parameters cron.JobParameters
+}
+
+func (jt *JobTriggers) observe(on string, ji *JobInvocation) (output []jobTriggerFired) {
+	jt.Lock()
+	defer jt.Unlock()
+
+	finished := ji.Complete
+	if finished.IsZero() {
+		finished = time.Now().UTC()
+	}
+	for jobName, triggers := range jt.Triggers {
+		for index, trigger := range triggers {
+			if trigger.OnOrDefault() != on || !stringsContain(trigger.After, ji.JobName) {
+				continue
+			}
+			state := jt.state[jobName][index]
+			state[ji.JobName] = jobTriggerFinished{
+				InvocationID: ji.ID,
+				Finished:     finished,
+				Parameters:   ji.Parameters,
+			}
+			if window := trigger.WindowOrDefault(); window > 0 {
+				for name, previous := range state {
+					if finished.Sub(previous.Finished) > window {
+						delete(state, name)
+					}
+				}
+			}
+			if len(state) < len(trigger.After) {
+				continue
+			}
+
+			fired := jobTriggerFired{jobName: jobName}
+			if trigger.PassParametersOrDefault() {
+				for _, upstream := range trigger.After {
+					fired.parameters = cron.MergeJobParameterValues(fired.parameters, state[upstream].Parameters)
+				}
+			}
+			jt.state[jobName][index] = make(map[string]jobTriggerFinished)
+			output = append(output, fired)
+		}
+	}
+	return
+}
+
+// run runs a job for a trigger that fired.
+//
+// The job is run with a new context, as the lifecycle hook that fired the trigger
+// can be cancelled before the job finishes.
+func (jt *JobTriggers) run(ctx context.Context, jobName string, parameters cron.JobParameters, ji *JobInvocation) {
+	if jt.JobManager == nil {
+		logger.MaybeWarningfContext(ctx, jt.Log, "trigger; job manager unset, cannot run %s after %s", jobName, ji.JobName)
+		return
+	}
+	logger.MaybeInfofContext(ctx, jt.Log, "trigger; running %s after %s (%s)", jobName, ji.JobName, ji.ID)
+	if _, _, err := RunJob(cron.WithJobParameterValues(context.Background(), parameters), jt.JobManager, jobName); err != nil {
+		logger.MaybeError(jt.Log, ex.New(err, ex.OptMessagef("trigger; job: %s; after: %s", jobName, ji.JobName)))
+	}
+}
+
+func stringsContain(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Job trigger errors.
+const (
+	ErrJobTriggerInvalid ex.Class = "job trigger invalid"
+	ErrJobTriggerCycle   ex.Class = "job triggers would run in a cycle"
+)
@@ -0,0 +1,99 @@
+package jobkit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/ex"
+	"github.com/blend/go-sdk/ref"
+)
+
+func TestJobTriggersAdd(t *testing.T) {
+	assert := assert.New(t)
+
+	triggers := NewJobTriggers()
+	assert.Nil(triggers.Add("extract"))
+	assert.Nil(triggers.Add("transform", JobTriggerConfig{After: []string{"extract"}}))
+	assert.Nil(triggers.Add("load", JobTriggerConfig{After: []string{"transform"}}))
+
+	err := triggers.Add("extract", JobTriggerConfig{After: []string{"load"}})
+	assert.True(ex.Is(err, ErrJobTriggerCycle))
+	assert.Empty(triggers.Triggers["extract"], "a rejected trigger should not be added")
+
+	assert.Nil(triggers.Add("report"))
+	assert.True(ex.Is(triggers.Add("report", JobTriggerConfig{}), ErrJobTriggerInvalid))
+	assert.True(ex.Is(triggers.Add("report", JobTriggerConfig{After: []string{"load"}, On: "sometimes"}), ErrJobTriggerInvalid))
+	assert.True(ex.Is(triggers.Add("report", JobTriggerConfig{After: []string{"load", "load"}}), ErrJobTriggerInvalid))
+
+	assert.Len(triggers.Graph().Edges, 2)
+}
+
+func TestJobTriggersObserve(t *testing.T) {
+	assert := assert.New(t)
+
+	triggers := NewJobTriggers()
+	assert.Nil(triggers.Add("transform", JobTriggerConfig{After: []string{"extract"}}))
+	assert.Nil(triggers.Add("report", JobTriggerConfig{After: []string{"extract", "load"}, On: JobTriggerOnComplete}))
+
+	extract := createTestCompleteJobInvocation("extract", time.Second, optJobParameters(map[string]string{"DATE": "2020-01-01", "SOURCE": "extract"}))
+	load := createTestCompleteJobInvocation("load", time.Second, optJobParameters(map[string]string{"SOURCE": "load"}))
+
+	fired := triggers.observe(JobTriggerOnSuccess, extract)
+	assert.Len(fired, 1)
+	assert.Equal("transform", fired[0].jobName)
+	assert.Equal(cron.JobParameters{"DATE": "2020-01-01", "SOURCE": "extract"}, fired[0].parameters)
+
+	assert.Empty(triggers.observe(JobTriggerOnComplete, extract))
+	fired = triggers.observe(JobTriggerOnComplete, load)
+	assert.Len(fired, 1)
+	assert.Equal("report", fired[0].jobName)
+	assert.Equal(cron.JobParameters{"DATE": "2020-01-01", "SOURCE": "load"}, fired[0].parameters)
+
+	// the trigger resets once it fires.
+	assert.Empty(triggers.observe(JobTriggerOnComplete, load))
+}
+
+func TestJobTriggersObserveWindow(t *testing.T) {
+	assert := assert.New(t)
+
+	triggers := NewJobTriggers()
+	assert.Nil(triggers.Add("report", JobTriggerConfig{
+		After:          []string{"extract", "load"},
+		Window:         ref.Duration(time.Hour),
+		PassParameters: ref.Bool(false),
+	}))
+
+	extract := createTestCompleteJobInvocation("extract", time.Second, optJobComplete(createTimestamp(-2*time.Hour)))
+	load := createTestCompleteJobInvocation("load", time.Second, optJobComplete(createTimestamp(0)), optJobParameters(map[string]string{"SOURCE": "load"}))
+
+	assert.Empty(triggers.observe(JobTriggerOnSuccess, extract))
+	assert.Empty(triggers.observe(JobTriggerOnSuccess, load), "extract finished outside the window")
+
+	extract = createTestCompleteJobInvocation("extract", time.Second, optJobComplete(createTimestamp(time.Minute)))
+	fired := triggers.observe(JobTriggerOnSuccess, extract)
+	assert.Len(fired, 1)
+	assert.Empty(fired[0].parameters)
+}
+
+func TestJobOnCompleteSkippedDoesNotTrigger(t *testing.T) {
+	assert := assert.New(t)
+
+	triggers := NewJobTriggers()
+	assert.Nil(triggers.Add("report", JobTriggerConfig{After: []string{"extract", "load"}, On: JobTriggerOnComplete}))
+
+	job := MustNewJob(cron.NewJob(cron.OptJobName("extract")))
+	job.Triggers = triggers
+
+	output := NewJobInvocationOutput()
+	output.Skipped = true
+	skipped := createTestCompleteJobInvocation("extract", time.Second)
+	skipped.State = output
+	job.OnComplete(cron.WithJobInvocation(context.Background(), &skipped.JobInvocation))
+
+	load := createTestCompleteJobInvocation("load", time.Second)
+	assert.Empty(triggers.observe(JobTriggerOnComplete, load), "skipped invocations don't count towards triggers")
+	assert.Len(triggers.observe(JobTriggerOnComplete, createTestCompleteJobInvocation("extract", time.Second)), 1)
+}
@@ -73,6 +73,8 @@ func NewJobViewModel(js *cron.JobScheduler) (*JobViewModel, error) {
 		Last:          last,
 		History:       history,
 		HistoryLookup: historyLookup,
+
+		NotificationsQueues: typed.NotificationsQueueStats(),
 	}, nil
 }
 
@@ -89,4 +91,6 @@ type JobViewModel struct {
 	Last          *JobInvocation
 	History       []*JobInvocation
 	HistoryLookup map[string]*JobInvocation
+
+	NotificationsQueues []RetryQueueStats
 }
@@ -0,0 +1,215 @@
+package jobkit
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/blend/go-sdk/async"
+	"github.com/blend/go-sdk/ex"
+	"github.com/blend/go-sdk/logger"
+)
+
+// Leader election errors.
+const (
+	ErrLeaderElectionInvalid  ex.Class = "leader election config invalid"
+	ErrLeaderElectionFollower ex.Class = "this replica is a follower and is read-only; use the leader to change jobs"
+)
+
+// NewLeaderElector returns a new leader elector.
+func NewLeaderElector(options ...LeaderElectorOption) *LeaderElector {
+	le := &LeaderElector{
+		Latch: async.NewLatch(),
+	}
+	for _, opt := range options {
+		opt(le)
+	}
+	return le
+}
+
+// LeaderElectorOption is an option or mutator for a leader elector.
+type LeaderElectorOption func(*LeaderElector)
+
+// OptLeaderElectorConfig sets the leader elector config.
+func OptLeaderElectorConfig(cfg LeaderElectionConfig) LeaderElectorOption {
+	return func(le *LeaderElector) {
+		le.Config = cfg
+	}
+}
+
+// OptLeaderElectorLock sets the lock the leader holds.
+func OptLeaderElectorLock(lock Lock) LeaderElectorOption {
+	return func(le *LeaderElector) {
+		le.Lock = lock
+	}
+}
+
+// OptLeaderElectorLog sets the leader elector logger.
+func OptLeaderElectorLog(log logger.Log) LeaderElectorOption {
+	return func(le *LeaderElector) {
+		le.Log = log
+	}
+}
+
+// OptLeaderElectorOnElected sets the handler called when the replica becomes the leader.
+func OptLeaderElectorOnElected(handler func(context.Context) error) LeaderElectorOption {
+	return func(le *LeaderElector) {
+		le.OnElected = handler
+	}
+}
+
+// OptLeaderElectorOnDemoted sets the handler called when the replica stops being the leader.
+func OptLeaderElectorOnDemoted(handler func(context.Context) error) LeaderElectorOption {
+	return func(le *LeaderElector) {
+		le.OnDemoted = handler
+	}
+}
+
+// LeaderStatus is the leader election status of a replica.
+type LeaderStatus struct {
+	// Enabled is set if the replica is one of a group that elects a leader.
+	Enabled bool `json:"enabled"`
+	// Leader is set if the replica schedules and runs jobs, i.e. if it's the leader or leader election is disabled.
+	Leader bool `json:"leader"`
+	// Provider is the lock provider.
+	Provider string `json:"provider,omitempty"`
+	// Key identifies the lock the replicas contend for.
+	Key string `json:"key,omitempty"`
+	// Lease bounds how long a failed leader keeps the lock.
+	Lease time.Duration `json:"lease,omitempty"`
+}
+
+// LeaderElector elects one of a group of replicas as the leader by having them contend for a shared lock.
+//
+// The replica holding the lock is the leader; it calls `OnElected` when it acquires the lock, typically to
+// start scheduling jobs, and `OnDemoted` if it can no longer confirm it holds the lock or is stopped.
+// The other replicas are followers, and try to acquire the lock each renew interval.
+type LeaderElector struct {
+	Latch     *async.Latch
+	Config    LeaderElectionConfig
+	Lock      Lock
+	Log       logger.Log
+	OnElected func(context.Context) error
+	OnDemoted func(context.Context) error
+
+	leader int32
+}
+
+// Start starts the elector and blocks until the elector is stopped.
+func (le *LeaderElector) Start() error {
+	if le.Lock == nil {
+		return ex.New(ErrLeaderElectionInvalid, ex.OptMessage("lock unset"))
+	}
+	if !le.Latch.CanStart() {
+		return async.ErrCannotStart
+	}
+	le.Latch.Started()
+
+	le.elect(context.Background())
+	ticker := time.NewTicker(le.Config.RenewInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			le.elect(context.Background())
+		case <-le.Latch.NotifyStopping():
+			le.demote(context.Background())
+			le.Latch.Stopped()
+			return nil
+		}
+	}
+}
+
+// Stop stops the elector, giving up leadership if the replica is the leader.
+func (le *LeaderElector) Stop() error {
+	if !le.Latch.CanStop() {
+		return async.ErrCannotStop
+	}
+	le.Latch.Stopping()
+	<-le.Latch.NotifyStopped()
+	return nil
+}
+
+// NotifyStarted returns the started notification channel.
+func (le *LeaderElector) NotifyStarted() <-chan struct{} {
+	return le.Latch.NotifyStarted()
+}
+
+// NotifyStopped returns the stopped notification channel.
+func (le *LeaderElector) NotifyStopped() <-chan struct{} {
+	return le.Latch.NotifyStopped()
+}
+
+// IsStarted returns if the elector is started.
+func (le *LeaderElector) IsStarted() bool {
+	return le.Latch.IsStarted()
+}
+
+// IsLeader returns if the replica is the leader.
+func (le *LeaderElector) IsLeader() bool {
+	return atomic.LoadInt32(&le.leader) == 1
+}
+
+// Status returns the leader election status of the replica.
+func (le *LeaderElector) Status() LeaderStatus {
+	return LeaderStatus{
+		Enabled:  true,
+		Leader:   le.IsLeader(),
+		Provider: le.Config.ProviderOrDefault(),
+		Key:      le.Config.KeyOrDefault(),
+		Lease:    le.Config.LeaseOrDefault(),
+	}
+}
+
+// elect confirms the leader still holds the lock, or tries to acquire the lock if the replica is a follower.
+//
+// Each attempt is bounded by the renew interval, so a leader that can't reach the lock is demoted within the lease.
+func (le *LeaderElector) elect(ctx context.Context) {
+	lockCtx, cancel := context.WithTimeout(ctx, le.Config.RenewInterval())
+	defer cancel()
+
+	if le.IsLeader() {
+		if err := le.Lock.Check(lockCtx); err != nil {
+			logger.MaybeError(le.Log, err)
+			le.demote(ctx)
+		}
+		return
+	}
+
+	acquired, err := le.Lock.TryLock(lockCtx)
+	if err != nil {
+		logger.MaybeError(le.Log, err)
+		return
+	}
+	if !acquired {
+		return
+	}
+	atomic.StoreInt32(&le.leader, 1)
+	logger.MaybeInfof(le.Log, "elected leader for: %s", le.Config.KeyOrDefault())
+	if le.OnElected != nil {
+		if err := le.OnElected(ctx); err != nil {
+			logger.MaybeError(le.Log, err)
+			le.demote(ctx)
+		}
+	}
+}
+
+// demote stops the replica being the leader and releases the lock.
+//
+// `OnDemoted` is called before the lock is released so the replica stops scheduling jobs before another takes over.
+func (le *LeaderElector) demote(ctx context.Context) {
+	if !atomic.CompareAndSwapInt32(&le.leader, 1, 0) {
+		return
+	}
+	logger.MaybeInfof(le.Log, "demoted to follower for: %s", le.Config.KeyOrDefault())
+	if le.OnDemoted != nil {
+		if err := le.OnDemoted(ctx); err != nil {
+			logger.MaybeError(le.Log, err)
+		}
+	}
+	lockCtx, cancel := context.WithTimeout(ctx, le.Config.RenewInterval())
+	defer cancel()
+	if err := le.Lock.Release(lockCtx); err != nil {
+		logger.MaybeError(le.Log, err)
+	}
+}
@@ -0,0 +1,87 @@
+package jobkit
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/blend/go-sdk/ex"
+)
+
+// LeaderElectionConfig is a config for electing one of a group of replicas to run jobs.
+type LeaderElectionConfig struct {
+	// Enabled runs the replica as one of a group that share a lock; only the replica holding it schedules and runs jobs.
+	Enabled *bool `yaml:"enabled"`
+	// Provider is the lock provider, one of `postgres` or `file`, defaulting to `postgres`.
+	//
+	// - `postgres` uses an advisory lock taken with the `db` config connection.
+	// - `file` uses a lock file, and is meant for running replicas on a single host for testing.
+	Provider string `yaml:"provider"`
+	// Key identifies the lock the replicas contend for, defaulting to `jobkit`.
+	Key string `yaml:"key"`
+	// Path is the lock file path for the `file` provider, defaulting to `<key>.lock` in the temp directory.
+	Path string `yaml:"path"`
+	// Lease bounds how long a failed leader keeps the lock, defaulting to 15s.
+	//
+	// The leader checks it still holds the lock, and followers try to acquire it, three times per lease.
+	// A leader that can't confirm the lock within a third of the lease is demoted. With the `postgres` provider,
+	// the database drops the session of a leader it can't reach after about the lease (see `LockPostgres.Lease`),
+	// so a follower takes over within the lease and a third, e.g. when the leader's host goes away.
+	// With the `file` provider the lock is released when the leader's process exits.
+	Lease *time.Duration `yaml:"lease"`
+}
+
+// EnabledOrDefault returns a value or a default.
+func (lec LeaderElectionConfig) EnabledOrDefault() bool {
+	if lec.Enabled != nil {
+		return *lec.Enabled
+	}
+	return DefaultLeaderElectionEnabled
+}
+
+// ProviderOrDefault returns a value or a default.
+func (lec LeaderElectionConfig) ProviderOrDefault() string {
+	if lec.Provider != "" {
+		return lec.Provider
+	}
+	return DefaultLeaderElectionProvider
+}
+
+// KeyOrDefault returns a value or a default.
+func (lec LeaderElectionConfig) KeyOrDefault() string {
+	if lec.Key != "" {
+		return lec.Key
+	}
+	return DefaultLeaderElectionKey
+}
+
+// PathOrDefault returns a value or a default.
+func (lec LeaderElectionConfig) PathOrDefault() string {
+	if lec.Path != "" {
+		return lec.Path
+	}
+	return filepath.Join(os.TempDir(), lec.KeyOrDefault()+".lock")
+}
+
+// LeaseOrDefault returns a value or a default.
+func (lec LeaderElectionConfig) LeaseOrDefault() time.Duration {
+	if lec.Lease != nil && *lec.Lease > 0 {
+		return *lec.Lease
+	}
+	return DefaultLeaderElectionLease
+}
+
+// RenewInterval returns how often the leader checks it still holds the lock, and followers try to acquire it.
+func (lec LeaderElectionConfig) RenewInterval() time.Duration {
+	return lec.LeaseOrDefault() / 3
+}
+
+// Validate returns an error if the provider is unknown.
+func (lec LeaderElectionConfig) Validate() error {
+	switch lec.ProviderOrDefault() {
+	case LockProviderPostgres, LockProviderFile:
+		return nil
+	default:
+		return ex.New(ErrLeaderElectionInvalid, ex.OptMessagef("provider: %s", lec.Provider))
+	}
+}
@@ -0,0 +1,135 @@
+package jobkit
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/ex"
+)
+
+// testLockHolder is the shared state of a set of test locks.
+type testLockHolder struct {
+	sync.Mutex
+	holder *testLock
+}
+
+// testLock is an in memory lock that can be lost on demand.
+type testLock struct {
+	*testLockHolder
+}
+
+func (tl *testLock) TryLock(_ context.Context) (bool, error) {
+	tl.testLockHolder.Lock()
+	defer tl.testLockHolder.Unlock()
+	if tl.holder != nil && tl.holder != tl {
+		return false, nil
+	}
+	tl.holder = tl
+	return true, nil
+}
+
+func (tl *testLock) Check(_ context.Context) error {
+	tl.testLockHolder.Lock()
+	defer tl.testLockHolder.Unlock()
+	if tl.holder != tl {
+		return ex.New(ErrLockNotHeld)
+	}
+	return nil
+}
+
+func (tl *testLock) Release(_ context.Context) error {
+	tl.testLockHolder.Lock()
+	defer tl.testLockHolder.Unlock()
+	if tl.holder == tl {
+		tl.holder = nil
+	}
+	return nil
+}
+
+func (tl *testLock) lose() {
+	tl.testLockHolder.Lock()
+	defer tl.testLockHolder.Unlock()
+	tl.holder = nil
+}
+
+func TestLeaderElectorElect(t *testing.T) {
+	assert := assert.New(t)
+
+	shared := new(testLockHolder)
+	var elected, demoted []string
+	createElector := func(name string) (*LeaderElector, *testLock) {
+		lock := &testLock{shared}
+		return NewLeaderElector(
+			OptLeaderElectorLock(lock),
+			OptLeaderElectorOnElected(func(_ context.Context) error {
+				elected = append(elected, name)
+				return nil
+			}),
+			OptLeaderElectorOnDemoted(func(_ context.Context) error {
+				demoted = append(demoted, name)
+				return nil
+			}),
+		), lock
+	}
+	first, firstLock := createElector("first")
+	second, _ := createElector("second")
+
+	first.elect(context.Background())
+	second.elect(context.Background())
+	assert.True(first.IsLeader())
+	assert.False(second.IsLeader())
+	assert.Equal([]string{"first"}, elected)
+
+	// the leader keeps the lock while it can confirm it holds it.
+	first.elect(context.Background())
+	second.elect(context.Background())
+	assert.True(first.IsLeader())
+	assert.False(second.IsLeader())
+
+	// the leader is demoted once it loses the lock, and a follower takes over.
+	firstLock.lose()
+	first.elect(context.Background())
+	assert.False(first.IsLeader())
+	assert.Equal([]string{"first"}, demoted)
+	second.elect(context.Background())
+	assert.True(second.IsLeader())
+	assert.Equal([]string{"first", "second"}, elected)
+
+	second.demote(context.Background())
+	assert.False(second.IsLeader())
+	assert.Equal([]string{"first", "second"}, demoted)
+	assert.Nil(shared.holder)
+}
+
+func TestLeaderElectorElectOnElectedError(t *testing.T) {
+	assert := assert.New(t)
+
+	shared := new(testLockHolder)
+	le := NewLeaderElector(
+		OptLeaderElectorLock(&testLock{shared}),
+		OptLeaderElectorOnElected(func(_ context.Context) error {
+			return ex.New("cannot start")
+		}),
+	)
+	le.elect(context.Background())
+	assert.False(le.IsLeader())
+	assert.Nil(shared.holder)
+}
+
+func TestLeaderElectionConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	var cfg LeaderElectionConfig
+	assert.False(cfg.EnabledOrDefault())
+	assert.Equal(LockProviderPostgres, cfg.ProviderOrDefault())
+	assert.Equal(DefaultLeaderElectionLease/3, cfg.RenewInterval())
+	assert.Nil(cfg.Validate())
+
+	cfg.Provider = "zookeeper"
+	assert.True(ex.Is(cfg.Validate(), ErrLeaderElectionInvalid))
+
+	_, err := NewLock(LockProviderPostgres, "jobkit", "", nil, 0)
+	assert.True(ex.Is(err, ErrLockInvalid))
+}
@@ -0,0 +1,67 @@
+package jobkit
+
+import (
+	"context"
+	"time"
+
+	"github.com/blend/go-sdk/db"
+	"github.com/blend/go-sdk/ex"
+)
+
+// Lock providers.
+const (
+	LockProviderPostgres = "postgres"
+	LockProviderFile     = "file"
+)
+
+// Lock errors.
+const (
+	ErrLockInvalid     ex.Class = "lock provider invalid"
+	ErrLockNotHeld     ex.Class = "lock not held"
+	ErrLockUnsupported ex.Class = "lock provider unsupported on this platform"
+)
+
+// Lock is a named lock shared between processes, and between replicas of a jobkit instance.
+type Lock interface {
+	// TryLock acquires the lock if it's free, returning if it was acquired.
+	TryLock(context.Context) (bool, error)
+	// Check returns an `ErrLockNotHeld` error if the lock is no longer held.
+	Check(context.Context) error
+	// Release releases the lock if it's held.
+	Release(context.Context) error
+}
+
+// LockProvider returns a new lock each time it's called, e.g. for each job invocation.
+//
+// Locks from a provider contend with each other, even within the same process.
+type LockProvider func() Lock
+
+// NewLockProvider returns a lock provider for a given provider; see `NewLock`.
+func NewLockProvider(provider, key, path string, conn *db.Connection, lease time.Duration) (LockProvider, error) {
+	if _, err := NewLock(provider, key, path, conn, lease); err != nil {
+		return nil, err
+	}
+	return func() Lock {
+		lock, _ := NewLock(provider, key, path, conn, lease)
+		return lock
+	}, nil
+}
+
+// NewLock returns a new lock for a given provider.
+//
+// The `postgres` provider takes an advisory lock on the key with the connection, which the database
+// releases within the lease if the holder's host or network fails (see `LockPostgres.Lease`), and
+// the `file` provider takes an exclusive lock on the file at the path.
+func NewLock(provider, key, path string, conn *db.Connection, lease time.Duration) (Lock, error) {
+	switch provider {
+	case LockProviderPostgres:
+		if conn == nil {
+			return nil, ex.New(ErrLockInvalid, ex.OptMessagef("provider: %s; a database connection is required", provider))
+		}
+		return &LockPostgres{Conn: conn, Key: key, Lease: lease}, nil
+	case LockProviderFile:
+		return &LockFile{Path: path}, nil
+	default:
+		return nil, ex.New(ErrLockInvalid, ex.OptMessagef("provider: %s", provider))
+	}
+}
@@ -0,0 +1,96 @@
+package jobkit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/blend/go-sdk/ex"
+)
+
+var (
+	_ Lock = (*LockFile)(nil)
+)
+
+// LockFile is a lock backed by an exclusive lock on a file, for replicas on the same host.
+//
+// The operating system releases the lock if the process holding it exits.
+type LockFile struct {
+	Path string
+
+	mu   sync.Mutex
+	file *os.File
+}
+
+// TryLock implements Lock.
+func (lf *LockFile) TryLock(_ context.Context) (bool, error) {
+	lf.mu.Lock()
+	defer lf.mu.Unlock()
+	if lf.file != nil {
+		return true, nil
+	}
+
+	file, err := os.OpenFile(lf.Path, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return false, ex.New(err)
+	}
+	acquired, err := tryLockFile(file)
+	if err != nil || !acquired {
+		_ = file.Close()
+		return false, err
+	}
+	// write the holder's pid to the file to make it easier to find.
+	if err = file.Truncate(0); err == nil {
+		_, err = fmt.Fprintf(file, "%d\n", os.Getpid())
+	}
+	if err != nil {
+		_ = unlockFile(file)
+		_ = file.Close()
+		return false, ex.New(err)
+	}
+	lf.file = file
+	return true, nil
+}
+
+// Check implements Lock.
+//
+// The lock is lost if the file was removed or replaced since it was locked.
+func (lf *LockFile) Check(_ context.Context) error {
+	lf.mu.Lock()
+	defer lf.mu.Unlock()
+	if lf.file == nil {
+		return ex.New(ErrLockNotHeld, ex.OptMessagef("path: %s", lf.Path))
+	}
+	held, err := lf.file.Stat()
+	if err != nil {
+		return ex.New(err)
+	}
+	current, err := os.Stat(lf.Path)
+	if err != nil || !os.SameFile(held, current) {
+		_ = unlockFile(lf.file)
+		_ = lf.file.Close()
+		lf.file = nil
+		return ex.New(ErrLockNotHeld, ex.OptMessagef("path: %s; the lock file was removed or replaced", lf.Path))
+	}
+	return nil
+}
+
+// Release implements Lock.
+func (lf *LockFile) Release(_ context.Context) error {
+	lf.mu.Lock()
+	defer lf.mu.Unlock()
+	if lf.file == nil {
+		return nil
+	}
+	file := lf.file
+	lf.file = nil
+	if err := unlockFile(file); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return ex.New(err)
+	}
+	return nil
+}
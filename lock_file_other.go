@@ -0,0 +1,20 @@
+//go:build windows || plan9
+// +build windows plan9
+
+package jobkit
+
+import (
+	"os"
+
+	"github.com/blend/go-sdk/ex"
+)
+
+// tryLockFile returns an error, as file locks aren't supported on this platform.
+func tryLockFile(file *os.File) (bool, error) {
+	return false, ex.New(ErrLockUnsupported, ex.OptMessagef("provider: %s; path: %s", LockProviderFile, file.Name()))
+}
+
+// unlockFile is a no-op on this platform.
+func unlockFile(_ *os.File) error {
+	return nil
+}
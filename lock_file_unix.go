@@ -0,0 +1,31 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package jobkit
+
+import (
+	"os"
+	"syscall"
+
+	"github.com/blend/go-sdk/ex"
+)
+
+// tryLockFile takes an exclusive lock on a file without blocking, returning if it was acquired.
+func tryLockFile(file *os.File) (bool, error) {
+	err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if err == syscall.EWOULDBLOCK {
+		return false, nil
+	}
+	if err != nil {
+		return false, ex.New(err, ex.OptMessagef("path: %s", file.Name()))
+	}
+	return true, nil
+}
+
+// unlockFile releases the lock on a file.
+func unlockFile(file *os.File) error {
+	if err := syscall.Flock(int(file.Fd()), syscall.LOCK_UN); err != nil {
+		return ex.New(err, ex.OptMessagef("path: %s", file.Name()))
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package jobkit
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/ex"
+)
+
+func TestLockFile(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "jobkit-lock")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "test.lock")
+
+	first := &LockFile{Path: path}
+	second := &LockFile{Path: path}
+
+	acquired, err := first.TryLock(context.Background())
+	assert.Nil(err)
+	assert.True(acquired)
+	assert.Nil(first.Check(context.Background()))
+
+	acquired, err = second.TryLock(context.Background())
+	assert.Nil(err)
+	assert.False(acquired)
+	assert.True(ex.Is(second.Check(context.Background()), ErrLockNotHeld))
+
+	assert.Nil(first.Release(context.Background()))
+	assert.True(ex.Is(first.Check(context.Background()), ErrLockNotHeld))
+
+	acquired, err = second.TryLock(context.Background())
+	assert.Nil(err)
+	assert.True(acquired)
+	assert.Nil(second.Release(context.Background()))
+}
+
+func TestLockFileRemoved(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "jobkit-lock")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "test.lock")
+
+	lock := &LockFile{Path: path}
+	acquired, err := lock.TryLock(context.Background())
+	assert.Nil(err)
+	assert.True(acquired)
+
+	assert.Nil(os.Remove(path))
+	assert.True(ex.Is(lock.Check(context.Background()), ErrLockNotHeld))
+
+	acquired, err = lock.TryLock(context.Background())
+	assert.Nil(err)
+	assert.True(acquired)
+	assert.Nil(lock.Release(context.Background()))
+}
@@ -0,0 +1,158 @@
+package jobkit
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/blend/go-sdk/db"
+	"github.com/blend/go-sdk/ex"
+)
+
+var (
+	_ Lock = (*LockPostgres)(nil)
+)
+
+// LockPostgres is a lock backed by a postgres session level advisory lock on a key.
+//
+// The lock is held by a connection taken from the pool and dedicated to it until the lock is released,
+// so the database releases it if the process holding it, or its connection, goes away.
+type LockPostgres struct {
+	Conn *db.Connection
+	Key  string
+	// Lease bounds how long the database keeps the lock for a holder it can't reach, if set.
+	//
+	// The holder's session is dropped, releasing the lock, once the holder's host stops answering
+	// TCP keepalives for the lease, and on postgres 14 and later, once the session has been idle for
+	// the lease. The holder must `Check` the lock more often than the lease to keep it.
+	Lease time.Duration
+
+	mu      sync.Mutex
+	session *sql.Conn
+}
+
+// TryLock implements Lock.
+func (lp *LockPostgres) TryLock(ctx context.Context) (bool, error) {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	if lp.session != nil {
+		return true, nil
+	}
+
+	session, err := lp.Conn.Connection.Conn(ctx)
+	if err != nil {
+		return false, ex.New(err)
+	}
+	if err = lp.setLease(ctx, session); err != nil {
+		discardSession(session)
+		return false, err
+	}
+	var acquired bool
+	if err = session.QueryRowContext(ctx, "select pg_try_advisory_lock(hashtext($1))", lp.Key).Scan(&acquired); err != nil {
+		discardSession(session)
+		return false, ex.New(err, ex.OptMessagef("key: %s", lp.Key))
+	}
+	if !acquired {
+		if err = lp.resetLease(ctx, session); err != nil {
+			discardSession(session)
+			return false, err
+		}
+		_ = session.Close()
+		return false, nil
+	}
+	lp.session = session
+	return true, nil
+}
+
+// Check implements Lock.
+//
+// The lock is held for as long as the session is, so this checks that the session is still alive.
+func (lp *LockPostgres) Check(ctx context.Context) error {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	if lp.session == nil {
+		return ex.New(ErrLockNotHeld, ex.OptMessagef("key: %s", lp.Key))
+	}
+	if _, err := lp.session.ExecContext(ctx, "select 1"); err != nil {
+		discardSession(lp.session)
+		lp.session = nil
+		return ex.New(ErrLockNotHeld, ex.OptMessagef("key: %s", lp.Key), ex.OptInner(err))
+	}
+	return nil
+}
+
+// Release implements Lock.
+func (lp *LockPostgres) Release(ctx context.Context) error {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	if lp.session == nil {
+		return nil
+	}
+	session := lp.session
+	lp.session = nil
+	if _, err := session.ExecContext(ctx, "select pg_advisory_unlock(hashtext($1))", lp.Key); err != nil {
+		discardSession(session)
+		return ex.New(err, ex.OptMessagef("key: %s", lp.Key))
+	}
+	if err := lp.resetLease(ctx, session); err != nil {
+		discardSession(session)
+		return err
+	}
+	if err := session.Close(); err != nil {
+		return ex.New(err)
+	}
+	return nil
+}
+
+// setLease sets the session timeouts that bound how long the database keeps the lock for a holder it can't reach.
+//
+// Keepalive probes start after a third of the lease and two more are sent a third of the lease apart, so the
+// session is dropped about a lease after the holder stops answering. Idle session timeouts need postgres 14.
+func (lp *LockPostgres) setLease(ctx context.Context, session *sql.Conn) error {
+	if lp.Lease <= 0 {
+		return nil
+	}
+	probe := int(lp.Lease / 3 / time.Second)
+	if probe < 1 {
+		probe = 1
+	}
+	if _, err := session.ExecContext(ctx,
+		"select set_config('tcp_keepalives_idle', $1, false), set_config('tcp_keepalives_interval', $1, false), set_config('tcp_keepalives_count', '2', false)",
+		strconv.Itoa(probe),
+	); err != nil {
+		return ex.New(err, ex.OptMessagef("key: %s; lease: %v", lp.Key, lp.Lease))
+	}
+	if _, err := session.ExecContext(ctx,
+		"select set_config('idle_session_timeout', $1, false) where current_setting('server_version_num')::int >= 140000",
+		strconv.FormatInt(int64(lp.Lease/time.Millisecond), 10),
+	); err != nil {
+		return ex.New(err, ex.OptMessagef("key: %s; lease: %v", lp.Key, lp.Lease))
+	}
+	return nil
+}
+
+// resetLease resets the session timeouts set by `setLease` before the session is returned to the pool,
+// so other users of the pool don't have their sessions dropped after the lease.
+func (lp *LockPostgres) resetLease(ctx context.Context, session *sql.Conn) error {
+	if lp.Lease <= 0 {
+		return nil
+	}
+	if _, err := session.ExecContext(ctx,
+		"select set_config(name, reset_val, false) from pg_settings where name in ('tcp_keepalives_idle', 'tcp_keepalives_interval', 'tcp_keepalives_count', 'idle_session_timeout')",
+	); err != nil {
+		return ex.New(err, ex.OptMessagef("key: %s; lease: %v", lp.Key, lp.Lease))
+	}
+	return nil
+}
+
+// discardSession closes a session's underlying connection rather than returning it to the pool,
+// so an advisory lock the session may still hold is released by the database.
+func discardSession(session *sql.Conn) {
+	_ = session.Raw(func(_ interface{}) error {
+		return driver.ErrBadConn
+	})
+	_ = session.Close()
+}
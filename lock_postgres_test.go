@@ -0,0 +1,70 @@
+package jobkit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/db"
+	"github.com/blend/go-sdk/uuid"
+)
+
+func createTestLockPostgresConn(assert *assert.Assertions) *db.Connection {
+	conn, err := db.New(db.OptConfig(db.Config{
+		Database: "postgres",
+		SSLMode:  db.SSLModeDisable,
+	}))
+	assert.Nil(err)
+	assert.Nil(conn.Open())
+	// use a single pooled connection so the lock's session is the one checked after it's returned.
+	conn.Connection.SetMaxOpenConns(1)
+	return conn
+}
+
+func lockPostgresSettings(assert *assert.Assertions, conn *db.Connection) (output []string) {
+	rows, err := conn.Connection.Query("select name, setting from pg_settings where name in ('tcp_keepalives_idle', 'tcp_keepalives_interval', 'tcp_keepalives_count', 'idle_session_timeout') order by name")
+	assert.Nil(err)
+	defer rows.Close()
+	for rows.Next() {
+		var name, setting string
+		assert.Nil(rows.Scan(&name, &setting))
+		output = append(output, name+"="+setting)
+	}
+	assert.Nil(rows.Err())
+	return
+}
+
+func TestLockPostgresLeaseReset(t *testing.T) {
+	assert := assert.New(t)
+
+	conn := createTestLockPostgresConn(assert)
+	defer conn.Close()
+	other := createTestLockPostgresConn(assert)
+	defer other.Close()
+
+	key := uuid.V4().String()
+	lease := time.Minute
+	lock := &LockPostgres{Conn: conn, Key: key, Lease: lease}
+	otherLock := &LockPostgres{Conn: other, Key: key, Lease: lease}
+
+	settings := lockPostgresSettings(assert, conn)
+	assert.NotEmpty(settings)
+	otherSettings := lockPostgresSettings(assert, other)
+
+	acquired, err := lock.TryLock(context.TODO())
+	assert.Nil(err)
+	assert.True(acquired)
+
+	acquired, err = otherLock.TryLock(context.TODO())
+	assert.Nil(err)
+	assert.False(acquired)
+	assert.Equal(otherSettings, lockPostgresSettings(assert, other), "a failed lock should reset its session")
+
+	assert.Nil(lock.Release(context.TODO()))
+	assert.Equal(settings, lockPostgresSettings(assert, conn), "a released lock should reset its session")
+
+	var idleSessionTimeout string
+	assert.Nil(conn.Connection.QueryRow("select coalesce(current_setting('idle_session_timeout', true), '')").Scan(&idleSessionTimeout))
+	assert.NotEqual("1min", idleSessionTimeout)
+}
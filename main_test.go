@@ -10,10 +10,83 @@ import (
 	"github.com/blend/go-sdk/assert"
 	"github.com/blend/go-sdk/bufferutil"
 	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/stats"
 	"github.com/blend/go-sdk/uuid"
 	"github.com/blend/go-sdk/web"
 )
 
+var (
+	_ stats.Collector = (*mockStatsCollector)(nil)
+)
+
+func newMockStatsCollector() *mockStatsCollector {
+	return &mockStatsCollector{
+		Metrics: make(chan mockMetric, 1024),
+	}
+}
+
+// mockMetric is a metric recorded by the mock stats collector.
+type mockMetric struct {
+	Kind    string
+	Name    string
+	Value   float64
+	Elapsed time.Duration
+	Tags    []string
+}
+
+// mockStatsCollector records every metric it is sent.
+type mockStatsCollector struct {
+	defaultTags []string
+	Metrics     chan mockMetric
+}
+
+func (msc *mockStatsCollector) AddDefaultTags(tags ...string) {
+	msc.defaultTags = append(msc.defaultTags, tags...)
+}
+
+func (msc *mockStatsCollector) DefaultTags() []string {
+	return msc.defaultTags
+}
+
+func (msc *mockStatsCollector) Count(name string, value int64, tags ...string) error {
+	msc.Metrics <- mockMetric{Kind: "count", Name: name, Value: float64(value), Tags: tags}
+	return nil
+}
+
+func (msc *mockStatsCollector) Increment(name string, tags ...string) error {
+	msc.Metrics <- mockMetric{Kind: "count", Name: name, Value: 1, Tags: tags}
+	return nil
+}
+
+func (msc *mockStatsCollector) Gauge(name string, value float64, tags ...string) error {
+	msc.Metrics <- mockMetric{Kind: "gauge", Name: name, Value: value, Tags: tags}
+	return nil
+}
+
+func (msc *mockStatsCollector) Histogram(name string, value float64, tags ...string) error {
+	msc.Metrics <- mockMetric{Kind: "histogram", Name: name, Value: value, Tags: tags}
+	return nil
+}
+
+func (msc *mockStatsCollector) TimeInMilliseconds(name string, value time.Duration, tags ...string) error {
+	msc.Metrics <- mockMetric{Kind: "timing", Name: name, Elapsed: value, Tags: tags}
+	return nil
+}
+
+func (msc *mockStatsCollector) Flush() error { return nil }
+
+func (msc *mockStatsCollector) Close() error { return nil }
+
+// waitForMetric reads metrics off the mock collector until it finds one with a given name.
+func (msc *mockStatsCollector) waitForMetric(name string) mockMetric {
+	for {
+		metric := <-msc.Metrics
+		if metric.Name == name {
+			return metric
+		}
+	}
+}
+
 type jobInvocationOption func(*JobInvocation)
 
 func optJobID(id string) jobInvocationOption {
@@ -48,6 +121,18 @@ func optJobParameters(params map[string]string) jobInvocationOption {
 	return func(ji *JobInvocation) { ji.Parameters = params }
 }
 
+func optJobExitInfo(exitInfo *ExitInfo) jobInvocationOption {
+	return func(ji *JobInvocation) { ji.ExitInfo = exitInfo }
+}
+
+func optJobArtifacts(artifacts ...Artifact) jobInvocationOption {
+	return func(ji *JobInvocation) { ji.Artifacts = artifacts }
+}
+
+func optJobOutputStreams(streams ...OutputStream) jobInvocationOption {
+	return func(ji *JobInvocation) { ji.OutputStreams = &OutputStreams{Streams: streams} }
+}
+
 func createTestJobInvocation(jobName string, opts ...jobInvocationOption) *JobInvocation {
 	output := &bufferutil.Buffer{
 		Chunks: []bufferutil.BufferChunk{
@@ -5,9 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"mime"
 	"net/http"
 	"net/url"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 	"time"
@@ -29,16 +31,27 @@ import (
 // NewServer returns a new management server that lets you
 // trigger jobs or look at job statuses via. a json api.
 func NewServer(jm *cron.JobManager, cfg Config, options ...web.Option) *web.App {
+	return NewServerWithPipelines(jm, nil, cfg, options...)
+}
+
+// NewServerWithPipelines returns a new management server that also lets you
+// start, cancel and look at pipeline runs.
+func NewServerWithPipelines(jm *cron.JobManager, pipelines []*Pipeline, cfg Config, options ...web.Option) *web.App {
 	options = append([]web.Option{web.OptConfig(cfg.Web)}, options...)
 	app := web.MustNew(options...)
-	app.Register(ManagementServer{Cron: jm, Config: cfg})
+	app.Register(ManagementServer{Cron: jm, Pipelines: pipelines, Config: cfg})
 	return app
 }
 
 // ManagementServer is the jobkit management server.
+//
+// If the leader elector is set and the replica is a follower, the server is read-only;
+// it shows jobs and their history but won't run, cancel, enable or disable them.
 type ManagementServer struct {
-	Config Config
-	Cron   *cron.JobManager
+	Config    Config
+	Cron      *cron.JobManager
+	Pipelines []*Pipeline
+	Leader    *LeaderElector
 }
 
 // Register registers the management server.
@@ -70,6 +83,18 @@ func (ms ManagementServer) Register(app *web.App) {
 		}
 		return strings.Join(ParameterValuesAsEnviron(params), ",")
 	}
+	app.Views.FuncMap["format_bytes"] = func(value int64) string {
+		const unit = 1 << 10
+		if value < unit {
+			return fmt.Sprintf("%dB", value)
+		}
+		div, exp := int64(unit), 0
+		for n := value / unit; n >= unit; n /= unit {
+			div *= unit
+			exp++
+		}
+		return fmt.Sprintf("%.1f%ciB", float64(value)/float64(div), "KMGTPE"[exp])
+	}
 	app.Views.FuncMap["job"] = func(viewmodel interface{}) *Job {
 		jobScheduler, ok := viewmodel.(*cron.JobScheduler)
 		if !ok {
@@ -84,37 +109,55 @@ func (ms ManagementServer) Register(app *web.App) {
 
 	// web specific routes
 	app.GET("/status.json", ms.getStatus)
+	app.GET("/metrics", ms.getMetrics)
 	app.GET("/static/*filepath", ms.getStatic)
 
 	// manager routes
 	app.GET("/", ms.getIndex)
 	app.GET("/search", ms.getSearch)
-	app.GET("/pause", ms.getPause)
-	app.GET("/resume", ms.getResume)
+	app.GET("/pause", ms.getPause, ms.leaderOnly)
+	app.GET("/resume", ms.getResume, ms.leaderOnly)
+	app.GET("/graph", ms.getGraph)
 
 	// job routes
 	app.GET("/job/:jobName", ms.getJob)
 	app.GET("/job/:jobName/:id", ms.getJobInvocation)
 	app.GET("/job.parameters/:jobName", ms.getJobParameters)
-	app.GET("/job.run/:jobName", ms.getJobRun)
-	app.GET("/job.enable/:jobName", ms.getJobEnable)
-	app.GET("/job.disable/:jobName", ms.getJobDisable)
-	app.GET("/job.cancel/:jobName", ms.getJobCancel)
+	app.GET("/job.run/:jobName", ms.getJobRun, ms.leaderOnly)
+	app.GET("/job.enable/:jobName", ms.getJobEnable, ms.leaderOnly)
+	app.GET("/job.disable/:jobName", ms.getJobDisable, ms.leaderOnly)
+	app.GET("/job.cancel/:jobName", ms.getJobCancel, ms.leaderOnly)
+
+	// pipeline routes
+	app.GET("/pipelines", ms.getPipelines)
+	app.GET("/pipeline/:pipelineName", ms.getPipeline)
+	app.GET("/pipeline/:pipelineName/:id", ms.getPipelineRun)
+	app.GET("/pipeline.run/:pipelineName", ms.getPipelineRunStart, ms.leaderOnly)
+	app.GET("/pipeline.cancel/:pipelineName/:id", ms.getPipelineRunCancel, ms.leaderOnly)
 
 	// api routes
-	app.POST("/api/pause", ms.postAPIPause)
-	app.POST("/api/resume", ms.postAPIResume)
+	app.POST("/api/pause", ms.postAPIPause, ms.leaderOnlyAPI)
+	app.POST("/api/resume", ms.postAPIResume, ms.leaderOnlyAPI)
 	app.GET("/api/jobs", ms.getAPIJobs)
 	app.GET("/api/jobs.running", ms.getAPIJobsRunning)
+	app.GET("/api/jobs.graph", ms.getAPIJobsGraph)
+	app.GET("/api/notifications.queues", ms.getAPINotificationsQueues)
+	app.GET("/api/leader", ms.getAPILeader)
 	app.GET("/api/job/:jobName", ms.getAPIJob)
 	app.GET("/api/job.parameters/:jobName", ms.getAPIJobParameters)
-	app.POST("/api/job.run/:jobName", ms.postAPIJobRun)
-	app.POST("/api/job.cancel/:jobName", ms.postAPIJobCancel)
-	app.POST("/api/job.disable/:jobName", ms.postAPIJobDisable)
-	app.POST("/api/job.enable/:jobName", ms.postAPIJobEnable)
+	app.POST("/api/job.run/:jobName", ms.postAPIJobRun, ms.leaderOnlyAPI)
+	app.POST("/api/job.cancel/:jobName", ms.postAPIJobCancel, ms.leaderOnlyAPI)
+	app.POST("/api/job.disable/:jobName", ms.postAPIJobDisable, ms.leaderOnlyAPI)
+	app.POST("/api/job.enable/:jobName", ms.postAPIJobEnable, ms.leaderOnlyAPI)
 	app.GET("/api/job/:jobName/:id", ms.getAPIJobInvocation)
 	app.GET("/api/job.output/:jobName/:id", ms.getAPIJobOutput)
 	app.GET("/api/job.output.stream/:jobName/:id", ms.getAPIJobOutputStream)
+	app.GET("/api/job.artifacts/:jobName/:id", ms.getAPIJobArtifacts)
+	app.GET("/api/pipelines", ms.getAPIPipelines)
+	app.GET("/api/pipeline/:pipelineName", ms.getAPIPipeline)
+	app.GET("/api/pipeline/:pipelineName/:id", ms.getAPIPipelineRun)
+	app.POST("/api/pipeline.run/:pipelineName", ms.postAPIPipelineRun, ms.leaderOnlyAPI)
+	app.POST("/api/pipeline.cancel/:pipelineName/:id", ms.postAPIPipelineCancel, ms.leaderOnlyAPI)
 
 	// debug things
 	app.GET("/api/debug/error", func(r *web.Ctx) web.Result {
@@ -131,17 +174,50 @@ func (ms ManagementServer) ViewPaths() []string {
 		"_views/job.html",
 		"_views/invocation.html",
 		"_views/parameters.html",
+		"_views/graph.html",
+		"_views/pipelines.html",
+		"_views/pipeline.html",
+		"_views/pipeline_run.html",
 		"_views/partials/job_table.html",
 		"_views/partials/job_row.html",
+		"_views/partials/notifications_queues.html",
 		"_views/status/error.html",
 		"_views/status/not_found.html",
 		"_views/status/bad_request.html",
 	}
 }
 
+// ManagementServerStatus is the `/status.json` response with the notification queue health,
+// returned if the `notificationsQueues` query parameter is `true`.
+type ManagementServerStatus struct {
+	State               cron.JobManagerState     `json:"state"`
+	NotificationsQueues NotificationsQueuesStats `json:"notificationsQueues"`
+}
+
 // getStatus is mapped to GET /status.json
+//
+// It returns the job manager state, as it always has, unless the notification queue health
+// is requested with `?notificationsQueues=true`; see `ManagementServerStatus`.
 func (ms ManagementServer) getStatus(r *web.Ctx) web.Result {
-	return web.JSON.Result(ms.Cron.State())
+	if r.QueryValue("notificationsQueues") != "true" {
+		return web.JSON.Result(ms.Cron.State())
+	}
+	return web.JSON.Result(ManagementServerStatus{
+		State:               ms.Cron.State(),
+		NotificationsQueues: ms.notificationsQueuesStats(),
+	})
+}
+
+// getMetrics is mapped to GET /metrics
+func (ms ManagementServer) getMetrics(r *web.Ctx) web.Result {
+	buffer := new(bytes.Buffer)
+	if err := WritePrometheusMetrics(buffer, NewPrometheusJobs(ms.Cron.Jobs)); err != nil {
+		return web.Text.InternalError(err)
+	}
+	r.Response.Header().Set(webutil.HeaderContentType, PrometheusContentType)
+	r.Response.WriteHeader(http.StatusOK)
+	_, _ = r.Response.Write(buffer.Bytes())
+	return nil
 }
 
 // getStatic is mapped to GET /static/*filepath
@@ -182,6 +258,9 @@ func (ms ManagementServer) getStatic(r *web.Ctx) web.Result {
 // getIndex is mapped to GET /
 func (ms ManagementServer) getIndex(r *web.Ctx) web.Result {
 	r.State.Set("show-job-history-link", true)
+	if dispatcher := ms.notificationsDispatcher(); dispatcher != nil {
+		r.State.Set("notifications-queues", dispatcher.Stats())
+	}
 	jobs, err := NewJobViewModels(ms.Cron.Jobs)
 	if err != nil {
 		return r.Views.InternalError(err)
@@ -220,11 +299,17 @@ func (ms ManagementServer) getPause(r *web.Ctx) web.Result {
 	return web.RedirectWithMethod("GET", "/")
 }
 
+// getGraph is mapped to GET /graph
+func (ms ManagementServer) getGraph(r *web.Ctx) web.Result {
+	return r.Views.View("graph", ms.jobGraph())
+}
+
 // getResume is mapped to GET /resume
 func (ms ManagementServer) getResume(r *web.Ctx) web.Result {
 	if err := ms.Cron.StartAsync(); err != nil {
 		return r.Views.BadRequest(err)
 	}
+	CatchUpMissedRuns(context.Background(), ms.Cron)
 	return web.RedirectWithMethod("GET", "/")
 }
 
@@ -261,14 +346,9 @@ func (ms ManagementServer) getJobRun(r *web.Ctx) web.Result {
 		return r.Views.BadRequest(err)
 	}
 
-	jobScheduler, err := ms.Cron.Job(job.Name)
-	if err != nil {
-		return r.Views.BadRequest(err)
-	}
-
 	parameters := job.Config.Parameters
 	parameterValues := ParameterValuesFromForm(parameters, r.Request.Form)
-	ji, _, err := jobScheduler.RunAsyncContext(cron.WithJobParameterValues(context.Background(), parameterValues))
+	ji, _, err := RunJob(cron.WithJobParameterValues(context.Background(), parameterValues), ms.Cron, job.Name)
 	if err != nil {
 		return r.Views.BadRequest(err)
 	}
@@ -305,7 +385,7 @@ func (ms ManagementServer) getJobCancel(r *web.Ctx) web.Result {
 	if result != nil {
 		return result
 	}
-	err := ms.Cron.CancelJob(job.Name)
+	err := CancelJob(ms.Cron, job.Name)
 	if err != nil {
 		return r.Views.BadRequest(err)
 	}
@@ -341,6 +421,46 @@ func (ms ManagementServer) getAPIJobsRunning(r *web.Ctx) web.Result {
 	}))
 }
 
+// getAPINotificationsQueues is mapped to GET /api/notifications.queues
+//
+// It returns the shared dispatcher queues once, and the queues of jobs that have their own.
+func (ms ManagementServer) getAPINotificationsQueues(r *web.Ctx) web.Result {
+	return web.JSON.Result(ms.notificationsQueuesStats())
+}
+
+// notificationsQueuesStats returns the stats of the shared dispatcher queues, and of the queues of jobs that have their own.
+func (ms ManagementServer) notificationsQueuesStats() NotificationsQueuesStats {
+	jobs := make(map[string][]RetryQueueStats)
+	for name, jobScheduler := range ms.Cron.Jobs {
+		if job, ok := jobScheduler.Job.(*Job); ok {
+			if queueStats := job.NotificationsQueueStats(); len(queueStats) > 0 {
+				jobs[name] = queueStats
+			}
+		}
+	}
+	var dispatcher []RetryQueueStats
+	if nd := ms.notificationsDispatcher(); nd != nil {
+		dispatcher = nd.Stats()
+	}
+	return NotificationsQueuesStats{
+		Dispatcher: dispatcher,
+		Jobs:       jobs,
+	}
+}
+
+// getAPILeader is mapped to GET /api/leader
+func (ms ManagementServer) getAPILeader(r *web.Ctx) web.Result {
+	if ms.Leader == nil {
+		return web.JSON.Result(LeaderStatus{Leader: true})
+	}
+	return web.JSON.Result(ms.Leader.Status())
+}
+
+// getAPIJobsGraph is mapped to GET /api/jobs.graph
+func (ms ManagementServer) getAPIJobsGraph(r *web.Ctx) web.Result {
+	return web.JSON.Result(ms.jobGraph())
+}
+
 // postAPIPause is mapped to POST /api/pause
 func (ms ManagementServer) postAPIPause(r *web.Ctx) web.Result {
 	if err := ms.Cron.Stop(); err != nil {
@@ -354,6 +474,7 @@ func (ms ManagementServer) postAPIResume(r *web.Ctx) web.Result {
 	if err := ms.Cron.StartAsync(); err != nil {
 		return r.Views.BadRequest(err)
 	}
+	CatchUpMissedRuns(context.Background(), ms.Cron)
 	return web.JSON.OK()
 }
 
@@ -394,7 +515,7 @@ func (ms ManagementServer) postAPIJobRun(r *web.Ctx) web.Result {
 			return web.JSON.BadRequest(err)
 		}
 	}
-	ji, _, err := ms.Cron.RunJobContext(cron.WithJobParameterValues(context.Background(), params), job.Name)
+	ji, _, err := RunJob(cron.WithJobParameterValues(context.Background(), params), ms.Cron, job.Name)
 	if err != nil {
 		return web.JSON.BadRequest(err)
 	}
@@ -407,7 +528,7 @@ func (ms ManagementServer) postAPIJobCancel(r *web.Ctx) web.Result {
 	if result != nil {
 		return result
 	}
-	if err := ms.Cron.CancelJob(job.Name); err != nil {
+	if err := CancelJob(ms.Cron, job.Name); err != nil {
 		return web.JSON.BadRequest(err)
 	}
 	return web.JSON.OK()
@@ -447,16 +568,22 @@ func (ms ManagementServer) getAPIJobInvocation(r *web.Ctx) web.Result {
 }
 
 // getAPIJobOutput is mapped to GET /api/job.output/:jobName/:id
+//
+// The `stream` query parameter filters the output to the chunks written to `stdout` or `stderr`.
 func (ms ManagementServer) getAPIJobOutput(r *web.Ctx) web.Result {
 	invocation, result := ms.getRequestJobInvocation(r, web.JSON)
 	if result != nil {
 		return result
 	}
-	chunks := invocation.JobInvocationOutput.Output.Chunks
+	streams, err := ms.getRequestOutputStreams(r)
+	if err != nil {
+		return web.JSON.BadRequest(err)
+	}
+	chunks := invocation.JobInvocationOutput.OutputChunks(streams...)
 	if afterNanos, _ := web.Int64Value(r.QueryValue("afterNanos")); afterNanos > 0 {
 		afterTS := time.Unix(0, afterNanos)
 
-		var filtered []bufferutil.BufferChunk
+		var filtered []OutputChunk
 		for _, chunk := range chunks {
 			if chunk.Timestamp.After(afterTS) {
 				filtered = append(filtered, chunk)
@@ -480,6 +607,11 @@ func (ms ManagementServer) getAPIJobOutputStream(r *web.Ctx) web.Result {
 		return result
 	}
 
+	streams, err := ms.getRequestOutputStreams(r)
+	if err != nil {
+		return web.JSON.BadRequest(err)
+	}
+
 	// set up the event source
 	es := webutil.NewEventSource(r.Response)
 	if err := es.StartSession(); err != nil {
@@ -488,7 +620,7 @@ func (ms ManagementServer) getAPIJobOutputStream(r *web.Ctx) web.Result {
 	}
 
 	// check if the job is running, if not, send the complete event and return
-	if !ms.Cron.IsJobRunning(invocation.JobInvocation.JobName) {
+	if !IsJobInvocationRunning(ms.Cron, invocation.JobName, invocation.ID) {
 		logger.MaybeDebugf(r.App.Log, "output stream; job is not running, closing")
 		if err := es.EventData("complete", string(invocation.Status)); err != nil {
 			logger.MaybeError(r.App.Log, err)
@@ -499,12 +631,15 @@ func (ms ManagementServer) getAPIJobOutputStream(r *web.Ctx) web.Result {
 	// this is a helper closure that splits
 	// a chunk into lines, and sends each line individually
 	// because the server events spec is not ideal
-	sendOutputData := func(chunk bufferutil.BufferChunk) {
-		for _, line := range stringutil.SplitLines(string(chunk.Data),
+	sendOutputData := func(chunk OutputChunk) {
+		if len(streams) > 0 && !hasOutputStream(streams, chunk.Stream) {
+			return
+		}
+		for _, line := range stringutil.SplitLines(chunk.Data,
 			stringutil.OptSplitLinesIncludeNewLine(true),
 			stringutil.OptSplitLinesIncludeEmptyLines(true),
 		) {
-			contents, _ := json.Marshal(map[string]interface{}{"data": strings.TrimSuffix(line, "\n")})
+			contents, _ := json.Marshal(map[string]interface{}{"data": strings.TrimSuffix(line, "\n"), "stream": chunk.Stream})
 			if strings.HasSuffix(line, "\n") {
 				if err := es.EventData("writeln", string(contents)); err != nil {
 					logger.MaybeError(r.App.Log, err)
@@ -521,7 +656,7 @@ func (ms ManagementServer) getAPIJobOutputStream(r *web.Ctx) web.Result {
 	if afterNanos, _ := web.Int64Value(r.QueryValue("afterNanos")); afterNanos > 0 {
 		after := time.Unix(0, afterNanos)
 		logger.MaybeDebugf(r.App.Log, "output stream; sending catchup output stream data from: %v", after)
-		for _, chunk := range invocation.Output.Chunks {
+		for _, chunk := range invocation.OutputChunks() {
 			if chunk.Timestamp.After(after) {
 				sendOutputData(chunk)
 			}
@@ -534,7 +669,11 @@ func (ms ManagementServer) getAPIJobOutputStream(r *web.Ctx) web.Result {
 	logger.MaybeDebugf(r.App.Log, "output stream; listening for new chunks")
 	// listen for new chunks, this will fire synchronously
 	invocation.OutputHandlers.Add(listenerID, func(chunk bufferutil.BufferChunk) {
-		sendOutputData(chunk)
+		sendOutputData(OutputChunk{
+			Timestamp: chunk.Timestamp,
+			Stream:    invocation.OutputStreams.Last(),
+			Data:      string(chunk.Data),
+		})
 	})
 	// unhook on exit
 	defer func() { invocation.OutputHandlers.Remove(listenerID) }()
@@ -545,7 +684,7 @@ func (ms ManagementServer) getAPIJobOutputStream(r *web.Ctx) web.Result {
 	for {
 		select {
 		case <-updateTick:
-			if !ms.Cron.IsJobRunning(invocation.JobName) { // if the job isn't running, return
+			if !IsJobInvocationRunning(ms.Cron, invocation.JobName, invocation.ID) { // if the invocation isn't running, return
 				logger.MaybeDebugf(r.App.Log, "output stream; job invocation is complete, closing")
 				if err := es.EventData("complete", string(invocation.Status)); err != nil {
 					logger.MaybeError(r.App.Log, err)
@@ -570,14 +709,87 @@ func (ms ManagementServer) getAPIJobOutputStream(r *web.Ctx) web.Result {
 	}
 }
 
+// getAPIJobArtifacts is mapped to GET /api/job.artifacts/:jobName/:id
+//
+// It returns a zip archive of the invocation artifacts, or a single artifact if the `name` query parameter is set.
+func (ms ManagementServer) getAPIJobArtifacts(r *web.Ctx) web.Result {
+	invocation, result := ms.getRequestJobInvocation(r, web.JSON)
+	if result != nil {
+		return result
+	}
+	if len(invocation.Artifacts) == 0 {
+		return web.JSON.NotFound()
+	}
+
+	if name := r.QueryValue("name"); name != "" {
+		for _, artifact := range invocation.Artifacts {
+			if artifact.Name == name {
+				return ms.writeAttachment(r, path.Base(artifact.Name), "application/octet-stream", artifact.Contents)
+			}
+		}
+		return web.JSON.NotFound()
+	}
+
+	buffer := new(bytes.Buffer)
+	if err := WriteArtifactsZip(buffer, invocation.Artifacts); err != nil {
+		return web.JSON.InternalError(err)
+	}
+	return ms.writeAttachment(r, fmt.Sprintf("%s-%s-artifacts.zip", invocation.JobName, invocation.ID), "application/zip", buffer.Bytes())
+}
+
+// writeAttachment writes contents to the response as a file download.
+func (ms ManagementServer) writeAttachment(r *web.Ctx, filename, contentType string, contents []byte) web.Result {
+	r.Response.Header().Set(webutil.HeaderContentType, contentType)
+	r.Response.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	r.Response.WriteHeader(http.StatusOK)
+	_, _ = r.Response.Write(contents)
+	return nil
+}
+
 // addContextStateConfig is a middleware that adds the config to a request context's state.
 func (ms ManagementServer) addContextStateConfig(action web.Action) web.Action {
 	return func(r *web.Ctx) web.Result {
 		r.State.Set("config", ms.Config)
+		r.State.Set("readOnly", ms.isFollower())
+		return action(r)
+	}
+}
+
+// leaderOnly is a middleware that rejects view requests that change jobs if the replica is a follower.
+func (ms ManagementServer) leaderOnly(action web.Action) web.Action {
+	return func(r *web.Ctx) web.Result {
+		if ms.isFollower() {
+			return r.Views.BadRequest(ex.New(ErrLeaderElectionFollower))
+		}
+		return action(r)
+	}
+}
+
+// leaderOnlyAPI is a middleware that rejects api requests that change jobs if the replica is a follower.
+func (ms ManagementServer) leaderOnlyAPI(action web.Action) web.Action {
+	return func(r *web.Ctx) web.Result {
+		if ms.isFollower() {
+			return web.JSON.BadRequest(ex.New(ErrLeaderElectionFollower))
+		}
 		return action(r)
 	}
 }
 
+// notificationsDispatcher returns the shared notifications dispatcher the jobs use, if any.
+func (ms ManagementServer) notificationsDispatcher() *NotificationsDispatcher {
+	for _, jobScheduler := range ms.Cron.Jobs {
+		if job, ok := jobScheduler.Job.(*Job); ok && job.NotificationsDispatcher != nil {
+			return job.NotificationsDispatcher
+		}
+	}
+	return nil
+}
+
+// isFollower returns if the replica is a follower, and the server is read-only.
+func (ms ManagementServer) isFollower() bool {
+	return ms.Leader != nil && !ms.Leader.IsLeader()
+}
+
 func (ms ManagementServer) getRequestJob(r *web.Ctx, resultProvider web.ResultProvider) (*JobViewModel, web.Result) {
 	jobName, err := r.RouteParam("jobName")
 	if err != nil {
@@ -598,6 +810,18 @@ func (ms ManagementServer) getRequestJob(r *web.Ctx, resultProvider web.ResultPr
 	return jvm, nil
 }
 
+// getRequestOutputStreams pulls the output stream filter off a request context.
+func (ms ManagementServer) getRequestOutputStreams(r *web.Ctx) ([]OutputStream, error) {
+	switch stream := OutputStream(r.QueryValue("stream")); stream {
+	case "":
+		return nil, nil
+	case OutputStreamStdout, OutputStreamStderr:
+		return []OutputStream{stream}, nil
+	default:
+		return nil, ex.New(ErrInvalidOutputStream, ex.OptMessagef("stream: %s", stream))
+	}
+}
+
 // getRequestJobInvocation pulls a job invocation off a request context.
 func (ms ManagementServer) getRequestJobInvocation(r *web.Ctx, resultProvider web.ResultProvider) (*JobInvocation, web.Result) {
 	job, result := ms.getRequestJob(r, resultProvider)
@@ -619,9 +843,197 @@ func (ms ManagementServer) getRequestJobInvocation(r *web.Ctx, resultProvider we
 		return job.Last, nil
 	}
 
+	if detached := DetachedJobInvocation(ms.Cron, job.Name, invocationID); detached != nil {
+		return NewJobInvocation(detached), nil
+	}
+
 	invocation, ok := job.HistoryLookup[invocationID]
 	if !ok {
 		return nil, resultProvider.NotFound()
 	}
 	return invocation, nil
 }
+
+// jobGraph returns the graph of the loaded jobs and the triggers between them.
+func (ms ManagementServer) jobGraph() JobGraph {
+	triggers := make(map[string][]JobTriggerConfig)
+	for name, jobScheduler := range ms.Cron.Jobs {
+		triggers[name] = nil
+		if job, ok := jobScheduler.Job.(*Job); ok {
+			triggers[name] = job.JobConfig.Triggers
+		}
+	}
+	return NewJobGraph(triggers)
+}
+
+// getPipelines is mapped to GET /pipelines
+func (ms ManagementServer) getPipelines(r *web.Ctx) web.Result {
+	pipelines, err := NewPipelineViewModels(r.Context(), ms.Pipelines)
+	if err != nil {
+		return r.Views.InternalError(err)
+	}
+	return r.Views.View("pipelines", pipelines)
+}
+
+// getPipeline is mapped to GET /pipeline/:pipelineName
+func (ms ManagementServer) getPipeline(r *web.Ctx) web.Result {
+	pipeline, result := ms.getRequestPipeline(r, r.Views)
+	if result != nil {
+		return result
+	}
+	pvm, err := NewPipelineViewModel(r.Context(), pipeline)
+	if err != nil {
+		return r.Views.InternalError(err)
+	}
+	return r.Views.View("pipeline", pvm)
+}
+
+// getPipelineRun is mapped to GET /pipeline/:pipelineName/:id
+func (ms ManagementServer) getPipelineRun(r *web.Ctx) web.Result {
+	run, result := ms.getRequestPipelineRun(r, r.Views)
+	if result != nil {
+		return result
+	}
+	return r.Views.View("pipeline_run", run)
+}
+
+// getPipelineRunStart is mapped to GET /pipeline.run/:pipelineName
+func (ms ManagementServer) getPipelineRunStart(r *web.Ctx) web.Result {
+	pipeline, result := ms.getRequestPipeline(r, r.Views)
+	if result != nil {
+		return result
+	}
+	if err := r.Request.ParseForm(); err != nil {
+		return r.Views.BadRequest(err)
+	}
+	run, err := pipeline.Run(r.Context(), ParameterValuesFromForm(pipeline.Config.Parameters, r.Request.Form))
+	if err != nil {
+		return r.Views.BadRequest(err)
+	}
+	return web.RedirectWithMethodf("GET", "/pipeline/%s/%s", url.QueryEscape(pipeline.Name()), run.ID)
+}
+
+// getPipelineRunCancel is mapped to GET /pipeline.cancel/:pipelineName/:id
+func (ms ManagementServer) getPipelineRunCancel(r *web.Ctx) web.Result {
+	pipeline, result := ms.getRequestPipeline(r, r.Views)
+	if result != nil {
+		return result
+	}
+	runID, err := r.RouteParam("id")
+	if err != nil {
+		return r.Views.BadRequest(err)
+	}
+	if err := pipeline.Cancel(runID); err != nil {
+		return r.Views.BadRequest(err)
+	}
+	return web.RedirectWithMethodf("GET", "/pipeline/%s/%s", url.QueryEscape(pipeline.Name()), runID)
+}
+
+// getAPIPipelines is mapped to GET /api/pipelines
+func (ms ManagementServer) getAPIPipelines(r *web.Ctx) web.Result {
+	pipelines, err := NewPipelineViewModels(r.Context(), ms.Pipelines)
+	if err != nil {
+		return web.JSON.InternalError(err)
+	}
+	return web.JSON.Result(pipelines)
+}
+
+// getAPIPipeline is mapped to GET /api/pipeline/:pipelineName
+func (ms ManagementServer) getAPIPipeline(r *web.Ctx) web.Result {
+	pipeline, result := ms.getRequestPipeline(r, web.JSON)
+	if result != nil {
+		return result
+	}
+	pvm, err := NewPipelineViewModel(r.Context(), pipeline)
+	if err != nil {
+		return web.JSON.InternalError(err)
+	}
+	return web.JSON.Result(pvm)
+}
+
+// getAPIPipelineRun is mapped to GET /api/pipeline/:pipelineName/:id
+func (ms ManagementServer) getAPIPipelineRun(r *web.Ctx) web.Result {
+	run, result := ms.getRequestPipelineRun(r, web.JSON)
+	if result != nil {
+		return result
+	}
+	return web.JSON.Result(run)
+}
+
+// postAPIPipelineRun is mapped to POST /api/pipeline.run/:pipelineName
+func (ms ManagementServer) postAPIPipelineRun(r *web.Ctx) web.Result {
+	pipeline, result := ms.getRequestPipeline(r, web.JSON)
+	if result != nil {
+		return result
+	}
+	body, err := r.PostBody()
+	if err != nil {
+		return web.JSON.BadRequest(err)
+	}
+	var params cron.JobParameters
+	if len(body) > 0 {
+		params, err = ParameterValuesFromJSON(pipeline.Config.Parameters, body)
+		if err != nil {
+			return web.JSON.BadRequest(err)
+		}
+	}
+	run, err := pipeline.Run(r.Context(), params)
+	if err != nil {
+		return web.JSON.BadRequest(err)
+	}
+	return web.JSON.Result(run)
+}
+
+// postAPIPipelineCancel is mapped to POST /api/pipeline.cancel/:pipelineName/:id
+func (ms ManagementServer) postAPIPipelineCancel(r *web.Ctx) web.Result {
+	pipeline, result := ms.getRequestPipeline(r, web.JSON)
+	if result != nil {
+		return result
+	}
+	runID, err := r.RouteParam("id")
+	if err != nil {
+		return web.JSON.BadRequest(err)
+	}
+	if err := pipeline.Cancel(runID); err != nil {
+		return web.JSON.BadRequest(err)
+	}
+	return web.JSON.OK()
+}
+
+// getRequestPipeline pulls a pipeline off a request context.
+func (ms ManagementServer) getRequestPipeline(r *web.Ctx, resultProvider web.ResultProvider) (*Pipeline, web.Result) {
+	pipelineName, err := r.RouteParam("pipelineName")
+	if err != nil {
+		return nil, resultProvider.BadRequest(err)
+	}
+	pipelineName, err = url.QueryUnescape(pipelineName)
+	if err != nil {
+		return nil, resultProvider.BadRequest(err)
+	}
+	for _, pipeline := range ms.Pipelines {
+		if pipeline.Name() == pipelineName {
+			return pipeline, nil
+		}
+	}
+	return nil, resultProvider.NotFound()
+}
+
+// getRequestPipelineRun pulls a pipeline run off a request context.
+func (ms ManagementServer) getRequestPipelineRun(r *web.Ctx, resultProvider web.ResultProvider) (*PipelineRun, web.Result) {
+	pipeline, result := ms.getRequestPipeline(r, resultProvider)
+	if result != nil {
+		return nil, result
+	}
+	runID, err := r.RouteParam("id")
+	if err != nil {
+		return nil, resultProvider.BadRequest(err)
+	}
+	run, err := pipeline.GetRun(r.Context(), runID)
+	if err != nil {
+		if ex.Is(err, ErrPipelineRunNotFound) {
+			return nil, resultProvider.NotFound()
+		}
+		return nil, resultProvider.InternalError(err)
+	}
+	return run, nil
+}
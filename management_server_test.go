@@ -66,6 +66,76 @@ func TestManagmentServerGetRequestJobInvocation(t *testing.T) {
 	assert.Equal(id, found.JobInvocation.ID)
 }
 
+func TestManagementServerStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	jm, app := createTestManagementServer()
+
+	var status cron.JobManagerState
+	meta, err := web.MockGet(app, "/status.json").JSON(&status)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Equal(jm.State(), status)
+
+	var withQueues ManagementServerStatus
+	meta, err = web.MockGet(app, "/status.json", r2.OptQueryValue("notificationsQueues", "true")).JSON(&withQueues)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Equal(jm.State(), withQueues.State)
+	assert.Len(withQueues.NotificationsQueues.Jobs, 3)
+}
+
+func TestManagementServerAPINotificationsQueues(t *testing.T) {
+	assert := assert.New(t)
+
+	_, app := createTestManagementServer()
+
+	var notificationsQueues NotificationsQueuesStats
+	meta, err := web.MockGet(app, "/api/notifications.queues").JSON(&notificationsQueues)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Len(notificationsQueues.Jobs, 3)
+	assert.Empty(notificationsQueues.Dispatcher)
+}
+
+func TestManagementServerMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	jm, app := createTestManagementServer()
+
+	// the metrics count invocations as they complete rather than reading history.
+	test0 := jm.Jobs["test0"].Job.(*Job)
+	for _, ji := range []*JobInvocation{
+		createTestCompleteJobInvocation("test0", 200*time.Millisecond),
+		createTestCompleteJobInvocation("test0", 250*time.Millisecond),
+		createTestFailedJobInvocation("test0", 5*time.Second, fmt.Errorf("only a test")),
+	} {
+		ctx := cron.WithJobInvocation(context.Background(), &ji.JobInvocation)
+		test0.sendStats(ctx, cron.FlagBegin)
+		if ji.Status == cron.JobInvocationStatusSuccess {
+			test0.sendStats(ctx, cron.FlagSuccess)
+		}
+		test0.sendStats(ctx, cron.FlagComplete)
+	}
+	test0.sendStats(context.Background(), cron.FlagBegin)
+	test0.sendStats(context.Background(), cron.FlagBegin)
+
+	contents, meta, err := web.MockGet(app, "/metrics").Bytes()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Equal(PrometheusContentType, meta.Header.Get("Content-Type"))
+	assert.Contains(string(contents), "# TYPE jobkit_job_runs_total counter")
+	assert.Contains(string(contents), `jobkit_job_runs_total{job="test0",status="success"} 2`)
+	assert.Contains(string(contents), `jobkit_job_runs_total{job="test0",status="errored"} 1`)
+	assert.Contains(string(contents), `jobkit_job_runs_total{job="test1",status="success"} 0`, "history isn't counted")
+	assert.Contains(string(contents), `jobkit_job_elapsed_seconds_bucket{job="test0",le="1"} 2`)
+	assert.Contains(string(contents), `jobkit_job_elapsed_seconds_count{job="test0"} 3`)
+	assert.Contains(string(contents), `jobkit_job_running{job="test0"} 2`)
+	assert.Contains(string(contents), `jobkit_job_running{job="test1"} 0`)
+	assert.Contains(string(contents), `jobkit_job_last_success_timestamp_seconds{job="test0"}`)
+	assert.NotContains(string(contents), `jobkit_job_last_success_timestamp_seconds{job="test1"}`)
+}
+
 func TestManagementServerStatic(t *testing.T) {
 	assert := assert.New(t)
 
@@ -269,6 +339,68 @@ func TestManagementServerJobInvocation(t *testing.T) {
 	assert.Contains(string(contents), invocationID)
 }
 
+func TestManagementServerJobInvocationExitInfo(t *testing.T) {
+	assert := assert.New(t)
+
+	jm, app := createTestManagementServer()
+
+	ji := firstInvocation(jm)
+	ji.ExitInfo = &ExitInfo{PID: 4321, ExitCode: -1, Signal: "killed", MaxRSS: 2 << 20}
+
+	contents, meta, err := web.MockGet(app, fmt.Sprintf("/job/%s/%s", ji.JobName, ji.ID)).Bytes()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode, string(contents))
+	assert.Contains(string(contents), "Exit Code")
+	assert.Contains(string(contents), "4321")
+	assert.Contains(string(contents), "killed")
+	assert.Contains(string(contents), "2.0MiB")
+
+	var verify JobInvocation
+	meta, err = web.MockGet(app, fmt.Sprintf("/api/job/%s/%s", ji.JobName, ji.ID)).JSON(&verify)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Equal(ji.ExitInfo, verify.ExitInfo)
+}
+
+func TestManagementServerJobArtifacts(t *testing.T) {
+	assert := assert.New(t)
+
+	jm, app := createTestManagementServer()
+
+	ji := firstInvocation(jm)
+
+	meta, err := web.MockGet(app, fmt.Sprintf("/api/job.artifacts/%s/%s", ji.JobName, ji.ID)).Discard()
+	assert.Nil(err)
+	assert.Equal(http.StatusNotFound, meta.StatusCode)
+
+	ji.Artifacts = []Artifact{
+		{Name: "reports/a.csv", Size: 4, Contents: []byte("a,1\n")},
+	}
+
+	contents, meta, err := web.MockGet(app, fmt.Sprintf("/job/%s/%s", ji.JobName, ji.ID)).Bytes()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode, string(contents))
+	assert.Contains(string(contents), "reports/a.csv")
+
+	contents, meta, err = web.MockGet(app, fmt.Sprintf("/api/job.artifacts/%s/%s", ji.JobName, ji.ID), r2.OptQueryValue("name", "reports/a.csv")).Bytes()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Equal("a,1\n", string(contents))
+	assert.Equal(`attachment; filename=a.csv`, meta.Header.Get("Content-Disposition"))
+
+	contents, meta, err = web.MockGet(app, fmt.Sprintf("/api/job.artifacts/%s/%s", ji.JobName, ji.ID)).Bytes()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Equal("application/zip", meta.Header.Get("Content-Type"))
+	assert.NotEmpty(contents)
+
+	var verify JobInvocation
+	meta, err = web.MockGet(app, fmt.Sprintf("/api/job/%s/%s", ji.JobName, ji.ID)).JSON(&verify)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Equal([]Artifact{{Name: "reports/a.csv", Size: 4}}, verify.Artifacts)
+}
+
 func TestManagementServerJobInvocationCurrent(t *testing.T) {
 	assert := assert.New(t)
 
@@ -321,6 +453,80 @@ func TestManagementServerAPIJobsRunning(t *testing.T) {
 	assert.Equal("test0", jobs[0].Name)
 }
 
+func TestManagementServerAPIJobsGraph(t *testing.T) {
+	assert := assert.New(t)
+
+	jm, app := createTestManagementServer()
+	jm.Jobs["test1"].Job.(*Job).JobConfig.Triggers = []JobTriggerConfig{{After: []string{"test0"}}}
+
+	var graph JobGraph
+	meta, err := web.MockGet(app, "/api/jobs.graph").JSON(&graph)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Len(graph.Nodes, 3)
+	assert.Len(graph.Edges, 1)
+	assert.Equal("test0", graph.Edges[0].From)
+	assert.Equal("test1", graph.Edges[0].To)
+	assert.Equal(JobTriggerOnSuccess, graph.Edges[0].On)
+
+	contents, meta, err := web.MockGet(app, "/graph").Bytes()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Contains(string(contents), "after <a href=\"/job/test0\">test0</a> on success")
+}
+
+func TestManagementServerAPIPipelines(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := createTestJobManager()
+	history := new(PipelineHistoryMemory)
+	pipeline, err := NewPipeline(PipelineConfig{
+		Name: "etl",
+		Stages: []PipelineStageConfig{
+			{Job: "test0"},
+			{Job: "test1", After: []string{"test0"}},
+		},
+	}, OptPipelineJobManager(jm), OptPipelineHistory(history))
+	assert.Nil(err)
+	assert.Nil(history.Add(context.Background(), &PipelineRun{
+		ID:           "test-run",
+		PipelineName: "etl",
+		Started:      time.Now().UTC(),
+		Complete:     time.Now().UTC(),
+		Status:       PipelineStatusSuccess,
+		Stages: []PipelineStageRun{
+			{Name: "test0", JobName: "test0", Status: PipelineStatusSuccess},
+			{Name: "test1", JobName: "test1", After: []string{"test0"}, Status: PipelineStatusSuccess},
+		},
+	}))
+	app := NewServerWithPipelines(jm, []*Pipeline{pipeline}, Config{})
+
+	var pipelines []PipelineViewModel
+	meta, err := web.MockGet(app, "/api/pipelines").JSON(&pipelines)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Len(pipelines, 1)
+	assert.Equal("etl", pipelines[0].Name)
+	assert.Len(pipelines[0].Graph.Edges, 1)
+	assert.Len(pipelines[0].History, 1)
+
+	var run PipelineRun
+	meta, err = web.MockGet(app, "/api/pipeline/etl/test-run").JSON(&run)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Equal(PipelineStatusSuccess, run.Status)
+	assert.Len(run.Stages, 2)
+
+	meta, err = web.MockGet(app, "/api/pipeline/etl/not-a-run").Discard()
+	assert.Nil(err)
+	assert.Equal(http.StatusNotFound, meta.StatusCode)
+
+	contents, meta, err := web.MockGet(app, "/pipeline/etl/test-run").Bytes()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Contains(string(contents), "/job/test1")
+}
+
 func TestManagementServerAPIPause(t *testing.T) {
 	assert := assert.New(t)
 
@@ -541,6 +747,48 @@ func TestManagementServerAPIJobOutputAfterNanosInvalid(t *testing.T) {
 	assert.Len(output.Chunks, 5)
 }
 
+func TestManagementServerAPIJobOutputStreamFilter(t *testing.T) {
+	assert := assert.New(t)
+
+	jm, app := createTestManagementServer()
+
+	invocation := firstInvocation(jm)
+	invocationID := invocation.JobInvocation.ID
+	invocation.OutputStreams = &OutputStreams{
+		Streams: []OutputStream{OutputStreamStdout, OutputStreamStderr, OutputStreamStdout, OutputStreamStderr, OutputStreamStdout},
+	}
+
+	var output struct {
+		ServerTimeNanos int64         `json:"serverTimeNanos"`
+		Chunks          []OutputChunk `json:"chunks"`
+	}
+	meta, err := web.MockGet(app,
+		fmt.Sprintf("/api/job.output/%s/%s", invocation.JobName, invocationID),
+		r2.OptQueryValue("stream", "stderr"),
+	).JSON(&output)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Len(output.Chunks, 2)
+	assert.Equal(OutputStreamStderr, output.Chunks[0].Stream)
+	assert.Equal(string(invocation.Output.Chunks[1].Data), output.Chunks[0].Data)
+	assert.Equal(string(invocation.Output.Chunks[3].Data), output.Chunks[1].Data)
+
+	meta, err = web.MockGet(app,
+		fmt.Sprintf("/api/job.output/%s/%s", invocation.JobName, invocationID),
+		r2.OptQueryValue("stream", "stdout"),
+	).JSON(&output)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Len(output.Chunks, 3)
+
+	meta, err = web.MockGet(app,
+		fmt.Sprintf("/api/job.output/%s/%s", invocation.JobName, invocationID),
+		r2.OptQueryValue("stream", "baileydog"),
+	).Discard()
+	assert.Nil(err)
+	assert.Equal(http.StatusBadRequest, meta.StatusCode)
+}
+
 func TestManagementServerAPIJobOutputStreamComplete(t *testing.T) {
 	assert := assert.New(t)
 
@@ -639,3 +887,120 @@ func TestManagementServerAPIJobOutputStream(t *testing.T) {
 	line := scanner.Text()
 	assert.Equal("event: complete", line)
 }
+
+func TestManagementServerAPIJobOutputStreamDetached(t *testing.T) {
+	assert := assert.New(t)
+
+	jm, app := createTestManagementServer()
+
+	start := make(chan struct{})
+	finish := make(chan struct{})
+	job := MustNewJob(cron.NewJob(
+		cron.OptJobName("detached-test"),
+		cron.OptJobAction(func(ctx context.Context) error {
+			<-start
+			io.WriteString(GetJobInvocationOutput(ctx).Output, "test1\n")
+			<-finish
+			return nil
+		}),
+	))
+	jm.LoadJobs(job)
+
+	ji, done := job.runDetached(context.Background())
+	assert.True(IsJobInvocationRunning(jm, job.Name(), ji.ID))
+
+	var invocation cron.JobInvocation
+	meta, err := web.MockGet(app, fmt.Sprintf("/api/job/%s/%s", job.Name(), ji.ID)).JSON(&invocation)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Equal(ji.ID, invocation.ID)
+
+	res, err := web.MockGet(app,
+		fmt.Sprintf("/api/job.output.stream/%s/%s", job.Name(), ji.ID),
+	).Do()
+	assert.Nil(err)
+	defer res.Body.Close()
+	assert.Equal(http.StatusOK, res.StatusCode)
+
+	close(start)
+
+	scanner := bufio.NewScanner(res.Body)
+	for _, expected := range []string{
+		"event: ping",
+		"",
+		"event: writeln",
+		"data: {\"data\":\"test1\"}",
+		"",
+	} {
+		scanner.Scan()
+		assert.Equal(expected, scanner.Text())
+	}
+
+	close(finish)
+	<-done
+	assert.False(IsJobInvocationRunning(jm, job.Name(), ji.ID))
+	var complete bool
+	for scanner.Scan() {
+		if scanner.Text() == "event: complete" {
+			complete = true
+			break
+		}
+	}
+	assert.True(complete)
+}
+
+func TestManagementServerFollowerReadOnly(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := createTestJobManager()
+	leader := NewLeaderElector(OptLeaderElectorLock(&testLock{new(testLockHolder)}))
+	app := web.MustNew()
+	app.Register(ManagementServer{Cron: jm, Leader: leader})
+
+	meta, err := web.MockGet(app, "/api/jobs").Discard()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+
+	meta, err = web.MockPost(app, "/api/job.run/test1", nil).Discard()
+	assert.Nil(err)
+	assert.Equal(http.StatusBadRequest, meta.StatusCode)
+
+	leader.elect(context.Background())
+	assert.True(leader.IsLeader())
+
+	meta, err = web.MockPost(app, "/api/job.run/test1", nil).Discard()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+}
+
+func TestManagementServerAPILeader(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := createTestJobManager()
+	app := web.MustNew()
+	app.Register(ManagementServer{Cron: jm})
+
+	var status LeaderStatus
+	meta, err := web.MockGet(app, "/api/leader").JSON(&status)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.False(status.Enabled)
+	assert.True(status.Leader, "replicas run jobs if leader election is disabled")
+
+	leader := NewLeaderElector(OptLeaderElectorLock(&testLock{new(testLockHolder)}))
+	app = web.MustNew()
+	app.Register(ManagementServer{Cron: jm, Leader: leader})
+
+	meta, err = web.MockGet(app, "/api/leader").JSON(&status)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.True(status.Enabled)
+	assert.False(status.Leader)
+	assert.Equal(DefaultLeaderElectionKey, status.Key)
+	assert.Equal(DefaultLeaderElectionLease, status.Lease)
+
+	leader.elect(context.Background())
+	meta, err = web.MockGet(app, "/api/leader").JSON(&status)
+	assert.Nil(err)
+	assert.True(status.Leader)
+}
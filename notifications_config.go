@@ -0,0 +1,35 @@
+package jobkit
+
+// NotificationsConfig configures the shared notifications dispatcher.
+type NotificationsConfig struct {
+	// EmailParallelism is the maximum number of email notifications sent at once.
+	EmailParallelism *int `yaml:"emailParallelism"`
+	// SlackParallelism is the maximum number of slack notifications sent at once.
+	SlackParallelism *int `yaml:"slackParallelism"`
+	// WebhookParallelism is the maximum number of webhook notifications sent at once.
+	WebhookParallelism *int `yaml:"webhookParallelism"`
+}
+
+// EmailParallelismOrDefault returns a value or a default.
+func (nc NotificationsConfig) EmailParallelismOrDefault() int {
+	if nc.EmailParallelism != nil && *nc.EmailParallelism > 0 {
+		return *nc.EmailParallelism
+	}
+	return DefaultNotificationsParallelism
+}
+
+// SlackParallelismOrDefault returns a value or a default.
+func (nc NotificationsConfig) SlackParallelismOrDefault() int {
+	if nc.SlackParallelism != nil && *nc.SlackParallelism > 0 {
+		return *nc.SlackParallelism
+	}
+	return DefaultNotificationsParallelism
+}
+
+// WebhookParallelismOrDefault returns a value or a default.
+func (nc NotificationsConfig) WebhookParallelismOrDefault() int {
+	if nc.WebhookParallelism != nil && *nc.WebhookParallelism > 0 {
+		return *nc.WebhookParallelism
+	}
+	return DefaultNotificationsParallelism
+}
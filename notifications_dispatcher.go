@@ -0,0 +1,217 @@
+package jobkit
+
+import (
+	"context"
+	"time"
+
+	"github.com/blend/go-sdk/async"
+	"github.com/blend/go-sdk/ex"
+	"github.com/blend/go-sdk/logger"
+	"github.com/blend/go-sdk/stats"
+)
+
+// Notification channels.
+const (
+	NotificationChannelEmail   = "email"
+	NotificationChannelSlack   = "slack"
+	NotificationChannelWebhook = "webhook"
+)
+
+// NewNotificationsDispatcher returns a new notifications dispatcher.
+//
+// The queues are created here rather than when the dispatcher starts, so they can be
+// read by jobs and the management server while the dispatcher starts.
+func NewNotificationsDispatcher(options ...NotificationsDispatcherOption) *NotificationsDispatcher {
+	nd := &NotificationsDispatcher{
+		Latch: async.NewLatch(),
+	}
+	for _, opt := range options {
+		opt(nd)
+	}
+	nd.Email = nd.newQueue(NotificationChannelEmail, nd.Config.EmailParallelismOrDefault())
+	nd.Slack = nd.newQueue(NotificationChannelSlack, nd.Config.SlackParallelismOrDefault())
+	nd.Webhook = nd.newQueue(NotificationChannelWebhook, nd.Config.WebhookParallelismOrDefault())
+	return nd
+}
+
+// NotificationsDispatcherOption is an option or mutator for a notifications dispatcher.
+type NotificationsDispatcherOption func(*NotificationsDispatcher)
+
+// OptNotificationsDispatcherConfig sets the notifications dispatcher config.
+func OptNotificationsDispatcherConfig(cfg NotificationsConfig) NotificationsDispatcherOption {
+	return func(nd *NotificationsDispatcher) {
+		nd.Config = cfg
+	}
+}
+
+// OptNotificationsDispatcherLog sets the notifications dispatcher logger.
+func OptNotificationsDispatcherLog(log logger.Log) NotificationsDispatcherOption {
+	return func(nd *NotificationsDispatcher) {
+		nd.Log = log
+	}
+}
+
+// OptNotificationsDispatcherStats sets the notifications dispatcher stats collector.
+func OptNotificationsDispatcherStats(collector stats.Collector) NotificationsDispatcherOption {
+	return func(nd *NotificationsDispatcher) {
+		nd.StatsClient = collector
+	}
+}
+
+// NotificationsDispatcher is a process wide set of retry queues, one per notification channel,
+// that jobs send their notifications through.
+//
+// Each channel has its own concurrency limit, and notifications are scheduled
+// round-robin between jobs so a single noisy job can't starve the others.
+type NotificationsDispatcher struct {
+	Latch       *async.Latch
+	Config      NotificationsConfig
+	Log         logger.Log
+	StatsClient stats.Collector
+
+	Email   *RetryQueue
+	Slack   *RetryQueue
+	Webhook *RetryQueue
+}
+
+// Start starts the dispatcher queues and blocks until the dispatcher is stopped.
+func (nd *NotificationsDispatcher) Start() error {
+	if !nd.Latch.CanStart() {
+		return async.ErrCannotStart
+	}
+	for _, queue := range nd.queues() {
+		go queue.Start()
+		<-queue.NotifyStarted()
+	}
+	nd.Latch.Started()
+
+	<-nd.Latch.NotifyStopping()
+	for _, queue := range nd.queues() {
+		if err := queue.Stop(); err != nil {
+			logger.MaybeError(nd.Log, err)
+		}
+	}
+	nd.Latch.Stopped()
+	return nil
+}
+
+// Stop stops the dispatcher.
+func (nd *NotificationsDispatcher) Stop() error {
+	if !nd.Latch.CanStop() {
+		return async.ErrCannotStop
+	}
+	nd.Latch.Stopping()
+	<-nd.Latch.NotifyStopped()
+	return nil
+}
+
+// NotifyStarted returns the started notification channel.
+func (nd *NotificationsDispatcher) NotifyStarted() <-chan struct{} {
+	return nd.Latch.NotifyStarted()
+}
+
+// NotifyStopped returns the stopped notification channel.
+func (nd *NotificationsDispatcher) NotifyStopped() <-chan struct{} {
+	return nd.Latch.NotifyStopped()
+}
+
+// IsStarted returns if the dispatcher is started and can accept notifications.
+func (nd *NotificationsDispatcher) IsStarted() bool {
+	return nd.Latch.IsStarted()
+}
+
+// Add enqueues a notification for a job on a given channel.
+func (nd *NotificationsDispatcher) Add(ctx context.Context, channel string, job *Job, item interface{}) error {
+	var queue *RetryQueue
+	switch channel {
+	case NotificationChannelEmail:
+		queue = nd.Email
+	case NotificationChannelSlack:
+		queue = nd.Slack
+	case NotificationChannelWebhook:
+		queue = nd.Webhook
+	default:
+		return ex.New("notifications dispatcher; invalid channel", ex.OptMessagef("channel: %s", channel))
+	}
+	if queue == nil || !nd.IsStarted() {
+		return ex.New("notifications dispatcher; not started")
+	}
+	queue.AddWithKey(ctx, job.Name(), Notification{
+		Channel: channel,
+		Job:     job,
+		Item:    item,
+	})
+	return nil
+}
+
+// Stats returns stats snapshots for each of the dispatcher queues.
+func (nd *NotificationsDispatcher) Stats() (output []RetryQueueStats) {
+	for _, queue := range nd.queues() {
+		if queue != nil {
+			output = append(output, queue.Stats())
+		}
+	}
+	return
+}
+
+func (nd *NotificationsDispatcher) queues() []*RetryQueue {
+	return []*RetryQueue{nd.Email, nd.Slack, nd.Webhook}
+}
+
+func (nd *NotificationsDispatcher) newQueue(channel string, parallelism int) *RetryQueue {
+	queue := NewRetryQueue(nd.send,
+		OptRetryQueueName(channel),
+		OptRetryQueueParallelism(parallelism),
+		OptRetryQueueStats(nd.StatsClient),
+		OptRetryQueueMaxAttemptsProvider(func(wi *RetryQueueWorkItem) int {
+			return notificationsConfig(wi).MaxRetriesOrDefault()
+		}),
+		OptRetryQueueRetryWaitProvider(func(wi *RetryQueueWorkItem) time.Duration {
+			return notificationsConfig(wi).RetryWaitOrDefault()
+		}),
+	)
+	queue.Log = nd.Log
+	return queue
+}
+
+// send is the retry queue action for the dispatcher queues.
+func (nd *NotificationsDispatcher) send(ctx context.Context, item interface{}) error {
+	notification, ok := item.(Notification)
+	if !ok || notification.Job == nil {
+		return ex.New("notifications dispatcher; invalid work item; not a `jobkit.Notification`")
+	}
+	ctx, cancel := context.WithTimeout(ctx, notification.Job.JobConfig.Notifications.TimeoutOrDefault())
+	defer cancel()
+
+	switch notification.Channel {
+	case NotificationChannelEmail:
+		return notification.Job.notifyEmail(ctx, notification.Item)
+	case NotificationChannelSlack:
+		return notification.Job.notifySlack(ctx, notification.Item)
+	case NotificationChannelWebhook:
+		return notification.Job.notifyWebhook(ctx, notification.Item)
+	default:
+		return ex.New("notifications dispatcher; invalid channel", ex.OptMessagef("channel: %s", notification.Channel))
+	}
+}
+
+// NotificationsQueuesStats are the stats of the shared dispatcher queues and of the jobs' own queues.
+type NotificationsQueuesStats struct {
+	Dispatcher []RetryQueueStats            `json:"dispatcher,omitempty"`
+	Jobs       map[string][]RetryQueueStats `json:"jobs"`
+}
+
+// notificationsConfig returns the job notifications config for a dispatcher work item.
+func notificationsConfig(wi *RetryQueueWorkItem) JobNotificationsConfig {
+	if notification, ok := wi.Item.(Notification); ok && notification.Job != nil {
+		return notification.Job.JobConfig.Notifications
+	}
+	return JobNotificationsConfig{}
+}
+
+// Notification is a work item for the notifications dispatcher.
+type Notification struct {
+	Channel string
+	Job     *Job
+	Item    interface{}
+}
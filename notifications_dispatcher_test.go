@@ -0,0 +1,72 @@
+package jobkit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/ref"
+	"github.com/blend/go-sdk/slack"
+	"github.com/blend/go-sdk/uuid"
+)
+
+func TestNotificationsDispatcher(t *testing.T) {
+	assert := assert.New(t)
+
+	dispatcher := NewNotificationsDispatcher(
+		OptNotificationsDispatcherConfig(NotificationsConfig{
+			SlackParallelism: ref.Int(2),
+		}),
+	)
+	// the queues exist, and can be read, before the dispatcher starts.
+	assert.Len(dispatcher.Stats(), 3)
+	assert.NotNil(dispatcher.Add(context.Background(), NotificationChannelSlack, MustNewJob(cron.NewJob(cron.OptJobName("test-job"))), "hello"))
+
+	go dispatcher.Start()
+	<-dispatcher.NotifyStarted()
+	defer dispatcher.Stop()
+
+	queueStats := dispatcher.Stats()
+	assert.Len(queueStats, 3)
+	assert.Equal(NotificationChannelEmail, queueStats[0].Name)
+	assert.Equal(DefaultNotificationsParallelism, queueStats[0].Parallelism)
+	assert.Equal(NotificationChannelSlack, queueStats[1].Name)
+	assert.Equal(2, queueStats[1].Parallelism)
+	assert.Equal(NotificationChannelWebhook, queueStats[2].Name)
+
+	slackMessages := make(chan slack.Message, 2)
+	job := MustNewJob(cron.NewJob(cron.OptJobName("test-job")),
+		OptJobNotificationsDispatcher(dispatcher),
+	)
+	job.SlackClient = slack.MockWebhookSender(slackMessages)
+
+	// the job should not start its own queues
+	assert.Nil(job.OnLoad(context.Background()))
+	assert.Nil(job.NotificationsQueueSlack)
+	assert.Empty(job.NotificationsQueueStats(), "the dispatcher queues should be reported by the dispatcher")
+
+	ctx := cron.WithJobInvocation(context.Background(), &cron.JobInvocation{
+		ID:      uuid.V4().String(),
+		JobName: job.Name(),
+		Err:     fmt.Errorf("only a test"),
+	})
+	job.OnError(ctx)
+
+	msg := <-slackMessages
+	assert.Contains(msg.Attachments[0].Text, "cron.errored")
+	assert.Equal(int64(1), dispatcher.Slack.Stats().Enqueued)
+}
+
+func TestNotificationsDispatcherAddInvalidChannel(t *testing.T) {
+	assert := assert.New(t)
+
+	dispatcher := NewNotificationsDispatcher()
+	go dispatcher.Start()
+	<-dispatcher.NotifyStarted()
+	defer dispatcher.Stop()
+
+	job := MustNewJob(cron.NewJob(cron.OptJobName("test-job")))
+	assert.NotNil(dispatcher.Add(context.Background(), "carrier-pigeon", job, "hello"))
+}
@@ -0,0 +1,130 @@
+package jobkit
+
+import (
+	"io"
+	"sync"
+	"time"
+
+	"github.com/blend/go-sdk/bufferutil"
+	"github.com/blend/go-sdk/ex"
+)
+
+// OutputStream is the stream that invocation output was written to.
+type OutputStream string
+
+// OutputStream values.
+const (
+	OutputStreamStdout OutputStream = "stdout"
+	OutputStreamStderr OutputStream = "stderr"
+)
+
+// OutputChunk is a chunk of invocation output tagged with the stream it was written to.
+type OutputChunk struct {
+	Timestamp time.Time    `json:"_ts"`
+	Stream    OutputStream `json:"stream"`
+	Data      string       `json:"data"`
+}
+
+// OutputStreams tags the chunks of an invocation output buffer, by index, with the stream they were written to.
+//
+// Output written with a stream writer is tagged with that stream, and output written
+// to the buffer directly is tagged as stdout.
+type OutputStreams struct {
+	sync.RWMutex
+	Streams []OutputStream
+
+	writeLock sync.Mutex
+	current   OutputStream
+}
+
+// Writer returns a writer that tags what it writes to a given output buffer with a stream.
+func (ost *OutputStreams) Writer(output io.Writer, stream OutputStream) io.Writer {
+	return outputStreamWriter{streams: ost, output: output, stream: stream}
+}
+
+// Stream returns the stream of the chunk at a given index.
+func (ost *OutputStreams) Stream(index int) OutputStream {
+	if ost == nil {
+		return OutputStreamStdout
+	}
+	ost.RLock()
+	defer ost.RUnlock()
+	if index >= 0 && index < len(ost.Streams) {
+		return ost.Streams[index]
+	}
+	return OutputStreamStdout
+}
+
+// Last returns the stream of the last chunk written.
+//
+// Buffer handlers are called synchronously as chunks are written, so this
+// is the stream of the chunk passed to the handler.
+func (ost *OutputStreams) Last() OutputStream {
+	if ost == nil {
+		return OutputStreamStdout
+	}
+	ost.RLock()
+	defer ost.RUnlock()
+	if len(ost.Streams) > 0 {
+		return ost.Streams[len(ost.Streams)-1]
+	}
+	return OutputStreamStdout
+}
+
+// Values returns a copy of the chunk streams.
+func (ost *OutputStreams) Values() []OutputStream {
+	if ost == nil {
+		return nil
+	}
+	ost.RLock()
+	defer ost.RUnlock()
+	return append([]OutputStream(nil), ost.Streams...)
+}
+
+// Handler returns a buffer handler that tags each chunk before calling a given handler.
+func (ost *OutputStreams) Handler(handler func(bufferutil.BufferChunk)) func(bufferutil.BufferChunk) {
+	return func(chunk bufferutil.BufferChunk) {
+		ost.observe()
+		if handler != nil {
+			handler(chunk)
+		}
+	}
+}
+
+// observe tags the chunk that was just written with the current stream.
+func (ost *OutputStreams) observe() {
+	ost.Lock()
+	defer ost.Unlock()
+	stream := ost.current
+	if stream == "" {
+		stream = OutputStreamStdout
+	}
+	ost.Streams = append(ost.Streams, stream)
+}
+
+func (ost *OutputStreams) setCurrent(stream OutputStream) {
+	ost.Lock()
+	defer ost.Unlock()
+	ost.current = stream
+}
+
+type outputStreamWriter struct {
+	streams *OutputStreams
+	output  io.Writer
+	stream  OutputStream
+}
+
+// Write implements io.Writer.
+func (osw outputStreamWriter) Write(contents []byte) (int, error) {
+	osw.streams.writeLock.Lock()
+	defer osw.streams.writeLock.Unlock()
+
+	osw.streams.setCurrent(osw.stream)
+	defer osw.streams.setCurrent("")
+	return osw.output.Write(contents)
+}
+
+// Output stream errors.
+const (
+	ErrInvalidOutputStream ex.Class = "invalid output stream; must be one of stdout or stderr"
+)
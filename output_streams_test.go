@@ -0,0 +1,72 @@
+package jobkit
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/bufferutil"
+)
+
+func TestOutputStreams(t *testing.T) {
+	assert := assert.New(t)
+
+	jio := NewJobInvocationOutput()
+
+	var handled []OutputStream
+	jio.OutputHandlers.Add("test", func(_ bufferutil.BufferChunk) {
+		handled = append(handled, jio.OutputStreams.Last())
+	})
+
+	fmt.Fprint(jio.StreamWriter(OutputStreamStdout), "out 0\n")
+	fmt.Fprint(jio.StreamWriter(OutputStreamStderr), "err 0\n")
+	fmt.Fprint(jio.Output, "direct\n")
+	fmt.Fprint(jio.StreamWriter(OutputStreamStderr), "err 1\n")
+
+	assert.Equal([]OutputStream{OutputStreamStdout, OutputStreamStderr, OutputStreamStdout, OutputStreamStderr}, jio.OutputStreams.Values())
+	assert.Equal(jio.OutputStreams.Values(), handled)
+
+	chunks := jio.OutputChunks(OutputStreamStderr)
+	assert.Len(chunks, 2)
+	assert.Equal("err 0\n", chunks[0].Data)
+	assert.Equal("err 1\n", chunks[1].Data)
+	assert.Len(jio.OutputChunks(), 4)
+	assert.Equal("rr 1\n", jio.OutputTail(OutputStreamStderr, 5))
+	assert.Equal("out 0\ndirect\n", jio.OutputTail(OutputStreamStdout, 1024))
+}
+
+func TestOutputStreamsConcurrentWriters(t *testing.T) {
+	assert := assert.New(t)
+
+	jio := NewJobInvocationOutput()
+
+	wg := sync.WaitGroup{}
+	for _, stream := range []OutputStream{OutputStreamStdout, OutputStreamStderr} {
+		wg.Add(1)
+		go func(stream OutputStream) {
+			defer wg.Done()
+			w := jio.StreamWriter(stream)
+			for x := 0; x < 64; x++ {
+				fmt.Fprint(w, string(stream))
+			}
+		}(stream)
+	}
+	wg.Wait()
+
+	for _, chunk := range jio.OutputChunks() {
+		assert.Equal(string(chunk.Stream), chunk.Data)
+	}
+}
+
+func TestOutputStreamsUnset(t *testing.T) {
+	assert := assert.New(t)
+
+	jio := JobInvocationOutput{
+		Output: bufferutil.NewBuffer([]byte("legacy output")),
+	}
+	chunks := jio.OutputChunks()
+	assert.Len(chunks, 1)
+	assert.Equal(OutputStreamStdout, chunks[0].Stream)
+	assert.Empty(jio.OutputChunks(OutputStreamStderr))
+}
@@ -0,0 +1,438 @@
+package jobkit
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/ex"
+	"github.com/blend/go-sdk/logger"
+	"github.com/blend/go-sdk/uuid"
+)
+
+// Pipeline parameters passed to each stage job.
+const (
+	PipelineParameterName  = "PIPELINE_NAME"
+	PipelineParameterRunID = "PIPELINE_RUN_ID"
+)
+
+// NewPipeline returns a new pipeline, returning an error if the config is invalid.
+func NewPipeline(cfg PipelineConfig, options ...PipelineOption) (*Pipeline, error) {
+	pipeline := &Pipeline{
+		Config:  cfg,
+		current: make(map[string]*PipelineRun),
+		cancels: make(map[string]context.CancelFunc),
+	}
+	for _, opt := range options {
+		opt(pipeline)
+	}
+	if err := pipeline.Validate(); err != nil {
+		return nil, err
+	}
+	return pipeline, nil
+}
+
+// PipelineOption is an option or mutator for a pipeline.
+type PipelineOption func(*Pipeline)
+
+// OptPipelineJobManager sets the job manager the pipeline runs its stage jobs with.
+func OptPipelineJobManager(jm *cron.JobManager) PipelineOption {
+	return func(p *Pipeline) {
+		p.JobManager = jm
+	}
+}
+
+// OptPipelineLog sets the pipeline logger.
+func OptPipelineLog(log logger.Log) PipelineOption {
+	return func(p *Pipeline) {
+		p.Log = log
+	}
+}
+
+// OptPipelineHistory sets the pipeline history provider.
+func OptPipelineHistory(provider PipelineHistoryProvider) PipelineOption {
+	return func(p *Pipeline) {
+		p.HistoryProvider = provider
+	}
+}
+
+// Pipeline is a named graph of jobs that run together as a pipeline run.
+//
+// Each stage runs its job once the stages it runs after have succeeded, so stages fan out
+// and join as the graph does. The outputs a stage's job sets are passed as parameters to
+// the stages after it, and each run is recorded with the ids of the job invocations it ran.
+type Pipeline struct {
+	sync.Mutex
+	Config          PipelineConfig
+	JobManager      *cron.JobManager
+	Log             logger.Log
+	HistoryProvider PipelineHistoryProvider
+
+	current map[string]*PipelineRun
+	cancels map[string]context.CancelFunc
+}
+
+// Name returns the pipeline name.
+func (p *Pipeline) Name() string {
+	return p.Config.Name
+}
+
+// Validate returns an error if the pipeline config is invalid, e.g. if its stages run in a cycle.
+func (p *Pipeline) Validate() error {
+	if p.Config.Name == "" {
+		return ex.New(ErrPipelineInvalid, ex.OptMessage("name unset"))
+	}
+	if len(p.Config.Stages) == 0 {
+		return ex.New(ErrPipelineInvalid, ex.OptMessagef("pipeline: %s; stages unset", p.Config.Name))
+	}
+	names := make(map[string]bool)
+	for _, stage := range p.Config.Stages {
+		if stage.Job == "" {
+			return ex.New(ErrPipelineInvalid, ex.OptMessagef("pipeline: %s; stage: %s; job unset", p.Config.Name, stage.Name))
+		}
+		if names[stage.NameOrDefault()] {
+			return ex.New(ErrPipelineInvalid, ex.OptMessagef("pipeline: %s; stage: %s; stage listed more than once", p.Config.Name, stage.NameOrDefault()))
+		}
+		names[stage.NameOrDefault()] = true
+	}
+	for _, stage := range p.Config.Stages {
+		for _, after := range stage.After {
+			if !names[after] {
+				return ex.New(ErrPipelineInvalid, ex.OptMessagef("pipeline: %s; stage: %s; after: %s; stage not found", p.Config.Name, stage.NameOrDefault(), after))
+			}
+		}
+	}
+	if cycle := p.Graph().Cycle(); cycle != nil {
+		return ex.New(ErrPipelineInvalid, ex.OptMessagef("pipeline: %s; stages run in a cycle: %s", p.Config.Name, strings.Join(cycle, " -> ")))
+	}
+	return nil
+}
+
+// Graph returns the graph of the pipeline stages, by stage name.
+func (p *Pipeline) Graph() JobGraph {
+	stages := make(map[string][]JobTriggerConfig)
+	for _, stage := range p.Config.Stages {
+		stages[stage.NameOrDefault()] = nil
+		if len(stage.After) > 0 {
+			stages[stage.NameOrDefault()] = []JobTriggerConfig{{After: stage.After}}
+		}
+	}
+	return NewJobGraph(stages)
+}
+
+// Run starts a pipeline run with a given set of parameters, returning the run before it completes.
+//
+// The run is not cancelled with the given context; use `Cancel` with the run id to cancel it.
+func (p *Pipeline) Run(ctx context.Context, parameters cron.JobParameters) (*PipelineRun, error) {
+	if p.JobManager == nil {
+		return nil, ex.New(ErrPipelineInvalid, ex.OptMessagef("pipeline: %s; job manager unset", p.Config.Name))
+	}
+	for _, stage := range p.Config.Stages {
+		if _, ok := p.JobManager.Jobs[stage.Job]; !ok {
+			return nil, ex.New(cron.ErrJobNotFound, ex.OptMessagef("pipeline: %s; stage: %s; job: %s", p.Config.Name, stage.NameOrDefault(), stage.Job))
+		}
+	}
+
+	run := &PipelineRun{
+		ID:           uuid.V4().String(),
+		PipelineName: p.Config.Name,
+		Started:      time.Now().UTC(),
+		Status:       PipelineStatusRunning,
+		Parameters:   cron.MergeJobParameterValues(DefaultParameterValues(p.Config.Parameters...), parameters),
+	}
+	for _, stage := range p.Config.Stages {
+		run.Stages = append(run.Stages, PipelineStageRun{
+			Name:    stage.NameOrDefault(),
+			JobName: stage.Job,
+			After:   stage.After,
+			Status:  PipelineStatusPending,
+		})
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	p.Lock()
+	p.current[run.ID] = run
+	p.cancels[run.ID] = cancel
+	clone := run.Clone()
+	p.Unlock()
+
+	logger.MaybeInfofContext(ctx, p.Log, "pipeline; starting %s run %s", p.Config.Name, run.ID)
+	go p.execute(runCtx, run)
+	return clone, nil
+}
+
+// Cancel cancels a running pipeline run, cancelling the stage jobs that are running.
+func (p *Pipeline) Cancel(runID string) error {
+	p.Lock()
+	defer p.Unlock()
+	cancel, ok := p.cancels[runID]
+	if !ok {
+		return ex.New(ErrPipelineRunNotFound, ex.OptMessagef("pipeline: %s; run: %s", p.Config.Name, runID))
+	}
+	cancel()
+	return nil
+}
+
+// Current returns the runs that are running.
+func (p *Pipeline) Current() (output []*PipelineRun) {
+	p.Lock()
+	defer p.Unlock()
+	for _, run := range p.current {
+		output = append(output, run.Clone())
+	}
+	return
+}
+
+// GetRun returns a run by id, whether it's running or in history.
+func (p *Pipeline) GetRun(ctx context.Context, runID string) (*PipelineRun, error) {
+	p.Lock()
+	if run, ok := p.current[runID]; ok {
+		clone := run.Clone()
+		p.Unlock()
+		return clone, nil
+	}
+	p.Unlock()
+	if p.HistoryProvider == nil {
+		return nil, ex.New(ErrPipelineRunNotFound, ex.OptMessagef("pipeline: %s; run: %s", p.Config.Name, runID))
+	}
+	return p.HistoryProvider.GetByID(ctx, p.Config.Name, runID)
+}
+
+// History returns the completed runs in history.
+func (p *Pipeline) History(ctx context.Context) ([]*PipelineRun, error) {
+	if p.HistoryProvider == nil {
+		return nil, nil
+	}
+	return p.HistoryProvider.Get(ctx, p.Config.Name)
+}
+
+// pipelineStageResult is the result of running a stage's job.
+type pipelineStageResult struct {
+	index   int
+	outputs map[string]string
+	skipped bool
+	err     error
+}
+
+// execute runs the stages of a run as the stages they run after succeed, then records the run.
+func (p *Pipeline) execute(ctx context.Context, run *PipelineRun) {
+	results := make(chan pipelineStageResult)
+	var running int
+	for {
+		p.Lock()
+		for _, index := range p.readyStages(ctx, run) {
+			parameters := p.stageParameters(run, index)
+			run.Stages[index].Status = PipelineStatusRunning
+			run.Stages[index].Started = time.Now().UTC()
+			run.Stages[index].Parameters = parameters
+			running++
+			go p.executeStage(ctx, run, index, parameters, results)
+		}
+		p.Unlock()
+
+		if running == 0 {
+			break
+		}
+		result := <-results
+		running--
+
+		p.Lock()
+		stage := &run.Stages[result.index]
+		stage.Complete = time.Now().UTC()
+		stage.Outputs = result.outputs
+		switch {
+		case result.err == nil && result.skipped:
+			// a skipped job had nothing to do, so the stages after it don't run.
+			stage.Status = PipelineStatusSkipped
+		case result.err == nil:
+			stage.Status = PipelineStatusSuccess
+		case cron.IsJobCancelled(result.err) || ctx.Err() != nil:
+			stage.Status = PipelineStatusCancelled
+		default:
+			stage.Status = PipelineStatusErrored
+		}
+		if result.err != nil {
+			stage.Err = result.err.Error()
+			if run.Err == "" {
+				run.Err = stage.Err
+			}
+		}
+		p.Unlock()
+	}
+	p.complete(ctx, run)
+}
+
+// readyStages marks the pending stages that can no longer run as skipped, and returns
+// the pending stages whose upstream stages have all succeeded.
+//
+// It must be called with the pipeline lock held.
+func (p *Pipeline) readyStages(ctx context.Context, run *PipelineRun) (output []int) {
+	statuses := make(map[string]PipelineStatus)
+	for _, stage := range run.Stages {
+		statuses[stage.Name] = stage.Status
+	}
+	for changed := true; changed; {
+		changed = false
+		for index, stage := range run.Stages {
+			if stage.Status != PipelineStatusPending {
+				continue
+			}
+			blocked := ctx.Err() != nil
+			for _, after := range stage.After {
+				if status := statuses[after]; status != PipelineStatusPending && status != PipelineStatusRunning && status != PipelineStatusSuccess {
+					blocked = true
+				}
+			}
+			if blocked {
+				run.Stages[index].Status = PipelineStatusSkipped
+				statuses[stage.Name] = PipelineStatusSkipped
+				changed = true
+			}
+		}
+	}
+	for index, stage := range run.Stages {
+		if stage.Status != PipelineStatusPending {
+			continue
+		}
+		ready := true
+		for _, after := range stage.After {
+			if statuses[after] != PipelineStatusSuccess {
+				ready = false
+			}
+		}
+		if ready {
+			output = append(output, index)
+		}
+	}
+	return
+}
+
+// stageParameters returns the parameters for a stage's job; the run parameters, then the outputs
+// of the stages it runs after, then the stage config parameters.
+//
+// It must be called with the pipeline lock held.
+func (p *Pipeline) stageParameters(run *PipelineRun, index int) cron.JobParameters {
+	parameters := cron.JobParameters(copyParameters(run.Parameters))
+	if parameters == nil {
+		parameters = make(cron.JobParameters)
+	}
+	for _, after := range run.Stages[index].After {
+		for _, stage := range run.Stages {
+			if stage.Name == after {
+				parameters = cron.MergeJobParameterValues(parameters, stage.Outputs)
+			}
+		}
+	}
+
+	outputs := make(map[string]string)
+	for _, stage := range run.Stages {
+		for key, value := range stage.Outputs {
+			outputs[stage.Name+"."+key] = value
+		}
+	}
+	expand := func(key string) string {
+		if value, ok := outputs[key]; ok {
+			return value
+		}
+		return parameters[key]
+	}
+	stageParameters := make(cron.JobParameters)
+	for key, value := range p.Config.Stages[index].Parameters {
+		stageParameters[key] = os.Expand(value, expand)
+	}
+	parameters = cron.MergeJobParameterValues(parameters, stageParameters)
+	parameters[PipelineParameterName] = run.PipelineName
+	parameters[PipelineParameterRunID] = run.ID
+	return parameters
+}
+
+// executeStage runs a stage's job and waits for it to complete, cancelling it if the run is cancelled.
+func (p *Pipeline) executeStage(ctx context.Context, run *PipelineRun, index int, parameters cron.JobParameters, results chan<- pipelineStageResult) {
+	result := pipelineStageResult{index: index}
+	defer func() { results <- result }()
+
+	jobName := p.Config.Stages[index].Job
+	ji, done, err := RunJob(cron.WithJobParameterValues(context.Background(), parameters), p.JobManager, jobName)
+	if err != nil {
+		result.err = err
+		return
+	}
+	p.Lock()
+	run.Stages[index].InvocationID = ji.ID
+	p.Unlock()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		logger.MaybeError(p.Log, CancelJobInvocation(p.JobManager, jobName, ji.ID))
+		<-done
+	}
+	result.err = ji.Err
+	if jio, ok := ji.State.(*JobInvocationOutput); ok && jio != nil {
+		result.skipped = jio.Skipped
+		if jio.Output != nil {
+			result.outputs = ParseOutputs(jio.Output.String())
+		}
+	}
+}
+
+// complete sets the status of a completed run and adds it to history.
+//
+// A run only succeeds if every stage ran and succeeded; if stages were skipped, the run is
+// `partial` if any stage succeeded, and `skipped` otherwise.
+func (p *Pipeline) complete(ctx context.Context, run *PipelineRun) {
+	p.Lock()
+	run.Complete = time.Now().UTC()
+	var succeeded, skipped, errored, cancelled bool
+	for _, stage := range run.Stages {
+		switch stage.Status {
+		case PipelineStatusSuccess:
+			succeeded = true
+		case PipelineStatusErrored:
+			errored = true
+		case PipelineStatusCancelled:
+			cancelled = true
+		default:
+			skipped = true
+		}
+	}
+	switch {
+	case ctx.Err() != nil, cancelled && !errored:
+		run.Status = PipelineStatusCancelled
+	case errored:
+		run.Status = PipelineStatusErrored
+	case skipped && succeeded:
+		run.Status = PipelineStatusPartial
+	case skipped:
+		run.Status = PipelineStatusSkipped
+	default:
+		run.Status = PipelineStatusSuccess
+	}
+	if cancel, ok := p.cancels[run.ID]; ok {
+		cancel()
+	}
+	delete(p.cancels, run.ID)
+	clone := run.Clone()
+	p.Unlock()
+
+	logger.MaybeInfof(p.Log, "pipeline; %s run %s %s (%v)", p.Config.Name, run.ID, clone.Status, clone.Elapsed().Round(time.Millisecond))
+	if p.HistoryProvider != nil {
+		historyCtx := context.Background()
+		logger.MaybeError(p.Log, p.HistoryProvider.Add(historyCtx, clone))
+		logger.MaybeError(p.Log, p.HistoryProvider.Cull(historyCtx, p.Config.Name, p.Config.HistoryMaxCountOrDefault(), p.Config.HistoryMaxAgeOrDefault()))
+	}
+
+	// remove the run from the current runs after it's in history so it can always be found.
+	p.Lock()
+	delete(p.current, run.ID)
+	p.Unlock()
+}
+
+// Pipeline errors.
+const (
+	ErrPipelineInvalid     ex.Class = "pipeline invalid"
+	ErrPipelineRunNotFound ex.Class = "pipeline run not found"
+)
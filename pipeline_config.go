@@ -0,0 +1,56 @@
+package jobkit
+
+import "time"
+
+// PipelineConfig is a config for a pipeline, a named graph of jobs that run together as a pipeline run.
+type PipelineConfig struct {
+	// Name is the name of the pipeline.
+	Name string `yaml:"name"`
+	// Description is a descriptive summary of the pipeline.
+	Description string `yaml:"description"`
+	// Parameters are the parameters of a pipeline run, which are passed to each stage.
+	Parameters []Parameter `yaml:"parameters"`
+	// Stages are the jobs the pipeline runs.
+	Stages []PipelineStageConfig `yaml:"stages"`
+	// HistoryMaxCount is the maximum number of pipeline runs to keep in history.
+	HistoryMaxCount *int `yaml:"historyMaxCount"`
+	// HistoryMaxAge is the maximum age of pipeline runs to keep in history.
+	HistoryMaxAge *time.Duration `yaml:"historyMaxAge"`
+}
+
+// HistoryMaxCountOrDefault returns a value or a default.
+func (pc PipelineConfig) HistoryMaxCountOrDefault() int {
+	if pc.HistoryMaxCount != nil {
+		return *pc.HistoryMaxCount
+	}
+	return DefaultHistoryMaxCount
+}
+
+// HistoryMaxAgeOrDefault returns a value or a default.
+func (pc PipelineConfig) HistoryMaxAgeOrDefault() time.Duration {
+	if pc.HistoryMaxAge != nil {
+		return *pc.HistoryMaxAge
+	}
+	return DefaultHistoryMaxAge
+}
+
+// PipelineStageConfig is a config for a stage of a pipeline.
+type PipelineStageConfig struct {
+	// Name is the name of the stage, defaulting to the job name.
+	Name string `yaml:"name"`
+	// Job is the name of the job the stage runs.
+	Job string `yaml:"job"`
+	// After are the names of the stages that must succeed before the stage runs.
+	After []string `yaml:"after"`
+	// Parameters are parameters for the stage's job; the pipeline run parameters and the outputs
+	// of the stages before it are expanded in the values, e.g. `${DATE}` or `${extract.FILE}`.
+	Parameters map[string]string `yaml:"parameters"`
+}
+
+// NameOrDefault returns a value or a default.
+func (psc PipelineStageConfig) NameOrDefault() string {
+	if psc.Name != "" {
+		return psc.Name
+	}
+	return psc.Job
+}
@@ -0,0 +1,92 @@
+package jobkit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/blend/go-sdk/ex"
+)
+
+// PipelineHistoryProvider is a provider for pipeline run history.
+type PipelineHistoryProvider interface {
+	Initialize(ctx context.Context) error
+	Add(context.Context, *PipelineRun) error
+	Get(context.Context, string) ([]*PipelineRun, error)
+	GetByID(context.Context, string, string) (*PipelineRun, error)
+	Cull(context.Context, string, int, time.Duration) error
+}
+
+var (
+	_ PipelineHistoryProvider = (*PipelineHistoryMemory)(nil)
+)
+
+// PipelineHistoryMemory is a memory backed pipeline history store.
+type PipelineHistoryMemory struct {
+	sync.RWMutex
+	History map[string][]*PipelineRun
+}
+
+// Initialize initializes the backing maps.
+func (phm *PipelineHistoryMemory) Initialize(ctx context.Context) error {
+	phm.Lock()
+	defer phm.Unlock()
+	if phm.History == nil {
+		phm.History = make(map[string][]*PipelineRun)
+	}
+	return nil
+}
+
+// Add adds a run.
+func (phm *PipelineHistoryMemory) Add(_ context.Context, run *PipelineRun) error {
+	phm.Lock()
+	defer phm.Unlock()
+	if phm.History == nil {
+		phm.History = make(map[string][]*PipelineRun)
+	}
+	phm.History[run.PipelineName] = append(phm.History[run.PipelineName], run)
+	return nil
+}
+
+// Get returns all history for a given pipeline.
+func (phm *PipelineHistoryMemory) Get(_ context.Context, pipelineName string) ([]*PipelineRun, error) {
+	phm.RLock()
+	defer phm.RUnlock()
+	if phm.History == nil {
+		return nil, nil
+	}
+	return phm.History[pipelineName], nil
+}
+
+// GetByID gets a pipeline run by ID.
+func (phm *PipelineHistoryMemory) GetByID(_ context.Context, pipelineName, runID string) (*PipelineRun, error) {
+	phm.RLock()
+	defer phm.RUnlock()
+	for _, run := range phm.History[pipelineName] {
+		if run.ID == runID {
+			return run, nil
+		}
+	}
+	return nil, ex.New(ErrPipelineRunNotFound, ex.OptMessagef("pipeline: %s; run: %s", pipelineName, runID))
+}
+
+// Cull culls history.
+func (phm *PipelineHistoryMemory) Cull(_ context.Context, pipelineName string, maxCount int, maxAge time.Duration) error {
+	phm.Lock()
+	defer phm.Unlock()
+
+	runs := phm.History[pipelineName]
+	now := time.Now().UTC()
+	var filtered []*PipelineRun
+	for index, run := range runs {
+		if maxCount > 0 && index < len(runs)-maxCount {
+			continue
+		}
+		if maxAge > 0 && now.Sub(run.Started) > maxAge {
+			continue
+		}
+		filtered = append(filtered, run)
+	}
+	phm.History[pipelineName] = filtered
+	return nil
+}
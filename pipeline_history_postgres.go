@@ -0,0 +1,150 @@
+package jobkit
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/db"
+	"github.com/blend/go-sdk/db/migration"
+	"github.com/blend/go-sdk/ex"
+	"github.com/blend/go-sdk/uuid"
+)
+
+var (
+	_ PipelineHistoryProvider = (*PipelineHistoryPostgres)(nil)
+)
+
+// PipelineHistoryPostgres implements a postgres pipeline history provider.
+type PipelineHistoryPostgres struct {
+	Conn *db.Connection
+	Tx   *sql.Tx
+}
+
+// Initialize creates the schema for the provider.
+func (h *PipelineHistoryPostgres) Initialize(ctx context.Context) error {
+	return migration.NewWithGroups(
+		migration.NewGroupWithAction(
+			migration.TableNotExists("pipeline_runs"),
+			migration.Statements(
+				`create table pipeline_runs (
+					id uuid not null primary key,
+					pipeline_name varchar(255) not null,
+					started timestamp not null,
+					complete timestamp,
+					status varchar(64) not null,
+					parameters json,
+					err text,
+					stages json
+				)`,
+			),
+			migration.OptGroupTx(h.Tx),
+		),
+	).Apply(ctx, h.Conn)
+}
+
+type pipelineRunRow struct {
+	ID           uuid.UUID          `db:"id,pk"`
+	PipelineName string             `db:"pipeline_name"`
+	Started      time.Time          `db:"started"`
+	Complete     time.Time          `db:"complete"`
+	Status       string             `db:"status"`
+	Parameters   map[string]string  `db:"parameters,json"`
+	Err          string             `db:"err"`
+	Stages       []PipelineStageRun `db:"stages,json"`
+}
+
+func (pr pipelineRunRow) TableName() string { return "pipeline_runs" }
+
+// PipelineRun returns the row as a pipeline run.
+func (pr pipelineRunRow) PipelineRun() *PipelineRun {
+	return &PipelineRun{
+		ID:           pr.ID.String(),
+		PipelineName: pr.PipelineName,
+		Started:      pr.Started,
+		Complete:     pr.Complete,
+		Status:       PipelineStatus(pr.Status),
+		Parameters:   cron.JobParameters(pr.Parameters),
+		Err:          pr.Err,
+		Stages:       pr.Stages,
+	}
+}
+
+// Add adds a run.
+func (h *PipelineHistoryPostgres) Add(ctx context.Context, run *PipelineRun) error {
+	return h.Conn.Invoke(
+		db.OptContext(ctx),
+		db.OptTx(h.Tx),
+	).Create(pipelineRunRow{
+		ID:           uuid.MustParse(run.ID),
+		PipelineName: run.PipelineName,
+		Started:      run.Started,
+		Complete:     run.Complete,
+		Status:       string(run.Status),
+		Parameters:   run.Parameters,
+		Err:          run.Err,
+		Stages:       run.Stages,
+	})
+}
+
+// Get gets all runs for a given pipeline.
+func (h *PipelineHistoryPostgres) Get(ctx context.Context, pipelineName string) (output []*PipelineRun, err error) {
+	var runs []pipelineRunRow
+	err = h.Conn.Invoke(
+		db.OptContext(ctx),
+		db.OptTx(h.Tx),
+	).Query(
+		fmt.Sprintf("select %s from %s where pipeline_name = $1 order by started asc", db.ColumnNamesCSV(pipelineRunRow{}), pipelineRunRow{}.TableName()),
+		pipelineName,
+	).OutMany(&runs)
+	if err != nil {
+		return
+	}
+	output = make([]*PipelineRun, len(runs))
+	for index := range runs {
+		output[index] = runs[index].PipelineRun()
+	}
+	return
+}
+
+// GetByID gets a specific run.
+func (h *PipelineHistoryPostgres) GetByID(ctx context.Context, pipelineName, runID string) (*PipelineRun, error) {
+	var output pipelineRunRow
+	found, err := h.Conn.Invoke(
+		db.OptContext(ctx),
+		db.OptTx(h.Tx),
+	).Query(
+		fmt.Sprintf("select %s from %s where pipeline_name = $1 and id = $2", db.ColumnNamesCSV(pipelineRunRow{}), pipelineRunRow{}.TableName()),
+		pipelineName,
+		runID,
+	).Out(&output)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ex.New(ErrPipelineRunNotFound, ex.OptMessagef("pipeline: %s; run: %s", pipelineName, runID))
+	}
+	return output.PipelineRun(), nil
+}
+
+// Cull culls history.
+func (h *PipelineHistoryPostgres) Cull(ctx context.Context, pipelineName string, maxCount int, maxAge time.Duration) (err error) {
+	opts := []db.InvocationOption{db.OptContext(ctx), db.OptTx(h.Tx)}
+	if maxCount > 0 {
+		_, err = h.Conn.Invoke(opts...).Exec(`
+		WITH ranked_runs AS ( SELECT id, ROW_NUMBER() OVER (ORDER BY started DESC) as run_rank FROM pipeline_runs WHERE pipeline_name = $1 )
+		DELETE FROM pipeline_runs pr USING ranked_runs rr
+		WHERE
+			pr.id = rr.id
+			AND rr.run_rank > $2`, pipelineName, maxCount)
+		if err != nil {
+			return
+		}
+	}
+	if maxAge > 0 {
+		_, err = h.Conn.Invoke(opts...).Exec(`DELETE FROM pipeline_runs WHERE pipeline_name = $1 AND started < $2`, pipelineName, time.Now().UTC().Add(-maxAge))
+	}
+	return
+}
@@ -0,0 +1,45 @@
+package jobkit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/ex"
+)
+
+func TestPipelineHistoryMemory(t *testing.T) {
+	assert := assert.New(t)
+
+	history := new(PipelineHistoryMemory)
+	assert.Nil(history.Initialize(context.Background()))
+
+	now := time.Now().UTC()
+	assert.Nil(history.Add(context.Background(), &PipelineRun{ID: "old", PipelineName: "etl", Started: now.Add(-2 * time.Hour)}))
+	assert.Nil(history.Add(context.Background(), &PipelineRun{ID: "first", PipelineName: "etl", Started: now.Add(-2 * time.Minute)}))
+	assert.Nil(history.Add(context.Background(), &PipelineRun{ID: "second", PipelineName: "etl", Started: now.Add(-time.Minute)}))
+	assert.Nil(history.Add(context.Background(), &PipelineRun{ID: "other", PipelineName: "report", Started: now}))
+
+	runs, err := history.Get(context.Background(), "etl")
+	assert.Nil(err)
+	assert.Len(runs, 3)
+
+	run, err := history.GetByID(context.Background(), "etl", "first")
+	assert.Nil(err)
+	assert.Equal("first", run.ID)
+
+	_, err = history.GetByID(context.Background(), "etl", "other")
+	assert.True(ex.Is(err, ErrPipelineRunNotFound))
+
+	assert.Nil(history.Cull(context.Background(), "etl", 0, time.Hour))
+	runs, err = history.Get(context.Background(), "etl")
+	assert.Nil(err)
+	assert.Len(runs, 2)
+
+	assert.Nil(history.Cull(context.Background(), "etl", 1, 0))
+	runs, err = history.Get(context.Background(), "etl")
+	assert.Nil(err)
+	assert.Len(runs, 1)
+	assert.Equal("second", runs[0].ID)
+}
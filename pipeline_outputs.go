@@ -0,0 +1,31 @@
+package jobkit
+
+import (
+	"strings"
+)
+
+// PipelineOutputPrefix is the prefix of the output lines a job writes to set pipeline outputs,
+// e.g. `::output FILE=/tmp/extract.csv`.
+//
+// The outputs of a stage are passed as parameters to the stages that run after it.
+const PipelineOutputPrefix = "::output "
+
+// ParseOutputs returns the pipeline outputs set in a job invocation output.
+//
+// If an output is set more than once, the last value is used.
+func ParseOutputs(output string) map[string]string {
+	outputs := make(map[string]string)
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if !strings.HasPrefix(line, PipelineOutputPrefix) {
+			continue
+		}
+		pair := strings.TrimPrefix(line, PipelineOutputPrefix)
+		index := strings.Index(pair, "=")
+		if index < 1 {
+			continue
+		}
+		outputs[strings.TrimSpace(pair[:index])] = pair[index+1:]
+	}
+	return outputs
+}
@@ -0,0 +1,20 @@
+package jobkit
+
+import (
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestParseOutputs(t *testing.T) {
+	assert := assert.New(t)
+
+	outputs := ParseOutputs("starting\n::output FILE=/tmp/extract.csv\r\n::output ROWS=10\n::output =empty\n::output QUERY=a=b\nnot ::output FOO=bar\n")
+	assert.Equal(map[string]string{
+		"FILE":  "/tmp/extract.csv",
+		"ROWS":  "10",
+		"QUERY": "a=b",
+	}, outputs)
+
+	assert.Empty(ParseOutputs(""))
+}
@@ -0,0 +1,111 @@
+package jobkit
+
+import (
+	"time"
+
+	"github.com/blend/go-sdk/cron"
+)
+
+// PipelineStatus is the status of a pipeline run or one of its stages.
+type PipelineStatus string
+
+// PipelineStatus values.
+const (
+	PipelineStatusPending   PipelineStatus = "pending"
+	PipelineStatusRunning   PipelineStatus = "running"
+	PipelineStatusSuccess   PipelineStatus = "success"
+	PipelineStatusErrored   PipelineStatus = "errored"
+	PipelineStatusCancelled PipelineStatus = "cancelled"
+	PipelineStatusSkipped   PipelineStatus = "skipped"
+	// PipelineStatusPartial is the status of a run in which some stages succeeded and the rest were skipped.
+	PipelineStatusPartial PipelineStatus = "partial"
+)
+
+// PipelineRun is a run of a pipeline.
+type PipelineRun struct {
+	// ID is the unique id of the run; it's passed to each stage job as the `PIPELINE_RUN_ID` parameter.
+	ID string `json:"id"`
+	// PipelineName is the name of the pipeline.
+	PipelineName string `json:"pipelineName"`
+	// Started is when the run started.
+	Started time.Time `json:"started"`
+	// Complete is when the run completed.
+	Complete time.Time `json:"complete,omitempty"`
+	// Status is the status of the run.
+	Status PipelineStatus `json:"status"`
+	// Parameters are the parameters the run was started with.
+	Parameters cron.JobParameters `json:"parameters,omitempty"`
+	// Err is the first error a stage returned, if any.
+	Err string `json:"err,omitempty"`
+	// Stages are the stages of the run, in the order of the pipeline config.
+	Stages []PipelineStageRun `json:"stages"`
+}
+
+// Elapsed returns the time the run took, or has taken so far if it's still running.
+func (pr PipelineRun) Elapsed() time.Duration {
+	if pr.Complete.IsZero() {
+		return time.Now().UTC().Sub(pr.Started)
+	}
+	return pr.Complete.Sub(pr.Started)
+}
+
+// IsComplete returns if the run has completed.
+func (pr PipelineRun) IsComplete() bool {
+	return !pr.Complete.IsZero()
+}
+
+// Clone returns a copy of the run.
+func (pr PipelineRun) Clone() *PipelineRun {
+	clone := pr
+	clone.Parameters = copyParameters(pr.Parameters)
+	clone.Stages = make([]PipelineStageRun, len(pr.Stages))
+	for index, stage := range pr.Stages {
+		stage.Parameters = copyParameters(stage.Parameters)
+		stage.Outputs = copyParameters(stage.Outputs)
+		clone.Stages[index] = stage
+	}
+	return &clone
+}
+
+// PipelineStageRun is a run of a pipeline stage.
+type PipelineStageRun struct {
+	// Name is the name of the stage.
+	Name string `json:"name"`
+	// JobName is the name of the job the stage runs.
+	JobName string `json:"jobName"`
+	// After are the names of the stages that run before the stage.
+	After []string `json:"after,omitempty"`
+	// Status is the status of the stage.
+	Status PipelineStatus `json:"status"`
+	// InvocationID is the id of the job invocation the stage ran, if it ran.
+	InvocationID string `json:"invocationID,omitempty"`
+	// Started is when the stage started.
+	Started time.Time `json:"started,omitempty"`
+	// Complete is when the stage completed.
+	Complete time.Time `json:"complete,omitempty"`
+	// Parameters are the parameters the stage's job ran with.
+	Parameters cron.JobParameters `json:"parameters,omitempty"`
+	// Outputs are the pipeline outputs the stage's job set.
+	Outputs map[string]string `json:"outputs,omitempty"`
+	// Err is the error the stage returned, if any.
+	Err string `json:"err,omitempty"`
+}
+
+// Elapsed returns the time the stage took to run.
+func (psr PipelineStageRun) Elapsed() time.Duration {
+	if psr.Started.IsZero() || psr.Complete.IsZero() {
+		return 0
+	}
+	return psr.Complete.Sub(psr.Started)
+}
+
+func copyParameters(values map[string]string) map[string]string {
+	if values == nil {
+		return nil
+	}
+	output := make(map[string]string, len(values))
+	for key, value := range values {
+		output[key] = value
+	}
+	return output
+}
@@ -0,0 +1,208 @@
+package jobkit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/ex"
+)
+
+func createTestPipelineConfig() PipelineConfig {
+	return PipelineConfig{
+		Name:       "etl",
+		Parameters: []Parameter{{Name: "DATE", Value: "today"}},
+		Stages: []PipelineStageConfig{
+			{Name: "extract", Job: "extract job"},
+			{Name: "transform", Job: "transform job", After: []string{"extract"}, Parameters: map[string]string{"INPUT": "${extract.FILE}", "LABEL": "${DATE}-${missing.FILE}"}},
+			{Name: "audit", Job: "audit job", After: []string{"extract"}},
+			{Name: "load", Job: "load job", After: []string{"transform", "audit"}},
+		},
+	}
+}
+
+func createTestPipelineRun(p *Pipeline) *PipelineRun {
+	run := &PipelineRun{
+		ID:           "run-id",
+		PipelineName: p.Name(),
+		Status:       PipelineStatusRunning,
+		Parameters:   cron.JobParameters{"DATE": "2020-01-01"},
+	}
+	for _, stage := range p.Config.Stages {
+		run.Stages = append(run.Stages, PipelineStageRun{
+			Name:    stage.NameOrDefault(),
+			JobName: stage.Job,
+			After:   stage.After,
+			Status:  PipelineStatusPending,
+		})
+	}
+	return run
+}
+
+func TestPipelineValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := NewPipeline(createTestPipelineConfig())
+	assert.Nil(err)
+
+	_, err = NewPipeline(PipelineConfig{Name: "etl"})
+	assert.True(ex.Is(err, ErrPipelineInvalid))
+
+	_, err = NewPipeline(PipelineConfig{Name: "etl", Stages: []PipelineStageConfig{{Job: "extract"}, {Job: "extract"}}})
+	assert.True(ex.Is(err, ErrPipelineInvalid), "a stage should not be listed more than once")
+
+	_, err = NewPipeline(PipelineConfig{Name: "etl", Stages: []PipelineStageConfig{{Job: "extract", After: []string{"load"}}}})
+	assert.True(ex.Is(err, ErrPipelineInvalid), "a stage should not run after a stage that isn't listed")
+
+	_, err = NewPipeline(PipelineConfig{Name: "etl", Stages: []PipelineStageConfig{
+		{Job: "extract", After: []string{"load"}},
+		{Job: "load", After: []string{"extract"}},
+	}})
+	assert.True(ex.Is(err, ErrPipelineInvalid), "stages should not run in a cycle")
+}
+
+func TestPipelineReadyStages(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := NewPipeline(createTestPipelineConfig())
+	assert.Nil(err)
+	run := createTestPipelineRun(p)
+
+	assert.Equal([]int{0}, p.readyStages(context.Background(), run))
+
+	run.Stages[0].Status = PipelineStatusSuccess
+	assert.Equal([]int{1, 2}, p.readyStages(context.Background(), run))
+
+	run.Stages[1].Status = PipelineStatusSuccess
+	run.Stages[2].Status = PipelineStatusRunning
+	assert.Empty(p.readyStages(context.Background(), run))
+
+	run.Stages[2].Status = PipelineStatusErrored
+	assert.Empty(p.readyStages(context.Background(), run))
+	assert.Equal(PipelineStatusSkipped, run.Stages[3].Status)
+}
+
+func TestPipelineReadyStagesCancelled(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := NewPipeline(createTestPipelineConfig())
+	assert.Nil(err)
+	run := createTestPipelineRun(p)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Empty(p.readyStages(ctx, run))
+	for _, stage := range run.Stages {
+		assert.Equal(PipelineStatusSkipped, stage.Status)
+	}
+}
+
+func TestPipelineStageParameters(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := NewPipeline(createTestPipelineConfig())
+	assert.Nil(err)
+	run := createTestPipelineRun(p)
+	run.Stages[0].Status = PipelineStatusSuccess
+	run.Stages[0].Outputs = map[string]string{"FILE": "/tmp/extract.csv", "ROWS": "10"}
+
+	parameters := p.stageParameters(run, 1)
+	assert.Equal("2020-01-01", parameters["DATE"])
+	assert.Equal("10", parameters["ROWS"], "the outputs of upstream stages should be passed")
+	assert.Equal("/tmp/extract.csv", parameters["INPUT"])
+	assert.Equal("2020-01-01-", parameters["LABEL"])
+	assert.Equal("etl", parameters[PipelineParameterName])
+	assert.Equal("run-id", parameters[PipelineParameterRunID])
+
+	parameters = p.stageParameters(run, 3)
+	assert.Empty(parameters["ROWS"], "only the outputs of the stages a stage runs after should be passed")
+}
+
+func TestPipelineRunJobNotFound(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := NewPipeline(createTestPipelineConfig(), OptPipelineJobManager(cron.New()))
+	assert.Nil(err)
+	_, err = p.Run(context.Background(), nil)
+	assert.True(ex.Is(err, cron.ErrJobNotFound))
+}
+
+func TestPipelineRunSkippedStage(t *testing.T) {
+	assert := assert.New(t)
+
+	ran := make(chan string, 2)
+	extract := MustNewJob(cron.NewJob(cron.OptJobName("extract job"), cron.OptJobAction(func(ctx context.Context) error {
+		ran <- "extract job"
+		GetJobInvocationOutput(ctx).Skipped = true
+		return nil
+	})))
+	transform := MustNewJob(cron.NewJob(cron.OptJobName("transform job"), cron.OptJobAction(func(_ context.Context) error {
+		ran <- "transform job"
+		return nil
+	})))
+	jm := cron.New()
+	assert.Nil(jm.LoadJobs(extract, transform))
+
+	p, err := NewPipeline(PipelineConfig{
+		Name: "etl",
+		Stages: []PipelineStageConfig{
+			{Name: "extract", Job: "extract job"},
+			{Name: "transform", Job: "transform job", After: []string{"extract"}},
+		},
+	}, OptPipelineJobManager(jm), OptPipelineHistory(new(PipelineHistoryMemory)))
+	assert.Nil(err)
+
+	run, err := p.Run(context.Background(), nil)
+	assert.Nil(err)
+	for len(p.Current()) > 0 {
+		time.Sleep(time.Millisecond)
+	}
+	run, err = p.GetRun(context.Background(), run.ID)
+	assert.Nil(err)
+	assert.Equal(PipelineStatusSkipped, run.Stages[0].Status)
+	assert.Equal(PipelineStatusSkipped, run.Stages[1].Status, "stages after a skipped stage don't run")
+	assert.Equal(PipelineStatusSkipped, run.Status, "a run in which no stages ran isn't successful")
+	assert.Equal("extract job", <-ran)
+	assert.Empty(ran)
+}
+
+func TestPipelineRunPartial(t *testing.T) {
+	assert := assert.New(t)
+
+	ran := make(chan string, 2)
+	extract := MustNewJob(cron.NewJob(cron.OptJobName("extract job"), cron.OptJobAction(func(_ context.Context) error {
+		ran <- "extract job"
+		return nil
+	})))
+	transform := MustNewJob(cron.NewJob(cron.OptJobName("transform job"), cron.OptJobAction(func(ctx context.Context) error {
+		ran <- "transform job"
+		GetJobInvocationOutput(ctx).Skipped = true
+		return nil
+	})))
+	jm := cron.New()
+	assert.Nil(jm.LoadJobs(extract, transform))
+
+	p, err := NewPipeline(PipelineConfig{
+		Name: "etl",
+		Stages: []PipelineStageConfig{
+			{Name: "extract", Job: "extract job"},
+			{Name: "transform", Job: "transform job", After: []string{"extract"}},
+		},
+	}, OptPipelineJobManager(jm), OptPipelineHistory(new(PipelineHistoryMemory)))
+	assert.Nil(err)
+
+	run, err := p.Run(context.Background(), nil)
+	assert.Nil(err)
+	for len(p.Current()) > 0 {
+		time.Sleep(time.Millisecond)
+	}
+	run, err = p.GetRun(context.Background(), run.ID)
+	assert.Nil(err)
+	assert.Equal(PipelineStatusSuccess, run.Stages[0].Status)
+	assert.Equal(PipelineStatusSkipped, run.Stages[1].Status)
+	assert.Equal(PipelineStatusPartial, run.Status)
+	assert.Equal("extract job", <-ran)
+	assert.Equal("transform job", <-ran)
+}
@@ -0,0 +1,61 @@
+package jobkit
+
+import (
+	"context"
+	"sort"
+)
+
+// NewPipelineViewModels returns the pipeline view models, sorted by name.
+func NewPipelineViewModels(ctx context.Context, pipelines []*Pipeline) ([]*PipelineViewModel, error) {
+	var output []*PipelineViewModel
+	for _, pipeline := range pipelines {
+		pvm, err := NewPipelineViewModel(ctx, pipeline)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, pvm)
+	}
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].Name < output[j].Name
+	})
+	return output, nil
+}
+
+// NewPipelineViewModel returns a pipeline view model from a pipeline.
+func NewPipelineViewModel(ctx context.Context, pipeline *Pipeline) (*PipelineViewModel, error) {
+	history, err := pipeline.History(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pvm := &PipelineViewModel{
+		Name:    pipeline.Name(),
+		Config:  pipeline.Config,
+		Graph:   pipeline.Graph(),
+		Current: pipeline.Current(),
+	}
+	pvm.History = append(pvm.History, history...)
+	sort.Slice(pvm.Current, func(i, j int) bool {
+		return pvm.Current[i].Started.After(pvm.Current[j].Started)
+	})
+	sort.Slice(pvm.History, func(i, j int) bool {
+		return pvm.History[i].Started.After(pvm.History[j].Started)
+	})
+	return pvm, nil
+}
+
+// PipelineViewModel is a viewmodel that represents a pipeline.
+type PipelineViewModel struct {
+	Name    string         `json:"name"`
+	Config  PipelineConfig `json:"config"`
+	Graph   JobGraph       `json:"graph"`
+	Current []*PipelineRun `json:"current"`
+	History []*PipelineRun `json:"history"`
+}
+
+// Last returns the most recent completed run.
+func (pvm PipelineViewModel) Last() *PipelineRun {
+	if len(pvm.History) > 0 {
+		return pvm.History[0]
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package jobkit
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blend/go-sdk/cron"
+)
+
+// PrometheusContentType is the content type of the prometheus text exposition format.
+const PrometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// DefaultPrometheusElapsedBuckets are the histogram buckets, in seconds, for job elapsed times.
+var DefaultPrometheusElapsedBuckets = []float64{0.1, 0.5, 1, 5, 10, 30, 60, 300, 600, 1800, 3600}
+
+// NewPrometheusJobs returns the state of a set of jobs exported as prometheus metrics, ordered by job name.
+//
+// It reads the job schedulers and the in-process job metrics, and doesn't read job history.
+func NewPrometheusJobs(jobs map[string]*cron.JobScheduler) []PrometheusJob {
+	var jobSchedulers []*cron.JobScheduler
+	for _, jobScheduler := range jobs {
+		jobSchedulers = append(jobSchedulers, jobScheduler)
+	}
+	sort.Sort(cron.JobSchedulersByJobNameAsc(jobSchedulers))
+
+	output := make([]PrometheusJob, 0, len(jobSchedulers))
+	for _, js := range jobSchedulers {
+		job := PrometheusJob{
+			Name:        js.Name(),
+			Disabled:    js.Disabled(),
+			NextRuntime: js.NextRuntime,
+		}
+		if typed, ok := js.Job.(*Job); ok {
+			job.Metrics = typed.Metrics()
+		} else if js.Current() != nil {
+			job.Metrics.Running = 1
+		}
+		output = append(output, job)
+	}
+	return output
+}
+
+// PrometheusJob is the state of a job exported as prometheus metrics.
+type PrometheusJob struct {
+	Name        string
+	Disabled    bool
+	NextRuntime time.Time
+	Metrics     JobMetrics
+}
+
+// WritePrometheusMetrics writes metrics for a set of jobs in the prometheus text exposition format.
+//
+// Run counts and elapsed times count the invocations completed since the process started.
+func WritePrometheusMetrics(w io.Writer, jobs []PrometheusJob) error {
+	pw := &prometheusWriter{Writer: w}
+
+	pw.Header("jobkit_job_runs_total", "counter", "The number of completed job invocations by status.")
+	for _, job := range jobs {
+		for _, status := range []cron.JobInvocationStatus{
+			cron.JobInvocationStatusSuccess,
+			cron.JobInvocationStatusErrored,
+			cron.JobInvocationStatusCancelled,
+			JobInvocationStatusSkipped,
+		} {
+			pw.Sample("jobkit_job_runs_total", float64(job.Metrics.Runs[status]), "job", job.Name, "status", string(status))
+		}
+	}
+
+	pw.Header("jobkit_job_elapsed_seconds", "histogram", "The elapsed time of completed job invocations.")
+	for _, job := range jobs {
+		for index, bucket := range DefaultPrometheusElapsedBuckets {
+			var count int64
+			if index < len(job.Metrics.ElapsedBuckets) {
+				count = job.Metrics.ElapsedBuckets[index]
+			}
+			pw.Sample("jobkit_job_elapsed_seconds_bucket", float64(count), "job", job.Name, "le", formatPrometheusValue(bucket))
+		}
+		pw.Sample("jobkit_job_elapsed_seconds_bucket", float64(job.Metrics.ElapsedCount), "job", job.Name, "le", "+Inf")
+		pw.Sample("jobkit_job_elapsed_seconds_sum", job.Metrics.ElapsedSum.Seconds(), "job", job.Name)
+		pw.Sample("jobkit_job_elapsed_seconds_count", float64(job.Metrics.ElapsedCount), "job", job.Name)
+	}
+
+	pw.Header("jobkit_job_running", "gauge", "The number of running invocations of the job.")
+	for _, job := range jobs {
+		pw.Sample("jobkit_job_running", float64(job.Metrics.Running), "job", job.Name)
+	}
+
+	pw.Header("jobkit_job_disabled", "gauge", "If the job is disabled.")
+	for _, job := range jobs {
+		pw.Sample("jobkit_job_disabled", boolValue(job.Disabled), "job", job.Name)
+	}
+
+	pw.Header("jobkit_job_next_runtime_timestamp_seconds", "gauge", "The next scheduled runtime of the job as a unix timestamp.")
+	for _, job := range jobs {
+		if !job.NextRuntime.IsZero() {
+			pw.Sample("jobkit_job_next_runtime_timestamp_seconds", unixSeconds(job.NextRuntime), "job", job.Name)
+		}
+	}
+
+	pw.Header("jobkit_job_last_success_timestamp_seconds", "gauge", "The completion time of the last successful invocation of the job as a unix timestamp.")
+	for _, job := range jobs {
+		if !job.Metrics.LastSuccess.IsZero() {
+			pw.Sample("jobkit_job_last_success_timestamp_seconds", unixSeconds(job.Metrics.LastSuccess), "job", job.Name)
+		}
+	}
+
+	return pw.Err
+}
+
+// prometheusWriter writes prometheus text format lines and
+// holds onto the first error it encounters.
+type prometheusWriter struct {
+	Writer io.Writer
+	Err    error
+}
+
+// Header writes the help and type lines for a metric.
+func (pw *prometheusWriter) Header(name, metricType, help string) {
+	pw.printf("# HELP %s %s\n", name, help)
+	pw.printf("# TYPE %s %s\n", name, metricType)
+}
+
+// Sample writes a sample line for a metric with a given set of label key value pairs.
+func (pw *prometheusWriter) Sample(name string, value float64, labelPairs ...string) {
+	var labels []string
+	for index := 0; index+1 < len(labelPairs); index += 2 {
+		labels = append(labels, fmt.Sprintf("%s=\"%s\"", labelPairs[index], escapePrometheusLabelValue(labelPairs[index+1])))
+	}
+	if len(labels) > 0 {
+		pw.printf("%s{%s} %s\n", name, strings.Join(labels, ","), formatPrometheusValue(value))
+		return
+	}
+	pw.printf("%s %s\n", name, formatPrometheusValue(value))
+}
+
+func (pw *prometheusWriter) printf(format string, args ...interface{}) {
+	if pw.Err != nil {
+		return
+	}
+	_, pw.Err = fmt.Fprintf(pw.Writer, format, args...)
+}
+
+var prometheusLabelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapePrometheusLabelValue(value string) string {
+	return prometheusLabelValueReplacer.Replace(value)
+}
+
+func formatPrometheusValue(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+func unixSeconds(t time.Time) float64 {
+	return float64(t.UnixNano()) / float64(time.Second)
+}
+
+func boolValue(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
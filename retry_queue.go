@@ -2,16 +2,30 @@ package jobkit
 
 import (
 	"context"
+	"fmt"
 	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/blend/go-sdk/async"
 	"github.com/blend/go-sdk/ex"
 	"github.com/blend/go-sdk/logger"
+	"github.com/blend/go-sdk/stats"
 	"github.com/blend/go-sdk/uuid"
 )
 
+// RetryQueue metric names and tags.
+const (
+	MetricRetryQueueEnqueued  = "retry_queue.enqueued"
+	MetricRetryQueueSucceeded = "retry_queue.succeeded"
+	MetricRetryQueueRetried   = "retry_queue.retried"
+	MetricRetryQueueDropped   = "retry_queue.dropped"
+	MetricRetryQueueLatency   = "retry_queue.latency"
+
+	TagRetryQueue = "retry_queue"
+)
+
 // NewRetryQueue returns a new retry queue.
 func NewRetryQueue(action async.WorkAction, options ...RetryQueueOption) *RetryQueue {
 	rq := &RetryQueue{
@@ -33,6 +47,37 @@ func NewRetryQueue(action async.WorkAction, options ...RetryQueueOption) *RetryQ
 // RetryQueueOption is an option or mutator for a retry queue.
 type RetryQueueOption func(*RetryQueue)
 
+// OptRetryQueueName sets the retry queue name.
+// The name is used to tag metrics and to identify the queue in stats.
+func OptRetryQueueName(name string) RetryQueueOption {
+	return func(rq *RetryQueue) {
+		rq.Name = name
+	}
+}
+
+// OptRetryQueueStats sets the retry queue stats collector and any additional tags
+// that should be sent with each metric.
+func OptRetryQueueStats(collector stats.Collector, tags ...string) RetryQueueOption {
+	return func(rq *RetryQueue) {
+		rq.StatsClient = collector
+		rq.StatsTags = tags
+	}
+}
+
+// OptRetryQueueParallelism sets the retry queue worker count.
+func OptRetryQueueParallelism(parallelism int) RetryQueueOption {
+	return func(rq *RetryQueue) {
+		rq.Parallelism = parallelism
+	}
+}
+
+// OptRetryQueueTimeout sets the timeout for each attempt of a work item.
+func OptRetryQueueTimeout(timeout time.Duration) RetryQueueOption {
+	return func(rq *RetryQueue) {
+		rq.Timeout = timeout
+	}
+}
+
 // OptRetryQueueMaxAttempts sets the retry queue max attempts.
 func OptRetryQueueMaxAttempts(maxAttempts int) RetryQueueOption {
 	return func(rq *RetryQueue) {
@@ -40,6 +85,14 @@ func OptRetryQueueMaxAttempts(maxAttempts int) RetryQueueOption {
 	}
 }
 
+// OptRetryQueueMaxAttemptsProvider sets the retry queue max attempts provider.
+// It lets the max attempts vary per work item.
+func OptRetryQueueMaxAttemptsProvider(provider func(*RetryQueueWorkItem) int) RetryQueueOption {
+	return func(rq *RetryQueue) {
+		rq.MaxAttemptsProvider = provider
+	}
+}
+
 // OptRetryQueueRetryWait sets the retry wait to a const value.
 func OptRetryQueueRetryWait(wait time.Duration) RetryQueueOption {
 	return func(rq *RetryQueue) {
@@ -68,8 +121,10 @@ func OptRetryQueueRetryWaitBackoff(base time.Duration) RetryQueueOption {
 
 // RetryQueue is a queue that retries on error.
 type RetryQueue struct {
+	Name              string
 	Parallelism       int
 	MaxAttempts       int
+	Timeout           time.Duration
 	RetryWaitProvider func(*RetryQueueWorkItem) time.Duration
 	Latch             *async.Latch
 	Log               logger.Log
@@ -77,13 +132,59 @@ type RetryQueue struct {
 	Action            async.WorkAction
 	WaitHandles       map[string]*async.Latch
 	WaitHandlesMux    sync.Mutex
+	StatsClient       stats.Collector
+	StatsTags         []string
+
+	MaxAttemptsProvider func(*RetryQueueWorkItem) int
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	enqueued  int64
+	succeeded int64
+	retried   int64
+	dropped   int64
+	inFlight  int64
+	pending   int64
 }
 
 // Add adds an item to the queue.
 func (rq *RetryQueue) Add(ctx context.Context, item interface{}) {
+	rq.AddWithKey(ctx, "", item)
+}
+
+// AddWithKey adds an item to the queue with a given key.
+// Items are processed round-robin between keys, so a key with many
+// pending items will not starve keys with only a few.
+func (rq *RetryQueue) AddWithKey(ctx context.Context, key string, item interface{}) {
+	atomic.AddInt64(&rq.enqueued, 1)
+	rq.increment(MetricRetryQueueEnqueued)
 	rq.Work <- &RetryQueueWorkItem{
-		Context: ctx,
-		Item:    item,
+		Context:  ctx,
+		Key:      key,
+		Item:     item,
+		Enqueued: time.Now().UTC(),
+	}
+}
+
+// Stats returns a snapshot of the retry queue stats.
+func (rq *RetryQueue) Stats() RetryQueueStats {
+	rq.WaitHandlesMux.Lock()
+	waiting := len(rq.WaitHandles)
+	rq.WaitHandlesMux.Unlock()
+
+	return RetryQueueStats{
+		Name:        rq.Name,
+		Running:     rq.Latch != nil && rq.Latch.IsStarted(),
+		Parallelism: rq.Parallelism,
+		MaxAttempts: rq.MaxAttempts,
+		Depth:       len(rq.Work) + int(atomic.LoadInt64(&rq.pending)),
+		InFlight:    atomic.LoadInt64(&rq.inFlight),
+		Waiting:     waiting,
+		Enqueued:    atomic.LoadInt64(&rq.enqueued),
+		Succeeded:   atomic.LoadInt64(&rq.succeeded),
+		Retried:     atomic.LoadInt64(&rq.retried),
+		Dropped:     atomic.LoadInt64(&rq.dropped),
 	}
 }
 
@@ -92,35 +193,60 @@ func (rq *RetryQueue) Start() error {
 	if !rq.Latch.CanStart() {
 		return async.ErrCannotStart
 	}
+	rq.ctx, rq.cancel = context.WithCancel(context.Background())
 	rq.Latch.Started()
 
-	var workItem *RetryQueueWorkItem
+	// workers share a single unbuffered channel so that
+	// work is only handed off when a worker is idle.
+	dispatch := make(chan *RetryQueueWorkItem)
 	workers := make([]*RetryQueueWorker, rq.Parallelism)
-	var current int
 	for x := 0; x < rq.Parallelism; x++ {
 		workers[x] = &RetryQueueWorker{
-			Latch:          async.NewLatch(),
-			Work:           make(chan *RetryQueueWorkItem),
-			Action:         rq.Action,
-			OnErrorHandler: rq.onError,
+			Latch:             async.NewLatch(),
+			Work:              dispatch,
+			Action:            rq.Action,
+			ContextProvider:   rq.attemptContext,
+			OnStartHandler:    rq.onStart,
+			OnCompleteHandler: rq.onComplete,
+			OnErrorHandler:    rq.onError,
 		}
 		go workers[x].Start()
 		<-workers[x].NotifyStarted()
 	}
 
+	pending := newRetryQueuePending()
+	var workItem, next *RetryQueueWorkItem
+	var nextDispatch chan *RetryQueueWorkItem
 	for {
+		// only attempt to dispatch if there is pending work,
+		// sends on a nil channel will never be selected.
+		if next = pending.Peek(); next != nil {
+			nextDispatch = dispatch
+		} else {
+			nextDispatch = nil
+		}
+
 		select {
 		case workItem = <-rq.Work:
-			workers[current].Work <- workItem
-			current = (current + 1) % rq.Parallelism
+			pending.Push(workItem)
+			atomic.AddInt64(&rq.pending, 1)
+		case nextDispatch <- next:
+			pending.Pop()
+			atomic.AddInt64(&rq.pending, -1)
 		case <-rq.Latch.NotifyStopping():
-			for _, waitHandle := range rq.WaitHandles {
-				waitHandle.Stopping()
-				<-waitHandle.NotifyStopped()
-			}
+			// cancel any in-flight attempts
+			rq.cancel()
+			rq.stopWaitHandles()
 			for x := 0; x < len(workers); x++ {
 				workers[x].Stop()
 			}
+			// work items that are pending or still buffered are never attempted.
+			rq.drop(pending.Len())
+			atomic.AddInt64(&rq.pending, -int64(pending.Len()))
+			rq.drop(len(rq.Work))
+			for len(rq.Work) > 0 {
+				<-rq.Work
+			}
 			rq.Latch.Stopped()
 			return nil
 		}
@@ -147,14 +273,42 @@ func (rq *RetryQueue) NotifyStopped() <-chan struct{} {
 	return rq.Latch.NotifyStopped()
 }
 
+func (rq *RetryQueue) onStart(_ *RetryQueueWorkItem) {
+	atomic.AddInt64(&rq.inFlight, 1)
+}
+
+func (rq *RetryQueue) onComplete(wi *RetryQueueWorkItem) {
+	atomic.AddInt64(&rq.inFlight, -1)
+	atomic.AddInt64(&rq.succeeded, 1)
+	rq.increment(MetricRetryQueueSucceeded)
+	if rq.StatsClient != nil && !wi.Enqueued.IsZero() {
+		logger.MaybeError(rq.Log, rq.StatsClient.TimeInMilliseconds(MetricRetryQueueLatency, time.Now().UTC().Sub(wi.Enqueued), rq.tags()...))
+	}
+}
+
 func (rq *RetryQueue) onError(wi *RetryQueueWorkItem, err error) {
+	atomic.AddInt64(&rq.inFlight, -1)
 	logger.MaybeError(rq.Log, err)
 
+	// the queue is stopping, don't requeue
+	if rq.ctx != nil && rq.ctx.Err() != nil {
+		atomic.AddInt64(&rq.dropped, 1)
+		rq.increment(MetricRetryQueueDropped)
+		logger.MaybeDebugf(rq.Log, "retry queue; work item error; queue is stopping, dropping work item")
+		return
+	}
+
 	// inrecement attempts
 	wi.Attempts = wi.Attempts + 1
-	if rq.MaxAttempts > 0 && wi.Attempts > rq.MaxAttempts {
+	maxAttempts := rq.maxAttempts(wi)
+	if maxAttempts > 0 && wi.Attempts > maxAttempts {
+		atomic.AddInt64(&rq.dropped, 1)
+		rq.increment(MetricRetryQueueDropped)
+		logger.MaybeDebugf(rq.Log, "retry queue; work item error; dropping after %d attempts", wi.Attempts)
 		return
 	}
+	atomic.AddInt64(&rq.retried, 1)
+	rq.increment(MetricRetryQueueRetried)
 
 	if rq.RetryWaitProvider != nil {
 		if wait := rq.RetryWaitProvider(wi); wait > 0 {
@@ -165,49 +319,206 @@ func (rq *RetryQueue) onError(wi *RetryQueueWorkItem, err error) {
 	}
 
 	// requeue immediately
-	if rq.MaxAttempts > 0 {
-		logger.MaybeDebugf(rq.Log, "retry queue; work item error; immediately requeueing (%d of %d attempts)", wi.Attempts, rq.MaxAttempts)
+	if maxAttempts > 0 {
+		logger.MaybeDebugf(rq.Log, "retry queue; work item error; immediately requeueing (%d of %d attempts)", wi.Attempts, maxAttempts)
 	} else {
 		logger.MaybeDebugf(rq.Log, "retry queue; work item error; immediately requeueing (%d attempts)", wi.Attempts)
 	}
 	rq.Work <- wi
 }
 
+// stopWaitHandles stops the work items waiting to be requeued, and waits for them to be dropped.
+//
+// The handles are collected with the lock held, but stopped without it, as the wait goroutines
+// take the lock to remove their handles as they exit.
+func (rq *RetryQueue) stopWaitHandles() {
+	rq.WaitHandlesMux.Lock()
+	waitHandles := make([]*async.Latch, 0, len(rq.WaitHandles))
+	stopped := make([]<-chan struct{}, 0, len(rq.WaitHandles))
+	for _, waitHandle := range rq.WaitHandles {
+		waitHandles = append(waitHandles, waitHandle)
+		stopped = append(stopped, waitHandle.NotifyStopped())
+	}
+	rq.WaitHandlesMux.Unlock()
+
+	for index, waitHandle := range waitHandles {
+		waitHandle.Stopping()
+		<-stopped[index]
+	}
+}
+
 func (rq *RetryQueue) wait(workItem *RetryQueueWorkItem, wait time.Duration) {
 	rq.WaitHandlesMux.Lock()
 	defer rq.WaitHandlesMux.Unlock()
 	waitHandleID := uuid.V4().String()
 	waitHandle := async.NewLatch()
 	go func() {
+		// the handle is marked stopped as it's removed, so handles collected by
+		// `stopWaitHandles` are always marked stopped once their goroutine exits.
 		defer func() {
 			rq.WaitHandlesMux.Lock()
 			defer rq.WaitHandlesMux.Unlock()
 			delete(rq.WaitHandles, waitHandleID)
+			waitHandle.Stopped()
 		}()
 		select {
 		case <-time.After(wait):
 			// requeue immediately
-			if rq.MaxAttempts > 0 {
-				logger.MaybeDebugf(rq.Log, "retry queue; work item error; delayed (%v) requeueing (%d of %d)", wait, workItem.Attempts, rq.MaxAttempts)
+			if maxAttempts := rq.maxAttempts(workItem); maxAttempts > 0 {
+				logger.MaybeDebugf(rq.Log, "retry queue; work item error; delayed (%v) requeueing (%d of %d)", wait, workItem.Attempts, maxAttempts)
 			} else {
 				logger.MaybeDebugf(rq.Log, "retry queue; work item error; delayed (%v) requeueing (%d)", wait, workItem.Attempts)
 			}
-			rq.Work <- workItem
+			select {
+			case rq.Work <- workItem:
+			case <-waitHandle.NotifyStopping():
+				rq.drop(1)
+			}
 			return
 		case <-waitHandle.NotifyStopping():
-			waitHandle.Stopped()
+			// the queue is stopping, so the work item won't be retried.
+			rq.drop(1)
 			return
 		}
 	}()
 	rq.WaitHandles[waitHandleID] = waitHandle
 }
 
+func (rq *RetryQueue) maxAttempts(wi *RetryQueueWorkItem) int {
+	if rq.MaxAttemptsProvider != nil {
+		return rq.MaxAttemptsProvider(wi)
+	}
+	return rq.MaxAttempts
+}
+
+// attemptContext returns the context for a single attempt at a work item.
+// It carries the values of the work item context, but is cancelled when the queue
+// stops or the attempt times out rather than when the work item context is cancelled,
+// as the work item context is typically a job invocation that has since completed.
+func (rq *RetryQueue) attemptContext(wi *RetryQueueWorkItem) (context.Context, context.CancelFunc) {
+	base := rq.ctx
+	if base == nil {
+		base = context.Background()
+	}
+	ctx := context.Context(retryQueueAttemptContext{Context: base, Values: wi.Context})
+	if rq.Timeout > 0 {
+		return context.WithTimeout(ctx, rq.Timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// drop counts work items discarded before they completed.
+func (rq *RetryQueue) drop(count int) {
+	for x := 0; x < count; x++ {
+		atomic.AddInt64(&rq.dropped, 1)
+		rq.increment(MetricRetryQueueDropped)
+	}
+}
+
+func (rq *RetryQueue) increment(metricName string) {
+	if rq.StatsClient != nil {
+		logger.MaybeError(rq.Log, rq.StatsClient.Increment(metricName, rq.tags()...))
+	}
+}
+
+func (rq *RetryQueue) tags() []string {
+	tags := []string{fmt.Sprintf("%s:%s", TagRetryQueue, rq.Name)}
+	return append(tags, rq.StatsTags...)
+}
+
+// RetryQueueStats is a snapshot of the state of a retry queue.
+type RetryQueueStats struct {
+	Name        string `json:"name"`
+	Running     bool   `json:"running"`
+	Parallelism int    `json:"parallelism"`
+	MaxAttempts int    `json:"maxAttempts"`
+	Depth       int    `json:"depth"`
+	InFlight    int64  `json:"inFlight"`
+	Waiting     int    `json:"waiting"`
+	Enqueued    int64  `json:"enqueued"`
+	Succeeded   int64  `json:"succeeded"`
+	Retried     int64  `json:"retried"`
+	Dropped     int64  `json:"dropped"`
+}
+
+func newRetryQueuePending() *retryQueuePending {
+	return &retryQueuePending{
+		Items: make(map[string][]*RetryQueueWorkItem),
+	}
+}
+
+// retryQueuePending holds work items waiting for a worker.
+// It is only ever accessed from the retry queue dispatch loop.
+type retryQueuePending struct {
+	// Keys is the round-robin order of keys with pending items.
+	Keys  []string
+	Items map[string][]*RetryQueueWorkItem
+}
+
+// Push adds a work item.
+func (rqp *retryQueuePending) Push(wi *RetryQueueWorkItem) {
+	if len(rqp.Items[wi.Key]) == 0 {
+		rqp.Keys = append(rqp.Keys, wi.Key)
+	}
+	rqp.Items[wi.Key] = append(rqp.Items[wi.Key], wi)
+}
+
+// Len returns the number of pending items.
+func (rqp *retryQueuePending) Len() (count int) {
+	for _, items := range rqp.Items {
+		count += len(items)
+	}
+	return
+}
+
+// Peek returns the next work item, or nil if there are no pending items.
+func (rqp *retryQueuePending) Peek() *RetryQueueWorkItem {
+	if len(rqp.Keys) == 0 {
+		return nil
+	}
+	return rqp.Items[rqp.Keys[0]][0]
+}
+
+// Pop removes the next work item and moves its key to the back of the line.
+func (rqp *retryQueuePending) Pop() {
+	if len(rqp.Keys) == 0 {
+		return
+	}
+	key := rqp.Keys[0]
+	rqp.Keys = rqp.Keys[1:]
+	rqp.Items[key] = rqp.Items[key][1:]
+	if len(rqp.Items[key]) > 0 {
+		rqp.Keys = append(rqp.Keys, key)
+	} else {
+		delete(rqp.Items, key)
+	}
+}
+
+// retryQueueAttemptContext is a context that pulls values from
+// one context and cancellation from another.
+type retryQueueAttemptContext struct {
+	context.Context
+	Values context.Context
+}
+
+// Value implements context.Context.
+func (rqac retryQueueAttemptContext) Value(key interface{}) interface{} {
+	if rqac.Values != nil {
+		if value := rqac.Values.Value(key); value != nil {
+			return value
+		}
+	}
+	return rqac.Context.Value(key)
+}
+
 // RetryQueueWorker is a background worker for a retry queue.
 type RetryQueueWorker struct {
 	Latch  *async.Latch
 	Action async.WorkAction
 	Work   chan *RetryQueueWorkItem
 
+	ContextProvider func(*RetryQueueWorkItem) (context.Context, context.CancelFunc)
+
 	OnStartHandler    func(*RetryQueueWorkItem)
 	OnCompleteHandler func(*RetryQueueWorkItem)
 	OnErrorHandler    func(*RetryQueueWorkItem, error)
@@ -256,8 +567,15 @@ func (rqw *RetryQueueWorker) Execute(workItem *RetryQueueWorkItem) {
 		}
 	}()
 
+	ctx := workItem.Context
+	if rqw.ContextProvider != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = rqw.ContextProvider(workItem)
+		defer cancel()
+	}
+
 	rqw.OnStart(workItem)
-	if err := rqw.Action(workItem.Context, workItem.Item); err != nil {
+	if err := rqw.Action(ctx, workItem.Item); err != nil {
 		rqw.OnError(workItem, err)
 		return
 	}
@@ -288,6 +606,8 @@ func (rqw *RetryQueueWorker) OnComplete(wi *RetryQueueWorkItem) {
 // RetryQueueWorkItem is a work item for the retry queue.
 type RetryQueueWorkItem struct {
 	Context  context.Context
+	Key      string
 	Item     interface{}
 	Attempts int
+	Enqueued time.Time
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/blend/go-sdk/assert"
 )
@@ -37,3 +38,223 @@ func TestRetryQueue(t *testing.T) {
 	<-done
 	assert.Zero(attempts)
 }
+
+func TestRetryQueueStats(t *testing.T) {
+	assert := assert.New(t)
+
+	collector := newMockStatsCollector()
+	var calls int
+	rtq := NewRetryQueue(func(_ context.Context, item interface{}) error {
+		calls++
+		if calls < 2 {
+			return fmt.Errorf("only a test")
+		}
+		return nil
+	},
+		OptRetryQueueName("test-queue"),
+		OptRetryQueueStats(collector, "job:test-job"),
+		OptRetryQueueMaxAttempts(5),
+		OptRetryQueueRetryWait(0),
+		OptRetryQueueParallelism(1),
+	)
+
+	go rtq.Start()
+	<-rtq.NotifyStarted()
+	defer rtq.Stop()
+
+	rtq.Add(context.Background(), "test payload")
+
+	metric := collector.waitForMetric(MetricRetryQueueSucceeded)
+	assert.Equal([]string{"retry_queue:test-queue", "job:test-job"}, metric.Tags)
+
+	queueStats := rtq.Stats()
+	assert.Equal("test-queue", queueStats.Name)
+	assert.True(queueStats.Running)
+	assert.Equal(int64(1), queueStats.Enqueued)
+	assert.Equal(int64(1), queueStats.Retried)
+	assert.Equal(int64(1), queueStats.Succeeded)
+	assert.Zero(queueStats.Dropped)
+	assert.Zero(queueStats.InFlight)
+}
+
+func TestRetryQueueStatsDropped(t *testing.T) {
+	assert := assert.New(t)
+
+	collector := newMockStatsCollector()
+	rtq := NewRetryQueue(func(_ context.Context, item interface{}) error {
+		return fmt.Errorf("only a test")
+	},
+		OptRetryQueueName("test-queue"),
+		OptRetryQueueStats(collector),
+		OptRetryQueueMaxAttempts(2),
+		OptRetryQueueRetryWait(0),
+		OptRetryQueueParallelism(1),
+	)
+
+	go rtq.Start()
+	<-rtq.NotifyStarted()
+	defer rtq.Stop()
+
+	rtq.Add(context.Background(), "test payload")
+
+	collector.waitForMetric(MetricRetryQueueDropped)
+
+	queueStats := rtq.Stats()
+	assert.Equal(int64(1), queueStats.Enqueued)
+	assert.Equal(int64(2), queueStats.Retried)
+	assert.Equal(int64(1), queueStats.Dropped)
+	assert.Zero(queueStats.Succeeded)
+}
+
+func TestRetryQueueStopWhileWaiting(t *testing.T) {
+	assert := assert.New(t)
+
+	rtq := NewRetryQueue(func(_ context.Context, item interface{}) error {
+		return fmt.Errorf("only a test")
+	},
+		OptRetryQueueRetryWait(time.Millisecond),
+		OptRetryQueueParallelism(4),
+	)
+
+	go rtq.Start()
+	<-rtq.NotifyStarted()
+
+	for x := 0; x < 32; x++ {
+		rtq.Add(context.Background(), x)
+	}
+	for rtq.Stats().Waiting == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// wait handles are requeueing and exiting as the queue stops.
+	stopped := make(chan error)
+	go func() { stopped <- rtq.Stop() }()
+	select {
+	case err := <-stopped:
+		assert.Nil(err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the retry queue should stop")
+	}
+	assert.Zero(rtq.Stats().Waiting)
+}
+
+type testRetryQueueContextKey struct{}
+
+func TestRetryQueueAttemptTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	errs := make(chan error, 2)
+	values := make(chan interface{}, 2)
+	done := make(chan struct{})
+	var calls int
+	rtq := NewRetryQueue(func(ctx context.Context, item interface{}) error {
+		calls++
+		values <- ctx.Value(testRetryQueueContextKey{})
+		if calls < 2 {
+			<-ctx.Done()
+			errs <- ctx.Err()
+			return ctx.Err()
+		}
+		errs <- ctx.Err()
+		close(done)
+		return nil
+	},
+		OptRetryQueueTimeout(10*time.Millisecond),
+		OptRetryQueueMaxAttempts(5),
+		OptRetryQueueRetryWait(0),
+		OptRetryQueueParallelism(1),
+	)
+
+	go rtq.Start()
+	<-rtq.NotifyStarted()
+	defer rtq.Stop()
+
+	// the work item context being cancelled should not cancel the attempts.
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), testRetryQueueContextKey{}, "test value"))
+	cancel()
+	rtq.Add(ctx, "test payload")
+
+	<-done
+	assert.Equal(context.DeadlineExceeded, <-errs)
+	assert.Nil(<-errs)
+	assert.Equal("test value", <-values)
+	assert.Equal("test value", <-values)
+}
+
+func TestRetryQueueStopCancelsInFlight(t *testing.T) {
+	assert := assert.New(t)
+
+	started := make(chan struct{})
+	errs := make(chan error, 1)
+	rtq := NewRetryQueue(func(ctx context.Context, item interface{}) error {
+		close(started)
+		<-ctx.Done()
+		errs <- ctx.Err()
+		return ctx.Err()
+	},
+		OptRetryQueueMaxAttempts(5),
+		OptRetryQueueRetryWait(0),
+		OptRetryQueueParallelism(1),
+	)
+
+	go rtq.Start()
+	<-rtq.NotifyStarted()
+
+	rtq.Add(context.Background(), "test payload")
+	<-started
+
+	assert.Nil(rtq.Stop())
+	assert.Equal(context.Canceled, <-errs)
+	assert.Equal(int64(1), rtq.Stats().Dropped)
+}
+
+func TestRetryQueueStopDropsPending(t *testing.T) {
+	assert := assert.New(t)
+
+	started := make(chan struct{}, 3)
+	rtq := NewRetryQueue(func(ctx context.Context, item interface{}) error {
+		started <- struct{}{}
+		<-ctx.Done()
+		return ctx.Err()
+	},
+		OptRetryQueueMaxAttempts(5),
+		OptRetryQueueRetryWait(time.Minute),
+		OptRetryQueueParallelism(1),
+	)
+
+	go rtq.Start()
+	<-rtq.NotifyStarted()
+
+	rtq.Add(context.Background(), "in flight")
+	<-started
+	rtq.Add(context.Background(), "pending")
+	rtq.Add(context.Background(), "pending")
+
+	assert.Nil(rtq.Stop())
+	queueStats := rtq.Stats()
+	assert.Equal(int64(3), queueStats.Dropped)
+	assert.Zero(queueStats.Depth)
+}
+
+func TestRetryQueuePendingRoundRobin(t *testing.T) {
+	assert := assert.New(t)
+
+	pending := newRetryQueuePending()
+	assert.Nil(pending.Peek())
+
+	pending.Push(&RetryQueueWorkItem{Key: "a", Item: "a0"})
+	pending.Push(&RetryQueueWorkItem{Key: "a", Item: "a1"})
+	pending.Push(&RetryQueueWorkItem{Key: "a", Item: "a2"})
+	pending.Push(&RetryQueueWorkItem{Key: "b", Item: "b0"})
+	pending.Push(&RetryQueueWorkItem{Key: "c", Item: "c0"})
+	pending.Push(&RetryQueueWorkItem{Key: "b", Item: "b1"})
+
+	var order []interface{}
+	for next := pending.Peek(); next != nil; next = pending.Peek() {
+		order = append(order, next.Item)
+		pending.Pop()
+	}
+	assert.Equal([]interface{}{"a0", "b0", "c0", "a1", "b1", "a2"}, order)
+	assert.Empty(pending.Items)
+	assert.Empty(pending.Keys)
+}
@@ -0,0 +1,73 @@
+package jobkit
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/ex"
+	"github.com/blend/go-sdk/logger"
+	"github.com/blend/go-sdk/sentry"
+)
+
+// NewSentryErrorListener returns a logger listener that sends error events to sentry,
+// except for job invocation failures, which jobs with a sentry client report themselves
+// with the invocation details.
+func NewSentryErrorListener(client sentry.Sender) logger.Listener {
+	return logger.NewErrorEventListener(func(ctx context.Context, ee logger.ErrorEvent) {
+		if isJobInvocationFailure(ctx, ee.Err) {
+			return
+		}
+		client.Notify(ctx, ee)
+	})
+}
+
+// isJobInvocationFailure returns if an error is the error of the job invocation in a context.
+func isJobInvocationFailure(ctx context.Context, err error) bool {
+	ji := cron.GetJobInvocation(ctx)
+	if ji == nil || ji.Err == nil || err == nil {
+		return false
+	}
+	return err == ji.Err || ex.Is(err, ji.Err)
+}
+
+// NewSentryContext returns a context decorated with the details of a job invocation for the sentry client.
+//
+// The invocation details are added as tags (job name, labels, invocation id, status) and extra data
+// (parameters, elapsed, and the tail of the output), and the event is fingerprinted by job name so that
+// each job gets its own sentry issue.
+func NewSentryContext(ctx context.Context, flag string, labels map[string]string, ji *JobInvocation) context.Context {
+	tags := logger.Labels{
+		"job.name":          ji.JobInvocation.JobName,
+		"job.invocation_id": ji.JobInvocation.ID,
+		"job.status":        string(ji.JobInvocation.Status),
+		"job.flag":          flag,
+	}
+	for key, value := range labels {
+		tags["job.label."+key] = value
+	}
+
+	extra := logger.Annotations{}
+	if len(ji.JobInvocation.Parameters) > 0 {
+		extra["parameters"] = ji.JobInvocation.Parameters
+	}
+	if ji.JobInvocation.Elapsed() > 0 {
+		extra["elapsed"] = ji.JobInvocation.Elapsed().String()
+	}
+	if ji.JobInvocationOutput.Output != nil {
+		if output := ji.JobInvocationOutput.Output.Bytes(); len(output) > 0 {
+			if len(output) > DefaultSentryOutputTailBytes {
+				output = output[len(output)-DefaultSentryOutputTailBytes:]
+				// don't start the tail partway through a multi-byte rune.
+				for len(output) > 0 && !utf8.RuneStart(output[0]) {
+					output = output[1:]
+				}
+			}
+			extra["output_tail"] = string(output)
+		}
+	}
+
+	ctx = logger.WithLabels(ctx, tags)
+	ctx = logger.WithAnnotations(ctx, extra)
+	return sentry.WithFingerprint(ctx, "jobkit", ji.JobInvocation.JobName)
+}
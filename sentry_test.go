@@ -0,0 +1,143 @@
+package jobkit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/bufferutil"
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/logger"
+	"github.com/blend/go-sdk/sentry"
+	"github.com/blend/go-sdk/uuid"
+)
+
+var (
+	_ sentry.Sender = (*mockSentrySender)(nil)
+)
+
+type mockSentryEvent struct {
+	Context context.Context
+	Event   logger.ErrorEvent
+}
+
+type mockSentrySender chan mockSentryEvent
+
+func (mss mockSentrySender) Notify(ctx context.Context, ee logger.ErrorEvent) {
+	mss <- mockSentryEvent{Context: ctx, Event: ee}
+}
+
+func TestNewSentryContext(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := time.Now().UTC()
+	id := uuid.V4().String()
+	ctx := NewSentryContext(context.Background(), cron.FlagErrored, map[string]string{"team": "test"}, &JobInvocation{
+		JobInvocation: cron.JobInvocation{
+			ID:         id,
+			JobName:    "test-job",
+			Status:     cron.JobInvocationStatusErrored,
+			Started:    ts,
+			Complete:   ts.Add(time.Second),
+			Parameters: cron.JobParameters{"FOO": "bar"},
+		},
+		JobInvocationOutput: JobInvocationOutput{
+			Output: bufferutil.NewBuffer([]byte(strings.Repeat("a", DefaultSentryOutputTailBytes) + "the end")),
+		},
+	})
+
+	labels := logger.GetLabels(ctx)
+	assert.Equal("test-job", labels["job.name"])
+	assert.Equal(id, labels["job.invocation_id"])
+	assert.Equal(string(cron.JobInvocationStatusErrored), labels["job.status"])
+	assert.Equal(cron.FlagErrored, labels["job.flag"])
+	assert.Equal("test", labels["job.label.team"])
+
+	annotations := logger.GetAnnotations(ctx)
+	assert.Equal("1s", annotations["elapsed"])
+	outputTail, ok := annotations["output_tail"].(string)
+	assert.True(ok)
+	assert.Len(outputTail, DefaultSentryOutputTailBytes)
+	assert.True(strings.HasSuffix(outputTail, "the end"))
+}
+
+func TestJobLifecycleHooksSentry(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx := cron.WithJobInvocation(context.Background(), &cron.JobInvocation{
+		ID:      uuid.V4().String(),
+		JobName: "test-job",
+		Status:  cron.JobInvocationStatusErrored,
+		Err:     fmt.Errorf("only a test"),
+	})
+
+	events := make(mockSentrySender, 3)
+	job := &Job{
+		SentryClient: events,
+	}
+
+	job.OnBegin(ctx)
+	job.OnSuccess(ctx)
+	assert.Empty(events)
+
+	job.OnError(ctx)
+	assert.Len(events, 1)
+	event := <-events
+	assert.Equal(logger.Error, event.Event.Flag)
+	assert.Equal("only a test", event.Event.Err.Error())
+	assert.Equal(cron.FlagErrored, logger.GetLabels(event.Context)["job.flag"])
+
+	job.OnBroken(ctx)
+	assert.Empty(events, "the failure was already reported")
+
+	job.OnFixed(ctx)
+	event = <-events
+	assert.Equal(logger.Info, event.Event.Flag)
+	assert.Contains(event.Event.Err.Error(), "test-job fixed")
+}
+
+func TestNewSentryContextOutputTailRuneBoundary(t *testing.T) {
+	assert := assert.New(t)
+
+	// each rune is three bytes, so the tail would start partway through one.
+	output := strings.Repeat("日", DefaultSentryOutputTailBytes)
+	ctx := NewSentryContext(context.Background(), cron.FlagErrored, nil, &JobInvocation{
+		JobInvocationOutput: JobInvocationOutput{
+			Output: bufferutil.NewBuffer([]byte(output)),
+		},
+	})
+
+	outputTail, ok := logger.GetAnnotations(ctx)["output_tail"].(string)
+	assert.True(ok)
+	assert.True(utf8.ValidString(outputTail))
+	assert.True(len(outputTail) <= DefaultSentryOutputTailBytes)
+	assert.True(strings.HasPrefix(outputTail, "日"))
+}
+
+func TestNewSentryErrorListener(t *testing.T) {
+	assert := assert.New(t)
+
+	events := make(mockSentrySender, 2)
+	listener := NewSentryErrorListener(events)
+
+	jobErr := fmt.Errorf("only a test")
+	ctx := cron.WithJobInvocation(context.Background(), &cron.JobInvocation{
+		JobName: "test-job",
+		Err:     jobErr,
+	})
+
+	listener(ctx, logger.NewErrorEvent(logger.Error, jobErr))
+	assert.Empty(events, "the job reports its own failures")
+
+	listener(ctx, logger.NewErrorEvent(logger.Error, fmt.Errorf("another error")))
+	assert.Len(events, 1)
+	event := <-events
+	assert.Equal("another error", event.Event.Err.Error())
+
+	listener(context.Background(), logger.NewErrorEvent(logger.Error, jobErr))
+	assert.Len(events, 1)
+}
@@ -1,16 +1,25 @@
 package jobkit
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
+	"os/exec"
+	"os/user"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/blend/go-sdk/cron"
 	"github.com/blend/go-sdk/ex"
 	"github.com/blend/go-sdk/logger"
 	"github.com/blend/go-sdk/sh"
+	"github.com/blend/go-sdk/template"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // NewShellAction returns a new shell action.
@@ -46,7 +55,7 @@ type ShellAction struct {
 }
 
 // Execute is the job body.
-func (se ShellAction) Execute(ctx context.Context) error {
+func (se ShellAction) Execute(ctx context.Context) (err error) {
 	ji := cron.GetJobInvocation(ctx)
 	jio := GetJobInvocationOutput(ctx)
 
@@ -54,59 +63,386 @@ func (se ShellAction) Execute(ctx context.Context) error {
 		return fmt.Errorf("shell action; invocation meta required with the output set")
 	}
 
+	uid, gid, err := se.credential()
+	if err != nil {
+		return err
+	}
+
 	localExec := make([]string, len(se.Config.Exec))
 	copy(localExec, se.Config.Exec)
 
-	if !se.Config.SkipExpandEnvOrDefault() {
+	if se.Config.Script != "" {
+		var scriptPath string
+		if scriptPath, err = se.writeScript(ji, uid, gid); err != nil {
+			return err
+		}
+		defer os.Remove(scriptPath)
+
+		for index, arg := range localExec {
+			localExec[index] = se.expand(ji, arg)
+		}
+		scriptArgs := append([]string{scriptPath}, localExec...)
+		localExec = append(append([]string{}, se.Config.InterpreterOrDefault()...), scriptArgs...)
+	} else {
 		for index, arg := range localExec {
 			if index == 0 {
 				continue
 			}
-			localExec[index] = os.Expand(arg, ExpandParameters(ji))
+			localExec[index] = se.expand(ji, arg)
 		}
 	}
+	if len(localExec) == 0 {
+		return ex.New("shell action; exec and script unset")
+	}
+
+	failOnOutput, err := se.failOnOutput()
+	if err != nil {
+		return err
+	}
+	terminationSignal, err := parseSignal(se.Config.TerminationSignalOrDefault())
+	if err != nil {
+		return err
+	}
 
-	cmd, err := sh.CmdContext(ctx, localExec[0], localExec[1:]...)
+	ctx, span := startSpan(ctx, SpanShellActionExecute,
+		attribute.String("job.name", ji.JobName),
+		attribute.String("job.invocation_id", ji.ID),
+		attribute.StringSlice("shell_action.exec", localExec),
+	)
+	defer func() { endSpan(span, err) }()
+
+	// the command is not bound to the context so that cancellation
+	// can terminate the whole process group gracefully.
+	cmd, err := sh.Cmd(localExec[0], localExec[1:]...)
 	if err != nil {
 		return err
 	}
-	cmd.Env = append(os.Environ(), ParameterValuesAsEnviron(ji.Parameters)...)
+	setProcessGroup(cmd)
+	if err = setCredential(cmd, uid, gid); err != nil {
+		return err
+	}
+	if cmd.Env, err = se.environ(ctx, ji); err != nil {
+		return err
+	}
+	if se.Config.WorkDir != "" {
+		cmd.Dir = se.expand(ji, se.Config.WorkDir)
+	} else if se.Config.Artifacts.Enabled() {
+		if cmd.Dir, err = se.tempWorkDir(uid, gid); err != nil {
+			return err
+		}
+		defer os.RemoveAll(cmd.Dir)
+	}
+	if cmd.Stdin, err = se.stdin(ji); err != nil {
+		return err
+	}
 	if !se.Config.DiscardOutputOrDefault() {
+		stdout, stderr := jio.StreamWriter(OutputStreamStdout), jio.StreamWriter(OutputStreamStderr)
 		if !se.Config.HideOutputOrDefault() {
 			if se.Log != nil {
-				logOutput := logOutputStream{ctx, se.Log}
-				cmd.Stdout = io.MultiWriter(jio.Output, logOutput)
-				cmd.Stderr = io.MultiWriter(jio.Output, logOutput)
+				stdoutLog := newLogOutputStream(ctx, se.Log, ShellActionLogFlag, se.Config.LogOutputJSONOrDefault())
+				stderrLog := newLogOutputStream(ctx, se.Log, ShellActionStderrLogFlag, se.Config.LogOutputJSONOrDefault())
+				defer stdoutLog.Flush()
+				defer stderrLog.Flush()
+				cmd.Stdout = io.MultiWriter(stdout, stdoutLog)
+				cmd.Stderr = io.MultiWriter(stderr, stderrLog)
 			} else {
-				cmd.Stdout = io.MultiWriter(jio.Output, os.Stdout)
-				cmd.Stderr = io.MultiWriter(jio.Output, os.Stderr)
+				cmd.Stdout = io.MultiWriter(stdout, os.Stdout)
+				cmd.Stderr = io.MultiWriter(stderr, os.Stderr)
 			}
 		} else {
-			cmd.Stdout = jio.Output
-			cmd.Stderr = jio.Output
+			cmd.Stdout = stdout
+			cmd.Stderr = stderr
 		}
 	} else if !se.Config.HideOutputOrDefault() {
 		cmd.Stdout = os.Stdout
 		cmd.Stderr = os.Stderr
 	}
-	return ex.New(cmd.Run())
+
+	// capture the output separately for the output failure rules
+	// so that they apply even if the output is discarded.
+	var output *bytes.Buffer
+	if len(failOnOutput) > 0 {
+		output = new(bytes.Buffer)
+		cmd.Stdout = teeWriter(cmd.Stdout, output)
+		cmd.Stderr = teeWriter(cmd.Stderr, output)
+	}
+
+	// processes that leave the process group can hold the output pipes open after the command
+	// exits or is killed, so waiting on the output is bounded by the grace period.
+	cmd.WaitDelay = se.Config.TerminationGracePeriodOrDefault()
+	if err = cmd.Start(); err != nil {
+		return ex.New(err)
+	}
+	termination, runErr := se.wait(ctx, cmd, terminationSignal)
+	if jio.ExitInfo = NewExitInfo(cmd.ProcessState); jio.ExitInfo != nil {
+		if termination != "" {
+			jio.ExitInfo.Termination = termination
+			jio.ExitInfo.TerminationSignal = se.Config.TerminationSignalOrDefault()
+		}
+		span.SetAttributes(
+			attribute.Int("shell_action.pid", jio.ExitInfo.PID),
+			attribute.Int("shell_action.exit_code", jio.ExitInfo.ExitCode),
+			attribute.String("shell_action.termination", termination),
+		)
+	}
+	artifactsErr := se.collectArtifacts(ctx, jio, cmd.Dir)
+	if termination != "" {
+		return ex.New(ErrShellActionTerminated, ex.OptMessagef("termination: %s", termination), ex.OptInner(ctx.Err()))
+	}
+	if err = se.result(jio, runErr, failOnOutput, output); err != nil {
+		return err
+	}
+	return artifactsErr
 }
 
-// Logger Constants
-const (
-	ShellActionLogFlag = "shell.action"
-)
+// wait waits for a started command to exit.
+//
+// If the context is cancelled first, the termination signal is sent to the command's process group,
+// and if the group hasn't exited after the grace period it is killed. The returned termination
+// is the path that was taken, and is empty if the command exited on its own.
+//
+// Once the command exits, the wait for its output is bounded by the command's `WaitDelay`.
+func (se ShellAction) wait(ctx context.Context, cmd *exec.Cmd, terminationSignal os.Signal) (termination string, err error) {
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+
+	select {
+	case err = <-done:
+		return
+	case <-ctx.Done():
+	}
+
+	logger.MaybeDebugfContext(ctx, se.Log, "shell action; sending %s to process group %d", terminationSignal, cmd.Process.Pid)
+	logger.MaybeError(se.Log, signalProcessGroup(cmd, terminationSignal))
+
+	gracePeriod := time.NewTimer(se.Config.TerminationGracePeriodOrDefault())
+	defer gracePeriod.Stop()
+	select {
+	case err = <-done:
+		termination = TerminationGraceful
+		return
+	case <-gracePeriod.C:
+	}
+
+	logger.MaybeDebugfContext(ctx, se.Log, "shell action; grace period elapsed, killing process group %d", cmd.Process.Pid)
+	logger.MaybeError(se.Log, signalProcessGroup(cmd, os.Kill))
+	err = <-done
+	termination = TerminationKilled
+	return
+}
+
+// result determines the result of the shell action from how the process exited and its output.
+func (se ShellAction) result(jio *JobInvocationOutput, runErr error, failOnOutput []*regexp.Regexp, output *bytes.Buffer) error {
+	if runErr != nil {
+		if _, ok := runErr.(*exec.ExitError); !ok {
+			return ex.New(runErr)
+		}
+	}
+	if jio.ExitInfo == nil || jio.ExitInfo.Signaled() {
+		return ex.New(runErr)
+	}
+	if se.Config.IsSkipExitCode(jio.ExitInfo.ExitCode) {
+		jio.Skipped = true
+		return nil
+	}
+	if !se.Config.IsSuccessExitCode(jio.ExitInfo.ExitCode) {
+		if runErr != nil {
+			return ex.New(runErr)
+		}
+		return ex.New(ErrShellActionExitCode, ex.OptMessagef("exit code: %d", jio.ExitInfo.ExitCode))
+	}
+	for _, pattern := range failOnOutput {
+		if pattern.Match(output.Bytes()) {
+			return ex.New(ErrShellActionOutputMatched, ex.OptMessagef("pattern: %s", pattern.String()))
+		}
+	}
+	return nil
+}
+
+// writeScript writes the script, with the invocation parameters expanded, to a temp file and returns its path.
+//
+// The file is only readable by its owner, so it's owned by the given user and group if they are set.
+func (se ShellAction) writeScript(ji *cron.JobInvocation, uid, gid *int) (string, error) {
+	script := se.Config.Script
+	if !se.Config.SkipExpandEnvOrDefault() {
+		script = expandScriptParameters(script, ji.Parameters)
+	}
+
+	f, err := ioutil.TempFile("", "jobkit-script-")
+	if err != nil {
+		return "", ex.New(err)
+	}
+	if _, err = io.WriteString(f, script); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return "", ex.New(err)
+	}
+	if uid != nil {
+		if err = f.Chown(*uid, *gid); err != nil {
+			f.Close()
+			os.Remove(f.Name())
+			return "", ex.New(err)
+		}
+	}
+	if err = f.Close(); err != nil {
+		os.Remove(f.Name())
+		return "", ex.New(err)
+	}
+	return f.Name(), nil
+}
+
+// expandScriptParameters replaces `$NAME` and `${NAME}` in a script with the value of the invocation
+// parameter `NAME`, and copies everything else as is, so other variables, positional parameters and
+// special parameters like `$$` and `$@` are left for the interpreter.
+func expandScriptParameters(script string, parameters cron.JobParameters) string {
+	output := new(strings.Builder)
+	for index := 0; index < len(script); {
+		if script[index] == '$' {
+			if name, width := scriptVariable(script[index+1:]); name != "" {
+				if value, ok := parameters[name]; ok {
+					output.WriteString(value)
+					index += 1 + width
+					continue
+				}
+			}
+		}
+		output.WriteByte(script[index])
+		index++
+	}
+	return output.String()
+}
+
+// scriptVariable returns the name of a `NAME` or `{NAME}` variable reference at the start of a string,
+// and the width of the reference. The name is empty if the string doesn't start with a reference.
+func scriptVariable(s string) (name string, width int) {
+	if strings.HasPrefix(s, "{") {
+		end := strings.IndexByte(s, '}')
+		if end < 0 || scriptVariableNameWidth(s[1:end]) != end-1 {
+			return "", 0
+		}
+		return s[1:end], end + 1
+	}
+	width = scriptVariableNameWidth(s)
+	return s[:width], width
+}
 
-type logOutputStream struct {
-	Context context.Context
-	Log     logger.Log
+// scriptVariableNameWidth returns the width of the variable name, i.e. a letter or underscore followed
+// by letters, digits or underscores, at the start of a string.
+func scriptVariableNameWidth(s string) (width int) {
+	for ; width < len(s); width++ {
+		c := s[width]
+		if c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || (width > 0 && '0' <= c && c <= '9') {
+			continue
+		}
+		break
+	}
+	return
 }
 
-func (los logOutputStream) Write(contents []byte) (count int, err error) {
-	if los.Log == nil {
+// stdin returns the standard input of the command, either from an invocation parameter or the stdin template.
+func (se ShellAction) stdin(ji *cron.JobInvocation) (io.Reader, error) {
+	if se.Config.StdinParameter != "" {
+		return strings.NewReader(ji.Parameters[se.Config.StdinParameter]), nil
+	}
+	if se.Config.Stdin == "" {
+		return nil, nil
+	}
+	stdin, err := template.New().WithBody(se.Config.Stdin).WithVars(TemplateVars(ji)).ProcessString()
+	if err != nil {
+		return nil, ex.New(err, ex.OptMessage("shell action; invalid stdin template"))
+	}
+	return strings.NewReader(stdin), nil
+}
+
+// credential returns the user and group to run the command as.
+//
+// If only the user is set, the group defaults to the user's primary group, and
+// it's an error if the user can't be looked up.
+func (se ShellAction) credential() (uid, gid *int, err error) {
+	uid, gid = se.Config.UID, se.Config.GID
+	if uid == nil || gid != nil {
 		return
 	}
-	los.Log.Trigger(los.Context, logger.NewMessageEvent(ShellActionLogFlag, strings.TrimSpace(string(contents))))
-	count = len(contents)
+	u, err := user.LookupId(strconv.Itoa(*uid))
+	if err != nil {
+		return nil, nil, ex.New(err, ex.OptMessagef("shell action; gid unset and the primary group of uid %d could not be looked up", *uid))
+	}
+	primaryGID, err := strconv.Atoi(u.Gid)
+	if err != nil {
+		return nil, nil, ex.New(err, ex.OptMessagef("shell action; gid unset and the primary group of uid %d is not numeric", *uid))
+	}
+	gid = &primaryGID
+	return
+}
+
+// tempWorkDir creates a temp working directory for an invocation, owned by the given user and group if they are set.
+func (se ShellAction) tempWorkDir(uid, gid *int) (string, error) {
+	dir, err := ioutil.TempDir("", "jobkit-workdir-")
+	if err != nil {
+		return "", ex.New(err)
+	}
+	if uid != nil {
+		if err = os.Chown(dir, *uid, *gid); err != nil {
+			os.RemoveAll(dir)
+			return "", ex.New(err)
+		}
+	}
+	return dir, nil
+}
+
+// collectArtifacts collects the configured artifacts from the working directory of the command.
+func (se ShellAction) collectArtifacts(ctx context.Context, jio *JobInvocationOutput, dir string) error {
+	if !se.Config.Artifacts.Enabled() {
+		return nil
+	}
+	if dir == "" {
+		dir = "."
+	}
+	artifacts, skipped, err := CollectArtifacts(dir, se.Config.Artifacts.Paths, se.Config.Artifacts.MaxBytesOrDefault())
+	if err != nil {
+		return err
+	}
+	for _, name := range skipped {
+		logger.MaybeWarningfContext(ctx, se.Log, "shell action; artifact %s skipped, artifacts would exceed %d bytes", name, se.Config.Artifacts.MaxBytesOrDefault())
+	}
+	jio.Artifacts = artifacts
+	return nil
+}
+
+// failOnOutput compiles the output failure rules.
+func (se ShellAction) failOnOutput() (output []*regexp.Regexp, err error) {
+	var pattern *regexp.Regexp
+	for _, expr := range se.Config.FailOnOutput {
+		pattern, err = regexp.Compile(expr)
+		if err != nil {
+			err = ex.New(err, ex.OptMessagef("shell action; invalid fail on output expression: %s", expr))
+			return
+		}
+		output = append(output, pattern)
+	}
 	return
 }
+
+// teeWriter returns a writer that writes to both writers, where the first may be unset.
+func teeWriter(w, other io.Writer) io.Writer {
+	if w == nil {
+		return other
+	}
+	return io.MultiWriter(w, other)
+}
+
+// Logger Constants
+const (
+	ShellActionLogFlag       = "shell.action"
+	ShellActionStderrLogFlag = "shell.action.stderr"
+)
+
+// Shell action errors.
+const (
+	ErrShellActionExitCode      ex.Class = "shell action; exit code is not a success exit code"
+	ErrShellActionOutputMatched ex.Class = "shell action; output matched a failure rule"
+	ErrShellActionTerminated    ex.Class = "shell action; process group terminated on cancellation"
+	ErrShellActionInvalidSignal ex.Class = "shell action; invalid termination signal"
+)
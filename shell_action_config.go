@@ -1,15 +1,56 @@
 package jobkit
 
+import (
+	"path"
+	"time"
+)
+
 // ShellActionConfig is a config for shell actions.
 type ShellActionConfig struct {
 	// Exec is a job body that shells out for its action.
 	Exec []string `yaml:"exec"`
+	// Script is an inline script run with the interpreter, in which case exec is passed as the script arguments.
+	// Invocation parameters referenced as `$NAME` or `${NAME}` are expanded in the script; other variables are left for the interpreter.
+	Script string `yaml:"script"`
+	// Interpreter is the command the script is run with, defaulting to `bash -euo pipefail`.
+	Interpreter []string `yaml:"interpreter"`
 	// SkipExpandEnv skips expanding environment variables in the exec segments.
 	SkipExpandEnv *bool `yaml:"skipExpandEnv"`
 	// DiscardOutput skips setting up output buffers for job invocations.
 	DiscardOutput *bool `yaml:"discardOutput"`
 	// HideOutput skips writing job output to standard output and standard error.
 	HideOutput *bool `yaml:"hideOutput"`
+	// LogOutputJSON parses lines of output that are JSON objects into structured log fields when output is logged.
+	LogOutputJSON *bool `yaml:"logOutputJSON"`
+	// SuccessExitCodes are the exit codes that mark the invocation as successful, defaulting to just `0`.
+	SuccessExitCodes []int `yaml:"successExitCodes"`
+	// SkipExitCode is an exit code that marks the invocation as skipped rather than errored.
+	SkipExitCode *int `yaml:"skipExitCode"`
+	// FailOnOutput are regular expressions that fail the invocation if they match the output.
+	FailOnOutput []string `yaml:"failOnOutput"`
+	// WorkDir is the working directory of the command, defaulting to the working directory of the process.
+	WorkDir string `yaml:"workDir"`
+	// Env are environment variables to set for the command; values are expanded with the invocation parameters.
+	Env map[string]string `yaml:"env"`
+	// EnvFile is the path to a file of `KEY=VALUE` lines to add to the command environment.
+	EnvFile string `yaml:"envFile"`
+	// InheritEnv controls which variables of the process environment the command inherits.
+	InheritEnv ShellActionInheritEnv `yaml:"inheritEnv"`
+	// UID is the user id to run the command as.
+	UID *int `yaml:"uid"`
+	// GID is the group id to run the command as, defaulting to the primary group of the user if only the uid is set.
+	GID *int `yaml:"gid"`
+	// TerminationSignal is the signal sent to the process group when the invocation is cancelled, defaulting to `SIGTERM`.
+	TerminationSignal string `yaml:"terminationSignal"`
+	// TerminationGracePeriod is how long to wait after the termination signal before killing the process group.
+	// It also bounds how long output is read after the process exits, e.g. from a background process that left the group.
+	TerminationGracePeriod *time.Duration `yaml:"terminationGracePeriod"`
+	// Stdin is a template written to the standard input of the command, with the invocation parameters as vars, e.g. `{{ .Var "name" }}`.
+	Stdin string `yaml:"stdin"`
+	// StdinParameter is the name of an invocation parameter written to the standard input of the command; it takes precedence over stdin.
+	StdinParameter string `yaml:"stdinParameter"`
+	// Artifacts are files collected from the working directory of the command after it exits.
+	Artifacts ShellActionArtifacts `yaml:"artifacts"`
 }
 
 // SkipExpandEnvOrDefault returns a value or a default.
@@ -35,3 +76,120 @@ func (se ShellActionConfig) HideOutputOrDefault() bool {
 	}
 	return DefaultHideOutput
 }
+
+// LogOutputJSONOrDefault returns a value or a default.
+func (se ShellActionConfig) LogOutputJSONOrDefault() bool {
+	if se.LogOutputJSON != nil {
+		return *se.LogOutputJSON
+	}
+	return DefaultLogOutputJSON
+}
+
+// InterpreterOrDefault returns a value or a default.
+func (se ShellActionConfig) InterpreterOrDefault() []string {
+	if len(se.Interpreter) > 0 {
+		return se.Interpreter
+	}
+	return DefaultInterpreter
+}
+
+// TerminationSignalOrDefault returns a value or a default.
+func (se ShellActionConfig) TerminationSignalOrDefault() string {
+	if se.TerminationSignal != "" {
+		return se.TerminationSignal
+	}
+	return DefaultTerminationSignal
+}
+
+// TerminationGracePeriodOrDefault returns a value or a default.
+func (se ShellActionConfig) TerminationGracePeriodOrDefault() time.Duration {
+	if se.TerminationGracePeriod != nil {
+		return *se.TerminationGracePeriod
+	}
+	return DefaultTerminationGracePeriod
+}
+
+// SuccessExitCodesOrDefault returns a value or a default.
+func (se ShellActionConfig) SuccessExitCodesOrDefault() []int {
+	if len(se.SuccessExitCodes) > 0 {
+		return se.SuccessExitCodes
+	}
+	return DefaultSuccessExitCodes
+}
+
+// IsSuccessExitCode returns if an exit code marks the invocation as successful.
+func (se ShellActionConfig) IsSuccessExitCode(exitCode int) bool {
+	for _, successExitCode := range se.SuccessExitCodesOrDefault() {
+		if exitCode == successExitCode {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSkipExitCode returns if an exit code marks the invocation as skipped.
+func (se ShellActionConfig) IsSkipExitCode(exitCode int) bool {
+	return se.SkipExitCode != nil && *se.SkipExitCode == exitCode
+}
+
+// ShellActionInheritEnv controls which variables of the process environment a shell action inherits.
+// Entries in the allow and deny lists can be glob patterns, e.g. `AWS_*`.
+type ShellActionInheritEnv struct {
+	// Disabled inherits none of the process environment.
+	Disabled *bool `yaml:"disabled"`
+	// Allow, if set, are the only variables that are inherited.
+	Allow []string `yaml:"allow"`
+	// Deny are variables that are not inherited.
+	Deny []string `yaml:"deny"`
+}
+
+// DisabledOrDefault returns a value or a default.
+func (ie ShellActionInheritEnv) DisabledOrDefault() bool {
+	if ie.Disabled != nil {
+		return *ie.Disabled
+	}
+	return false
+}
+
+// Inherits returns if a variable of the process environment should be inherited.
+func (ie ShellActionInheritEnv) Inherits(key string) bool {
+	if ie.DisabledOrDefault() {
+		return false
+	}
+	if len(ie.Allow) > 0 && !matchesAny(ie.Allow, key) {
+		return false
+	}
+	return !matchesAny(ie.Deny, key)
+}
+
+func matchesAny(patterns []string, key string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, key); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// ShellActionArtifacts are files collected from the working directory of a shell action after it exits.
+// If a work dir isn't set, the command runs in a temp directory for each invocation that is removed once
+// the artifacts are collected.
+type ShellActionArtifacts struct {
+	// Paths are glob patterns relative to the working directory, e.g. `reports/*.csv`.
+	Paths []string `yaml:"paths"`
+	// MaxBytes is the maximum total size of the artifacts collected for an invocation.
+	MaxBytes *int `yaml:"maxBytes"`
+}
+
+// Enabled returns if any artifacts are configured.
+func (sa ShellActionArtifacts) Enabled() bool {
+	return len(sa.Paths) > 0
+}
+
+// MaxBytesOrDefault returns a value or a default.
+func (sa ShellActionArtifacts) MaxBytesOrDefault() int {
+	if sa.MaxBytes != nil {
+		return *sa.MaxBytes
+	}
+	return DefaultArtifactsMaxBytes
+}
@@ -0,0 +1,90 @@
+package jobkit
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/ex"
+)
+
+// ErrShellActionInvalidEnvFile is returned if an env file has a malformed line.
+const ErrShellActionInvalidEnvFile ex.Class = "shell action; invalid env file"
+
+// environ returns the environment for a shell action command.
+//
+// Later entries take precedence, so the order is the inherited process environment,
+// then the env file, the invocation parameters, the configured env, and finally the trace context.
+func (se ShellAction) environ(ctx context.Context, ji *cron.JobInvocation) ([]string, error) {
+	var environ []string
+	for _, entry := range os.Environ() {
+		if key := strings.SplitN(entry, "=", 2)[0]; se.Config.InheritEnv.Inherits(key) {
+			environ = append(environ, entry)
+		}
+	}
+	if se.Config.EnvFile != "" {
+		fileEnviron, err := ReadEnvFile(se.expand(ji, se.Config.EnvFile))
+		if err != nil {
+			return nil, err
+		}
+		environ = append(environ, fileEnviron...)
+	}
+	environ = append(environ, ParameterValuesAsEnviron(ji.Parameters)...)
+
+	keys := make([]string, 0, len(se.Config.Env))
+	for key := range se.Config.Env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		environ = append(environ, key+"="+se.expand(ji, se.Config.Env[key]))
+	}
+	return append(environ, TraceContextEnviron(ctx)...), nil
+}
+
+// expand expands invocation parameters in a value unless expansion is disabled.
+func (se ShellAction) expand(ji *cron.JobInvocation, value string) string {
+	if se.Config.SkipExpandEnvOrDefault() {
+		return value
+	}
+	return os.Expand(value, ExpandParameters(ji))
+}
+
+// ReadEnvFile reads environment variables from a file of `KEY=VALUE` lines.
+//
+// Blank lines and lines starting with `#` are ignored, lines may start with `export `,
+// and values may be wrapped in single or double quotes.
+func ReadEnvFile(path string) (environ []string, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, ex.New(err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	var lineNumber int
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, ex.New(ErrShellActionInvalidEnvFile, ex.OptMessagef("path: %s, line: %d", path, lineNumber))
+		}
+		key, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		if len(value) > 1 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		environ = append(environ, key+"="+value)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, ex.New(err)
+	}
+	return
+}
@@ -0,0 +1,109 @@
+package jobkit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/logger"
+)
+
+// newLogOutputStream returns a new log output stream for a job invocation.
+func newLogOutputStream(ctx context.Context, log logger.Log, flag string, parseJSON bool) *logOutputStream {
+	if ji := cron.GetJobInvocation(ctx); ji != nil {
+		ctx = logger.WithLabels(ctx, logger.Labels{
+			"job.name":          ji.JobName,
+			"job.invocation_id": ji.ID,
+		})
+	}
+	return &logOutputStream{
+		Context:   ctx,
+		Log:       log,
+		Flag:      flag,
+		ParseJSON: parseJSON,
+	}
+}
+
+// logOutputStream is a line buffered writer that triggers a message event for each line of output.
+//
+// If ParseJSON is set, lines that are JSON objects have their fields added to the event as annotations,
+// and the message is taken from the `msg` or `message` field if present.
+type logOutputStream struct {
+	Context   context.Context
+	Log       logger.Log
+	Flag      string
+	ParseJSON bool
+
+	mu      sync.Mutex
+	partial []byte
+}
+
+// Write implements io.Writer.
+func (los *logOutputStream) Write(contents []byte) (int, error) {
+	if los.Log == nil {
+		return len(contents), nil
+	}
+	los.mu.Lock()
+	defer los.mu.Unlock()
+
+	los.partial = append(los.partial, contents...)
+	for {
+		index := bytes.IndexByte(los.partial, '\n')
+		if index < 0 {
+			break
+		}
+		los.trigger(los.partial[:index])
+		los.partial = los.partial[index+1:]
+	}
+	if len(los.partial) >= DefaultLogOutputMaxLineBytes {
+		los.trigger(los.partial)
+		los.partial = nil
+	}
+	return len(contents), nil
+}
+
+// Flush triggers an event for any partial line left when the process exits.
+func (los *logOutputStream) Flush() {
+	if los.Log == nil {
+		return
+	}
+	los.mu.Lock()
+	defer los.mu.Unlock()
+	if len(los.partial) > 0 {
+		los.trigger(los.partial)
+		los.partial = nil
+	}
+}
+
+func (los *logOutputStream) trigger(line []byte) {
+	line = bytes.TrimSuffix(line, []byte("\r"))
+	ctx, message := los.Context, string(line)
+	if los.ParseJSON {
+		if fields, ok := parseLogLine(line); ok {
+			if value, ok := fields["msg"].(string); ok {
+				message = value
+				delete(fields, "msg")
+			} else if value, ok := fields["message"].(string); ok {
+				message = value
+				delete(fields, "message")
+			}
+			ctx = logger.WithAnnotations(ctx, fields)
+		}
+	}
+	los.Log.Trigger(ctx, logger.NewMessageEvent(los.Flag, message))
+}
+
+// parseLogLine parses a line of output as a JSON object.
+func parseLogLine(line []byte) (logger.Annotations, bool) {
+	trimmed := bytes.TrimSpace(line)
+	if len(trimmed) == 0 || trimmed[0] != '{' {
+		return nil, false
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(trimmed, &fields); err != nil {
+		return nil, false
+	}
+	return logger.Annotations(fields), true
+}
@@ -0,0 +1,117 @@
+package jobkit
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/logger"
+	"github.com/blend/go-sdk/ref"
+)
+
+type testLogEvent struct {
+	Text        string
+	Labels      logger.Labels
+	Annotations logger.Annotations
+}
+
+func createTestLogOutputLog(flag string) (*logger.Logger, func() []testLogEvent) {
+	log := logger.MustNew(logger.OptAll(), logger.OptOutput(ioutil.Discard))
+
+	var mu sync.Mutex
+	var events []testLogEvent
+	log.Listen(flag, "test", logger.NewMessageEventListener(func(ctx context.Context, me logger.MessageEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, testLogEvent{
+			Text:        me.Text,
+			Labels:      logger.GetLabels(ctx),
+			Annotations: logger.GetAnnotations(ctx),
+		})
+	}))
+	return log, func() []testLogEvent {
+		log.Drain()
+		mu.Lock()
+		defer mu.Unlock()
+		return events
+	}
+}
+
+func TestLogOutputStreamLines(t *testing.T) {
+	assert := assert.New(t)
+
+	log, events := createTestLogOutputLog(ShellActionLogFlag)
+	defer log.Close()
+
+	ctx := cron.WithJobInvocation(context.Background(), &cron.JobInvocation{ID: "test-id", JobName: "test-job"})
+	los := newLogOutputStream(ctx, log, ShellActionLogFlag, false)
+
+	fmt.Fprint(los, "one\ntw")
+	fmt.Fprint(los, "o\n  three  \nfou")
+	assert.Len(events(), 3)
+	los.Flush()
+
+	logged := events()
+	assert.Len(logged, 4)
+	assert.Equal("one", logged[0].Text)
+	assert.Equal("two", logged[1].Text)
+	assert.Equal("  three  ", logged[2].Text)
+	assert.Equal("fou", logged[3].Text)
+	assert.Equal("test-job", logged[0].Labels["job.name"])
+	assert.Equal("test-id", logged[0].Labels["job.invocation_id"])
+}
+
+func TestLogOutputStreamJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	log, events := createTestLogOutputLog(ShellActionLogFlag)
+	defer log.Close()
+
+	los := newLogOutputStream(context.Background(), log, ShellActionLogFlag, true)
+	fmt.Fprint(los, `{"msg":"structured","count":3}`+"\n")
+	fmt.Fprint(los, `{"message":"also structured"}`+"\n")
+	fmt.Fprint(los, "{not json\n")
+
+	logged := events()
+	assert.Len(logged, 3)
+	assert.Equal("structured", logged[0].Text)
+	assert.Equal(float64(3), logged[0].Annotations["count"])
+	assert.Nil(logged[0].Annotations["msg"])
+	assert.Equal("also structured", logged[1].Text)
+	assert.Equal("{not json", logged[2].Text)
+	assert.Empty(logged[2].Annotations)
+}
+
+func TestShellActionLogOutput(t *testing.T) {
+	assert := assert.New(t)
+
+	log, stdoutEvents := createTestLogOutputLog(ShellActionLogFlag)
+	defer log.Close()
+	var mu sync.Mutex
+	var stderrLines []string
+	log.Listen(ShellActionStderrLogFlag, "test", logger.NewMessageEventListener(func(_ context.Context, me logger.MessageEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		stderrLines = append(stderrLines, me.Text)
+	}))
+
+	ctx, _ := createTestShellActionContext()
+	action := NewShellAction([]string{"sh", "-c", `echo '{"msg":"hello","n":1}'; echo oops >&2; printf partial`}, OptShellActionConfig(ShellActionConfig{
+		LogOutputJSON: ref.Bool(true),
+	}), OptShellActionLog(log))
+	assert.Nil(action.Execute(ctx))
+
+	logged := stdoutEvents()
+	assert.Len(logged, 2)
+	assert.Equal("hello", logged[0].Text)
+	assert.Equal(float64(1), logged[0].Annotations["n"])
+	assert.Equal("partial", logged[1].Text)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal([]string{"oops"}, stderrLines)
+}
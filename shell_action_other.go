@@ -0,0 +1,36 @@
+//go:build windows || plan9
+// +build windows plan9
+
+package jobkit
+
+import (
+	"os"
+	"os/exec"
+
+	"github.com/blend/go-sdk/ex"
+)
+
+// parseSignal returns os.Kill for any signal name, as processes
+// can only be killed on this platform.
+func parseSignal(_ string) (os.Signal, error) {
+	return os.Kill, nil
+}
+
+// setProcessGroup is a no-op on this platform.
+func setProcessGroup(_ *exec.Cmd) {}
+
+// signalProcessGroup signals the process of a started command.
+func signalProcessGroup(cmd *exec.Cmd, signal os.Signal) error {
+	if err := cmd.Process.Signal(signal); err != nil && err != os.ErrProcessDone {
+		return ex.New(err)
+	}
+	return nil
+}
+
+// setCredential returns an error if a user or group is set, as it is not supported on this platform.
+func setCredential(_ *exec.Cmd, uid, gid *int) error {
+	if uid != nil || gid != nil {
+		return ex.New("shell action; uid and gid are not supported on this platform")
+	}
+	return nil
+}
@@ -1,11 +1,18 @@
 package jobkit
 
 import (
+	"context"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/ex"
+	"github.com/blend/go-sdk/ref"
 	"github.com/blend/go-sdk/uuid"
 )
 
@@ -20,3 +27,342 @@ func TestShellAction(t *testing.T) {
 	action := NewShellAction([]string{"sh", fmt.Sprintf("$%s/foo", envVar)})
 	assert.NotNil(action)
 }
+
+func createTestShellActionContext() (context.Context, *JobInvocationOutput) {
+	jio := NewJobInvocationOutput()
+	ctx := cron.WithJobInvocation(context.Background(), &cron.JobInvocation{
+		ID:      uuid.V4().String(),
+		JobName: "test-job",
+		State:   jio,
+	})
+	return WithJobInvocationOutput(ctx, jio), jio
+}
+
+func TestShellActionExitInfo(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, jio := createTestShellActionContext()
+	action := NewShellAction([]string{"sh", "-c", "exit 3"}, OptShellActionConfig(ShellActionConfig{
+		HideOutput: ref.Bool(true),
+	}))
+	assert.NotNil(action.Execute(ctx))
+	assert.NotNil(jio.ExitInfo)
+	assert.Equal(3, jio.ExitInfo.ExitCode)
+	assert.False(jio.ExitInfo.Signaled())
+	assert.NotZero(jio.ExitInfo.PID)
+
+	ctx, jio = createTestShellActionContext()
+	action = NewShellAction([]string{"sh", "-c", "exit 0"}, OptShellActionConfig(ShellActionConfig{
+		HideOutput: ref.Bool(true),
+	}))
+	assert.Nil(action.Execute(ctx))
+	assert.NotNil(jio.ExitInfo)
+	assert.Zero(jio.ExitInfo.ExitCode)
+}
+
+func TestShellActionExitInfoSignaled(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, jio := createTestShellActionContext()
+	action := NewShellAction([]string{"sh", "-c", "kill -9 $$"}, OptShellActionConfig(ShellActionConfig{
+		HideOutput: ref.Bool(true),
+	}))
+	assert.NotNil(action.Execute(ctx))
+	assert.NotNil(jio.ExitInfo)
+	assert.Equal(-1, jio.ExitInfo.ExitCode)
+	assert.True(jio.ExitInfo.Signaled())
+	assert.Equal("killed", jio.ExitInfo.Signal)
+}
+
+func TestShellActionSuccessExitCodes(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, _ := createTestShellActionContext()
+	action := NewShellAction([]string{"sh", "-c", "exit 1"}, OptShellActionConfig(ShellActionConfig{
+		HideOutput:       ref.Bool(true),
+		SuccessExitCodes: []int{0, 1},
+	}))
+	assert.Nil(action.Execute(ctx))
+
+	ctx, _ = createTestShellActionContext()
+	action = NewShellAction([]string{"sh", "-c", "exit 0"}, OptShellActionConfig(ShellActionConfig{
+		HideOutput:       ref.Bool(true),
+		SuccessExitCodes: []int{1},
+	}))
+	err := action.Execute(ctx)
+	assert.True(ex.Is(err, ErrShellActionExitCode))
+}
+
+func TestShellActionSkipExitCode(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, jio := createTestShellActionContext()
+	action := NewShellAction([]string{"sh", "-c", "exit 3"}, OptShellActionConfig(ShellActionConfig{
+		HideOutput:   ref.Bool(true),
+		SkipExitCode: ref.Int(3),
+	}))
+	assert.Nil(action.Execute(ctx))
+	assert.True(jio.Skipped)
+
+	ji := cron.GetJobInvocation(ctx)
+	ji.Status = cron.JobInvocationStatusSuccess
+	assert.Equal(JobInvocationStatusSkipped, NewJobInvocation(ji).Status)
+}
+
+func TestShellActionFailOnOutput(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, _ := createTestShellActionContext()
+	action := NewShellAction([]string{"sh", "-c", "echo 'FATAL: something went wrong' 1>&2"}, OptShellActionConfig(ShellActionConfig{
+		HideOutput:   ref.Bool(true),
+		FailOnOutput: []string{"^FATAL"},
+	}))
+	err := action.Execute(ctx)
+	assert.True(ex.Is(err, ErrShellActionOutputMatched))
+
+	// the rules apply even if the output is discarded
+	ctx, _ = createTestShellActionContext()
+	action = NewShellAction([]string{"sh", "-c", "echo 'FATAL: something went wrong'"}, OptShellActionConfig(ShellActionConfig{
+		HideOutput:    ref.Bool(true),
+		DiscardOutput: ref.Bool(true),
+		FailOnOutput:  []string{"(?m)^FATAL"},
+	}))
+	err = action.Execute(ctx)
+	assert.True(ex.Is(err, ErrShellActionOutputMatched))
+
+	ctx, _ = createTestShellActionContext()
+	action = NewShellAction([]string{"sh", "-c", "echo 'all good'"}, OptShellActionConfig(ShellActionConfig{
+		HideOutput:   ref.Bool(true),
+		FailOnOutput: []string{"(?m)^FATAL"},
+	}))
+	assert.Nil(action.Execute(ctx))
+
+	ctx, _ = createTestShellActionContext()
+	action = NewShellAction([]string{"sh", "-c", "echo 'all good'"}, OptShellActionConfig(ShellActionConfig{
+		FailOnOutput: []string{"(FATAL"},
+	}))
+	assert.NotNil(action.Execute(ctx))
+}
+
+func TestShellActionWorkDirAndEnv(t *testing.T) {
+	assert := assert.New(t)
+
+	tempDir, err := ioutil.TempDir("", "jobkit")
+	assert.Nil(err)
+	defer os.RemoveAll(tempDir)
+
+	envFile := filepath.Join(tempDir, "test.env")
+	assert.Nil(ioutil.WriteFile(envFile, []byte("# comment\nexport FROM_FILE='file value'\nOVERRIDDEN=file\n"), 0600))
+
+	os.Setenv("JOBKIT_TEST_ALLOWED", "allowed")
+	defer os.Unsetenv("JOBKIT_TEST_ALLOWED")
+	os.Setenv("JOBKIT_TEST_DENIED", "denied")
+	defer os.Unsetenv("JOBKIT_TEST_DENIED")
+
+	ctx, jio := createTestShellActionContext()
+	cron.GetJobInvocation(ctx).Parameters = cron.JobParameters{"MESSAGE": "hello"}
+
+	action := NewShellAction([]string{"sh", "-c", "pwd; echo $FROM_FILE; echo $OVERRIDDEN; echo $STATIC; echo ${JOBKIT_TEST_ALLOWED:-unset}; echo ${JOBKIT_TEST_DENIED:-unset}"}, OptShellActionConfig(ShellActionConfig{
+		HideOutput: ref.Bool(true),
+		WorkDir:    tempDir,
+		EnvFile:    envFile,
+		Env: map[string]string{
+			"OVERRIDDEN": "env",
+			"STATIC":     "${MESSAGE} world",
+		},
+		InheritEnv: ShellActionInheritEnv{
+			Deny: []string{"JOBKIT_TEST_DENIED*"},
+		},
+	}))
+	assert.Nil(action.Execute(ctx))
+
+	resolvedTempDir, err := filepath.EvalSymlinks(tempDir)
+	assert.Nil(err)
+	lines := strings.Split(strings.TrimSpace(jio.Output.String()), "\n")
+	assert.Len(lines, 6)
+	assert.Equal(resolvedTempDir, lines[0])
+	assert.Equal("file value", lines[1])
+	assert.Equal("env", lines[2])
+	assert.Equal("hello world", lines[3])
+	assert.Equal("allowed", lines[4])
+	assert.Equal("unset", lines[5])
+}
+
+func TestShellActionInheritEnv(t *testing.T) {
+	assert := assert.New(t)
+
+	inheritEnv := ShellActionInheritEnv{}
+	assert.True(inheritEnv.Inherits("PATH"))
+
+	inheritEnv = ShellActionInheritEnv{Allow: []string{"PATH", "AWS_*"}, Deny: []string{"AWS_SECRET_ACCESS_KEY"}}
+	assert.True(inheritEnv.Inherits("PATH"))
+	assert.True(inheritEnv.Inherits("AWS_REGION"))
+	assert.False(inheritEnv.Inherits("AWS_SECRET_ACCESS_KEY"))
+	assert.False(inheritEnv.Inherits("HOME"))
+
+	inheritEnv = ShellActionInheritEnv{Disabled: ref.Bool(true)}
+	assert.False(inheritEnv.Inherits("PATH"))
+}
+
+func TestReadEnvFile(t *testing.T) {
+	assert := assert.New(t)
+
+	tempDir, err := ioutil.TempDir("", "jobkit")
+	assert.Nil(err)
+	defer os.RemoveAll(tempDir)
+
+	envFile := filepath.Join(tempDir, "test.env")
+	assert.Nil(ioutil.WriteFile(envFile, []byte("FOO=bar\n\n# comment\nexport BAZ=\"buzz fuzz\"\nEMPTY=\n"), 0600))
+	environ, err := ReadEnvFile(envFile)
+	assert.Nil(err)
+	assert.Equal([]string{"FOO=bar", "BAZ=buzz fuzz", "EMPTY="}, environ)
+
+	assert.Nil(ioutil.WriteFile(envFile, []byte("FOO=bar\nnot a variable\n"), 0600))
+	_, err = ReadEnvFile(envFile)
+	assert.True(ex.Is(err, ErrShellActionInvalidEnvFile))
+}
+
+func TestShellActionScript(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, jio := createTestShellActionContext()
+	cron.GetJobInvocation(ctx).Parameters = cron.JobParameters{"MESSAGE": "hello"}
+
+	action := NewShellAction([]string{"world"}, OptShellActionConfig(ShellActionConfig{
+		HideOutput:  ref.Bool(true),
+		Interpreter: []string{"sh", "-e"},
+		Script: `echo "$0"
+for i in 1 2; do
+	echo "${MESSAGE} $1 $i"
+done
+`,
+	}))
+	assert.Nil(action.Execute(ctx))
+
+	lines := strings.Split(strings.TrimSpace(jio.Output.String()), "\n")
+	assert.Len(lines, 3)
+	assert.Equal("hello world 1", lines[1])
+	assert.Equal("hello world 2", lines[2])
+
+	// the script file is cleaned up after the invocation
+	_, err := os.Stat(lines[0])
+	assert.True(os.IsNotExist(err))
+}
+
+func TestExpandScriptParameters(t *testing.T) {
+	assert := assert.New(t)
+
+	parameters := cron.JobParameters{"NAME": "world", "FIELD": "2"}
+	testCases := [...]struct {
+		Script   string
+		Expected string
+	}{
+		{Script: "echo $NAME ${NAME}", Expected: "echo world world"},
+		{Script: "echo ${NAME}s $NAMES", Expected: "echo worlds $NAMES"},
+		{Script: "awk '{print $1, $FIELD}'", Expected: "awk '{print $1, 2}'"},
+		{Script: "echo $$ $@ $# $? $* $-", Expected: "echo $$ $@ $# $? $* $-"},
+		{Script: "echo $HOME ${HOME:-/root} ${#NAME}", Expected: "echo $HOME ${HOME:-/root} ${#NAME}"},
+		{Script: "echo 'a ${ b' '$ c' $", Expected: "echo 'a ${ b' '$ c' $"},
+		{Script: "echo ${NAME", Expected: "echo ${NAME"},
+	}
+	for _, tc := range testCases {
+		assert.Equal(tc.Expected, expandScriptParameters(tc.Script, parameters), tc.Script)
+	}
+}
+
+func TestShellActionScriptFails(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, _ := createTestShellActionContext()
+	action := NewShellAction(nil, OptShellActionConfig(ShellActionConfig{
+		HideOutput:  ref.Bool(true),
+		Interpreter: []string{"sh", "-e"},
+		Script:      "false\necho unreachable\n",
+	}))
+	assert.NotNil(action.Execute(ctx))
+}
+
+func TestShellActionStdin(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, jio := createTestShellActionContext()
+	cron.GetJobInvocation(ctx).Parameters = cron.JobParameters{"NAME": "world", "BODY": "from a parameter"}
+
+	action := NewShellAction([]string{"cat"}, OptShellActionConfig(ShellActionConfig{
+		HideOutput: ref.Bool(true),
+		Stdin:      `hello {{ .Var "NAME" }}`,
+	}))
+	assert.Nil(action.Execute(ctx))
+	assert.Equal("hello world", jio.Output.String())
+
+	ctx, jio = createTestShellActionContext()
+	cron.GetJobInvocation(ctx).Parameters = cron.JobParameters{"NAME": "world", "BODY": "from a parameter"}
+
+	action = NewShellAction([]string{"cat"}, OptShellActionConfig(ShellActionConfig{
+		HideOutput:     ref.Bool(true),
+		Stdin:          `hello {{ .Var "NAME" }}`,
+		StdinParameter: "BODY",
+	}))
+	assert.Nil(action.Execute(ctx))
+	assert.Equal("from a parameter", jio.Output.String())
+}
+
+func TestShellActionArtifacts(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, jio := createTestShellActionContext()
+	action := NewShellAction([]string{"sh", "-c", "pwd; mkdir reports; echo a > reports/a.csv; echo bb > reports/b.csv; echo c > c.txt"}, OptShellActionConfig(ShellActionConfig{
+		HideOutput: ref.Bool(true),
+		Artifacts: ShellActionArtifacts{
+			Paths: []string{"reports/*.csv"},
+		},
+	}))
+	assert.Nil(action.Execute(ctx))
+	assert.Len(jio.Artifacts, 2)
+	assert.Equal("reports/a.csv", jio.Artifacts[0].Name)
+	assert.Equal("a\n", string(jio.Artifacts[0].Contents))
+	assert.Equal(int64(2), jio.Artifacts[0].Size)
+	assert.Equal("reports/b.csv", jio.Artifacts[1].Name)
+
+	// the temp work dir is removed once the artifacts are collected
+	_, err := os.Stat(strings.TrimSpace(jio.Output.String()))
+	assert.True(os.IsNotExist(err))
+}
+
+func TestShellActionArtifactsWorkDir(t *testing.T) {
+	assert := assert.New(t)
+
+	workDir, err := ioutil.TempDir("", "jobkit-test-")
+	assert.Nil(err)
+	defer os.RemoveAll(workDir)
+
+	ctx, jio := createTestShellActionContext()
+	action := NewShellAction([]string{"sh", "-c", "echo report > report.txt; exit 1"}, OptShellActionConfig(ShellActionConfig{
+		HideOutput: ref.Bool(true),
+		WorkDir:    workDir,
+		Artifacts: ShellActionArtifacts{
+			Paths: []string{"*.txt"},
+		},
+	}))
+	assert.NotNil(action.Execute(ctx))
+	assert.Len(jio.Artifacts, 1)
+	assert.Equal("report.txt", jio.Artifacts[0].Name)
+
+	// the configured work dir is left alone
+	_, err = os.Stat(filepath.Join(workDir, "report.txt"))
+	assert.Nil(err)
+}
+
+func TestShellActionOutputStreams(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, jio := createTestShellActionContext()
+	action := NewShellAction([]string{"sh", "-c", "echo out; sleep 0.05; echo err >&2; sleep 0.05; echo out again"}, OptShellActionConfig(ShellActionConfig{
+		HideOutput: ref.Bool(true),
+	}))
+	assert.Nil(action.Execute(ctx))
+
+	assert.Equal("out\nerr\nout again\n", jio.Output.String())
+	assert.Equal("err\n", jio.OutputTail(OutputStreamStderr, 1024))
+	assert.Equal("out\nout again\n", jio.OutputTail(OutputStreamStdout, 1024))
+}
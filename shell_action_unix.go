@@ -0,0 +1,82 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package jobkit
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+
+	"github.com/blend/go-sdk/ex"
+)
+
+// signals are the signals that can be used as shell action termination signals.
+var signals = map[string]syscall.Signal{
+	"SIGHUP":  syscall.SIGHUP,
+	"SIGINT":  syscall.SIGINT,
+	"SIGQUIT": syscall.SIGQUIT,
+	"SIGKILL": syscall.SIGKILL,
+	"SIGUSR1": syscall.SIGUSR1,
+	"SIGUSR2": syscall.SIGUSR2,
+	"SIGTERM": syscall.SIGTERM,
+}
+
+// parseSignal parses a signal name, with or without the `SIG` prefix.
+func parseSignal(name string) (os.Signal, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if !strings.HasPrefix(name, "SIG") {
+		name = "SIG" + name
+	}
+	if signal, ok := signals[name]; ok {
+		return signal, nil
+	}
+	return nil, ex.New(ErrShellActionInvalidSignal, ex.OptMessagef("signal: %s", name))
+}
+
+// setProcessGroup runs the command in its own process group.
+func setProcessGroup(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = new(syscall.SysProcAttr)
+	}
+	cmd.SysProcAttr.Setpgid = true
+}
+
+// signalProcessGroup sends a signal to the process group of a started command.
+func signalProcessGroup(cmd *exec.Cmd, signal os.Signal) error {
+	typed, ok := signal.(syscall.Signal)
+	if !ok {
+		return ex.New(ErrShellActionInvalidSignal, ex.OptMessagef("signal: %v", signal))
+	}
+	// the process group id is the pid of the group leader, and a negative pid signals the group.
+	if err := syscall.Kill(-cmd.Process.Pid, typed); err != nil && err != syscall.ESRCH {
+		return ex.New(err)
+	}
+	return nil
+}
+
+// setCredential runs the command as a given user and group, where either
+// defaults to that of the current process if it is unset.
+func setCredential(cmd *exec.Cmd, uid, gid *int) error {
+	if uid == nil && gid == nil {
+		return nil
+	}
+	credential := &syscall.Credential{
+		Uid: uint32(os.Getuid()),
+		Gid: uint32(os.Getgid()),
+		// only root can drop the supplementary groups.
+		NoSetGroups: os.Getuid() != 0,
+	}
+	if uid != nil {
+		credential.Uid = uint32(*uid)
+	}
+	if gid != nil {
+		credential.Gid = uint32(*gid)
+	}
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = new(syscall.SysProcAttr)
+	}
+	cmd.SysProcAttr.Credential = credential
+	return nil
+}
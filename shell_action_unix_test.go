@@ -0,0 +1,160 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package jobkit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/ex"
+	"github.com/blend/go-sdk/ref"
+)
+
+func TestShellActionTerminationGraceful(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, jio := createTestShellActionContext()
+	ctx, cancel := context.WithTimeout(ctx, 250*time.Millisecond)
+	defer cancel()
+
+	action := NewShellAction([]string{"sh", "-c", `trap "exit 0" TERM; while true; do sleep 0.1; done`}, OptShellActionConfig(ShellActionConfig{
+		HideOutput:             ref.Bool(true),
+		TerminationGracePeriod: ref.Duration(5 * time.Second),
+	}))
+	err := action.Execute(ctx)
+	assert.True(ex.Is(err, ErrShellActionTerminated))
+	assert.NotNil(jio.ExitInfo)
+	assert.Equal(TerminationGraceful, jio.ExitInfo.Termination)
+	assert.Equal(DefaultTerminationSignal, jio.ExitInfo.TerminationSignal)
+	assert.Zero(jio.ExitInfo.ExitCode)
+}
+
+func TestShellActionTerminationKilled(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, jio := createTestShellActionContext()
+	ctx, cancel := context.WithTimeout(ctx, 250*time.Millisecond)
+	defer cancel()
+
+	action := NewShellAction([]string{"sh", "-c", `trap "" INT; while true; do sleep 0.1; done`}, OptShellActionConfig(ShellActionConfig{
+		HideOutput:             ref.Bool(true),
+		TerminationSignal:      "INT",
+		TerminationGracePeriod: ref.Duration(250 * time.Millisecond),
+	}))
+	err := action.Execute(ctx)
+	assert.True(ex.Is(err, ErrShellActionTerminated))
+	assert.NotNil(jio.ExitInfo)
+	assert.Equal(TerminationKilled, jio.ExitInfo.Termination)
+	assert.Equal("killed", jio.ExitInfo.Signal)
+}
+
+func TestShellActionTerminationKilledOutputHeld(t *testing.T) {
+	assert := assert.New(t)
+
+	if _, err := exec.LookPath("setsid"); err != nil {
+		t.Skip("setsid is required to leave the process group")
+	}
+
+	ctx, jio := createTestShellActionContext()
+	ctx, cancel := context.WithTimeout(ctx, 250*time.Millisecond)
+	defer cancel()
+
+	// the grandchild leaves the process group, so it isn't killed, and holds the output pipes open.
+	action := NewShellAction([]string{"sh", "-c", `setsid sleep 30 & trap "" TERM; while true; do sleep 0.1; done`}, OptShellActionConfig(ShellActionConfig{
+		HideOutput:             ref.Bool(true),
+		TerminationGracePeriod: ref.Duration(250 * time.Millisecond),
+	}))
+	done := make(chan error)
+	go func() { done <- action.Execute(ctx) }()
+	select {
+	case err := <-done:
+		assert.True(ex.Is(err, ErrShellActionTerminated))
+	case <-time.After(10 * time.Second):
+		t.Fatal("the action should return once the grace period elapses after the kill")
+	}
+	assert.NotNil(jio.ExitInfo)
+	assert.Equal(TerminationKilled, jio.ExitInfo.Termination)
+}
+
+func TestShellActionTerminationInvalidSignal(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, _ := createTestShellActionContext()
+	action := NewShellAction([]string{"sh", "-c", "exit 0"}, OptShellActionConfig(ShellActionConfig{
+		TerminationSignal: "SIGNOTREAL",
+	}))
+	assert.True(ex.Is(action.Execute(ctx), ErrShellActionInvalidSignal))
+}
+
+func TestParseSignal(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, name := range []string{"SIGTERM", "TERM", "sigterm", " term "} {
+		signal, err := parseSignal(name)
+		assert.Nil(err)
+		assert.Equal(signals["SIGTERM"], signal)
+	}
+}
+
+func TestShellActionCredential(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, jio := createTestShellActionContext()
+	action := NewShellAction([]string{"sh", "-c", "id -u; id -g"}, OptShellActionConfig(ShellActionConfig{
+		HideOutput: ref.Bool(true),
+		UID:        ref.Int(os.Getuid()),
+		GID:        ref.Int(os.Getgid()),
+	}))
+	assert.Nil(action.Execute(ctx))
+	assert.Equal(fmt.Sprintf("%d\n%d", os.Getuid(), os.Getgid()), strings.TrimSpace(jio.Output.String()))
+}
+
+func TestShellActionCredentialPrimaryGroup(t *testing.T) {
+	assert := assert.New(t)
+
+	current, err := user.Current()
+	assert.Nil(err)
+
+	ctx, jio := createTestShellActionContext()
+	action := NewShellAction([]string{"sh", "-c", "id -g"}, OptShellActionConfig(ShellActionConfig{
+		HideOutput: ref.Bool(true),
+		UID:        ref.Int(os.Getuid()),
+	}))
+	assert.Nil(action.Execute(ctx))
+	assert.Equal(current.Gid, strings.TrimSpace(jio.Output.String()), "the gid defaults to the user's primary group")
+}
+
+func TestShellActionCredentialUnknownUser(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, _ := createTestShellActionContext()
+	action := NewShellAction([]string{"true"}, OptShellActionConfig(ShellActionConfig{
+		UID: ref.Int(2147483646),
+	}))
+	assert.NotNil(action.Execute(ctx), "the gid can't default to the primary group of an unknown user")
+}
+
+func TestShellActionScriptCredential(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("running as another user requires root")
+	}
+	assert := assert.New(t)
+
+	ctx, jio := createTestShellActionContext()
+	action := NewShellAction(nil, OptShellActionConfig(ShellActionConfig{
+		HideOutput: ref.Bool(true),
+		Script:     "id -u\n",
+		UID:        ref.Int(65534),
+		GID:        ref.Int(65534),
+	}))
+	assert.Nil(action.Execute(ctx), "the script is readable by the user it runs as")
+	assert.Equal("65534", strings.TrimSpace(jio.Output.String()))
+}
@@ -0,0 +1,202 @@
+package jobkit
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/db"
+	"github.com/blend/go-sdk/ex"
+	"github.com/blend/go-sdk/logger"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// NewSQLAction returns a new sql action.
+func NewSQLAction(opts ...SQLActionOption) SQLAction {
+	sqlAction := SQLAction{}
+	for _, opt := range opts {
+		opt(&sqlAction)
+	}
+	return sqlAction
+}
+
+// OptSQLActionConfig sets the sql action config.
+func OptSQLActionConfig(cfg SQLActionConfig) SQLActionOption {
+	return func(sa *SQLAction) { sa.Config = cfg }
+}
+
+// OptSQLActionConn sets the sql action database connection.
+func OptSQLActionConn(conn *db.Connection) SQLActionOption {
+	return func(sa *SQLAction) { sa.Conn = conn }
+}
+
+// OptSQLActionLog sets the sql action logger.
+func OptSQLActionLog(log logger.Log) SQLActionOption {
+	return func(sa *SQLAction) { sa.Log = log }
+}
+
+// SQLActionOption is a mutator for a sql action.
+type SQLActionOption func(*SQLAction)
+
+// SQLAction is a job body that executes sql statements.
+type SQLAction struct {
+	Log    logger.Log
+	Config SQLActionConfig
+	Conn   *db.Connection
+}
+
+// SQLStatementSummary is a summary of a statement executed by a sql action.
+type SQLStatementSummary struct {
+	// Statement is the statement that was executed.
+	Statement string `json:"statement"`
+	// RowsAffected is the number of rows affected by the statement.
+	RowsAffected int64 `json:"rowsAffected"`
+	// Elapsed is the time the statement took to execute.
+	Elapsed time.Duration `json:"elapsed"`
+	// Err is the error the statement failed with, if it failed.
+	Err string `json:"err,omitempty"`
+}
+
+// Execute is the job body.
+func (sa SQLAction) Execute(ctx context.Context) (err error) {
+	ji := cron.GetJobInvocation(ctx)
+	jio := GetJobInvocationOutput(ctx)
+
+	if ji == nil || jio == nil {
+		return fmt.Errorf("sql action; invocation meta required with the output set")
+	}
+	if sa.Conn == nil {
+		return ex.New("sql action; connection unset")
+	}
+	statements, err := sa.statements()
+	if err != nil {
+		return err
+	}
+	args, err := sa.args(ji)
+	if err != nil {
+		return err
+	}
+	statementArgs := make([][]interface{}, len(statements))
+	for index, statement := range statements {
+		if statementArgs[index], err = sa.statementArgs(statement, args); err != nil {
+			return err
+		}
+	}
+
+	ctx, span := startSpan(ctx, SpanSQLActionExecute,
+		attribute.String("job.name", ji.JobName),
+		attribute.String("job.invocation_id", ji.ID),
+		attribute.Int("sql_action.statements", len(statements)),
+	)
+	defer func() { endSpan(span, err) }()
+
+	var tx *sql.Tx
+	if sa.Config.TransactionOrDefault() {
+		if tx, err = sa.Conn.BeginTx(ctx); err != nil {
+			return ex.New(err)
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := sa.rollback(tx); rollbackErr != nil {
+					logger.MaybeError(sa.Log, rollbackErr)
+					fmt.Fprintf(jio.Output, "transaction rollback failed: %v\n", rollbackErr)
+					return
+				}
+				fmt.Fprintf(jio.Output, "transaction rolled back\n")
+			}
+		}()
+	}
+
+	for index, statement := range statements {
+		started := time.Now()
+		var res sql.Result
+		res, err = sa.Conn.Invoke(db.OptContext(ctx), db.OptTx(tx)).Exec(statement, statementArgs[index]...)
+		summary := SQLStatementSummary{
+			Statement: statement,
+			Elapsed:   time.Since(started),
+		}
+		if err != nil {
+			summary.Err = err.Error()
+			jio.SQLSummary = append(jio.SQLSummary, summary)
+			fmt.Fprintf(jio.Output, "statement %d failed (%v): %v\n", index+1, summary.Elapsed.Round(time.Millisecond), err)
+			if ctx.Err() != nil {
+				return ex.New(ErrSQLActionCancelled, ex.OptInner(err))
+			}
+			return ex.New(err, ex.OptMessagef("statement: %d", index+1))
+		}
+		summary.RowsAffected, _ = res.RowsAffected()
+		jio.SQLSummary = append(jio.SQLSummary, summary)
+		fmt.Fprintf(jio.Output, "statement %d: %d rows affected (%v)\n", index+1, summary.RowsAffected, summary.Elapsed.Round(time.Millisecond))
+	}
+
+	if tx != nil {
+		if ctx.Err() != nil {
+			err = ex.New(ErrSQLActionCancelled, ex.OptInner(ctx.Err()))
+			return
+		}
+		if err = tx.Commit(); err != nil {
+			return ex.New(err)
+		}
+		fmt.Fprintf(jio.Output, "transaction committed\n")
+	}
+	return nil
+}
+
+// statements returns the statement and the statements in the script file.
+func (sa SQLAction) statements() (output []string, err error) {
+	if sa.Config.Statement != "" {
+		output = append(output, sa.Config.Statement)
+	}
+	if sa.Config.ScriptFile != "" {
+		var contents []byte
+		if contents, err = ioutil.ReadFile(sa.Config.ScriptFile); err != nil {
+			err = ex.New(err, ex.OptMessagef("sql action; script file: %s", sa.Config.ScriptFile))
+			return
+		}
+		output = append(output, SplitSQLStatements(string(contents))...)
+	}
+	if len(output) == 0 {
+		err = ex.New("sql action; statement and script file unset")
+	}
+	return
+}
+
+// args returns the invocation parameters bound to the statement placeholders.
+func (sa SQLAction) args(ji *cron.JobInvocation) (output []interface{}, err error) {
+	for _, name := range sa.Config.Args {
+		value, ok := ji.Parameters[name]
+		if !ok {
+			err = ex.New(ErrSQLActionArgUnset, ex.OptMessagef("parameter: %s", name))
+			return
+		}
+		output = append(output, value)
+	}
+	return
+}
+
+// statementArgs returns the args for the placeholders a statement uses.
+func (sa SQLAction) statementArgs(statement string, args []interface{}) ([]interface{}, error) {
+	count := sqlPlaceholderCount(statement)
+	if count > len(args) {
+		return nil, ex.New(ErrSQLActionArgUnset, ex.OptMessagef("statement uses $%d, but only %d args are set", count, len(args)))
+	}
+	return args[:count], nil
+}
+
+// rollback rolls back a transaction, ignoring if the transaction was already
+// rolled back because the invocation context was cancelled.
+func (sa SQLAction) rollback(tx *sql.Tx) error {
+	if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+		return ex.New(err)
+	}
+	return nil
+}
+
+// SQL action errors.
+const (
+	ErrSQLActionArgUnset  ex.Class = "sql action; arg parameter unset"
+	ErrSQLActionCancelled ex.Class = "sql action; invocation cancelled"
+)
@@ -0,0 +1,33 @@
+package jobkit
+
+import (
+	"github.com/blend/go-sdk/db"
+)
+
+// SQLActionConfig is a config for sql actions.
+type SQLActionConfig struct {
+	// Statement is a sql statement to execute.
+	Statement string `yaml:"statement"`
+	// ScriptFile is the path to a file of `;` separated sql statements to execute in order, after the statement if it's set.
+	ScriptFile string `yaml:"scriptFile"`
+	// Args are the names of the invocation parameters bound to the statement placeholders, i.e. `$1`, `$2` etc.
+	Args []string `yaml:"args"`
+	// Transaction runs the statements for each invocation in a transaction that is rolled back
+	// if a statement fails or the invocation is cancelled, defaulting to true.
+	Transaction *bool `yaml:"transaction"`
+	// DB is the connection for the job, defaulting to the jobkit database connection.
+	DB db.Config `yaml:"db"`
+}
+
+// IsZero returns if the sql action is set or not.
+func (sc SQLActionConfig) IsZero() bool {
+	return sc.Statement == "" && sc.ScriptFile == ""
+}
+
+// TransactionOrDefault returns a value or a default.
+func (sc SQLActionConfig) TransactionOrDefault() bool {
+	if sc.Transaction != nil {
+		return *sc.Transaction
+	}
+	return DefaultSQLActionTransaction
+}
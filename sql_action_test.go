@@ -0,0 +1,129 @@
+package jobkit
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/db"
+	"github.com/blend/go-sdk/ex"
+	"github.com/blend/go-sdk/ref"
+	"github.com/blend/go-sdk/uuid"
+)
+
+func createTestSQLActionTable(assert *assert.Assertions) (*db.Connection, string) {
+	conn, err := db.New(db.OptConfig(db.Config{
+		Database: "postgres",
+		SSLMode:  db.SSLModeDisable,
+	}))
+	assert.Nil(err)
+	assert.Nil(conn.Open())
+
+	table := "sql_action_test_" + uuid.V4().String()[:8]
+	_, err = conn.Exec(fmt.Sprintf("create table %s (id serial primary key, name varchar(255) not null)", table))
+	assert.Nil(err)
+	return conn, table
+}
+
+func countTestSQLActionRows(assert *assert.Assertions, conn *db.Connection, table string) (count int) {
+	_, err := conn.Query(fmt.Sprintf("select count(*) from %s", table)).Scan(&count)
+	assert.Nil(err)
+	return
+}
+
+func TestSQLAction(t *testing.T) {
+	assert := assert.New(t)
+
+	conn, table := createTestSQLActionTable(assert)
+	defer conn.Close()
+	defer conn.Exec(fmt.Sprintf("drop table %s", table))
+
+	scriptFile, err := ioutil.TempFile("", "jobkit-test-")
+	assert.Nil(err)
+	defer os.Remove(scriptFile.Name())
+	fmt.Fprintf(scriptFile, "insert into %s (name) values ($1), ($2);\nupdate %s set name = $2 where name = $1;\n", table, table)
+	assert.Nil(scriptFile.Close())
+
+	ctx, jio := createTestShellActionContext()
+	cron.GetJobInvocation(ctx).Parameters = cron.JobParameters{"FIRST": "bailey", "SECOND": "dog"}
+
+	action := NewSQLAction(OptSQLActionConn(conn), OptSQLActionConfig(SQLActionConfig{
+		Statement:  fmt.Sprintf("insert into %s (name) values ($1)", table),
+		ScriptFile: scriptFile.Name(),
+		Args:       []string{"FIRST", "SECOND"},
+	}))
+	assert.Nil(action.Execute(ctx))
+
+	assert.Equal(3, countTestSQLActionRows(assert, conn, table))
+	assert.Len(jio.SQLSummary, 3)
+	assert.Equal(int64(1), jio.SQLSummary[0].RowsAffected)
+	assert.Equal(int64(2), jio.SQLSummary[1].RowsAffected)
+	assert.Equal(int64(2), jio.SQLSummary[2].RowsAffected)
+	assert.Contains(jio.Output.String(), "statement 2: 2 rows affected")
+	assert.Contains(jio.Output.String(), "transaction committed")
+}
+
+func TestSQLActionRollback(t *testing.T) {
+	assert := assert.New(t)
+
+	conn, table := createTestSQLActionTable(assert)
+	defer conn.Close()
+	defer conn.Exec(fmt.Sprintf("drop table %s", table))
+
+	ctx, jio := createTestShellActionContext()
+	action := NewSQLAction(OptSQLActionConn(conn), OptSQLActionConfig(SQLActionConfig{
+		Statement: fmt.Sprintf("insert into %s (name) values ('bailey'); insert into %s (name) values (null)", table, table),
+	}))
+	assert.NotNil(action.Execute(ctx))
+	assert.Zero(countTestSQLActionRows(assert, conn, table))
+	assert.Contains(jio.Output.String(), "transaction rolled back")
+	assert.Len(jio.SQLSummary, 1, "the failed statement is summarized")
+	assert.NotEmpty(jio.SQLSummary[0].Err)
+
+	ctx, _ = createTestShellActionContext()
+	action = NewSQLAction(OptSQLActionConn(conn), OptSQLActionConfig(SQLActionConfig{
+		Statement:   fmt.Sprintf("insert into %s (name) values ('bailey'); insert into %s (name) values (null)", table, table),
+		Transaction: ref.Bool(false),
+	}))
+	assert.NotNil(action.Execute(ctx))
+}
+
+func TestSQLActionCancelled(t *testing.T) {
+	assert := assert.New(t)
+
+	conn, table := createTestSQLActionTable(assert)
+	defer conn.Close()
+	defer conn.Exec(fmt.Sprintf("drop table %s", table))
+
+	ctx, _ := createTestShellActionContext()
+	ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+
+	action := NewSQLAction(OptSQLActionConn(conn), OptSQLActionConfig(SQLActionConfig{
+		Statement: fmt.Sprintf("insert into %s (name) values ('bailey'); select pg_sleep(5)", table),
+	}))
+	err := action.Execute(ctx)
+	assert.True(ex.Is(err, ErrSQLActionCancelled), fmt.Sprintf("%+v", err))
+	assert.Zero(countTestSQLActionRows(assert, conn, table))
+}
+
+func TestSQLActionArgUnset(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, _ := createTestShellActionContext()
+	action := NewSQLAction(OptSQLActionConn(new(db.Connection)), OptSQLActionConfig(SQLActionConfig{
+		Statement: "select $1",
+		Args:      []string{"MISSING"},
+	}))
+	assert.True(ex.Is(action.Execute(ctx), ErrSQLActionArgUnset))
+
+	action = NewSQLAction(OptSQLActionConn(new(db.Connection)), OptSQLActionConfig(SQLActionConfig{
+		Statement: "select $1",
+	}))
+	assert.True(ex.Is(action.Execute(ctx), ErrSQLActionArgUnset))
+}
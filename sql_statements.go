@@ -0,0 +1,105 @@
+package jobkit
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SplitSQLStatements splits a sql script into its `;` separated statements.
+//
+// Semicolons in quoted strings and identifiers, dollar quoted strings and comments don't end statements.
+// Statements are trimmed, and empty statements are dropped.
+func SplitSQLStatements(script string) (output []string) {
+	var statement strings.Builder
+	flush := func() {
+		if trimmed := strings.TrimSpace(statement.String()); trimmed != "" {
+			output = append(output, trimmed)
+		}
+		statement.Reset()
+	}
+	scanSQL(script, func(token string, code bool) {
+		if code && token == ";" {
+			flush()
+			return
+		}
+		statement.WriteString(token)
+	})
+	flush()
+	return
+}
+
+// scanSQL splits a sql script into tokens, calling a visitor with each token and if it's code,
+// rather than a quoted string or identifier, a dollar quoted string or a comment.
+//
+// Placeholders, e.g. `$2`, are single tokens; other code is visited a byte at a time.
+func scanSQL(script string, visit func(token string, code bool)) {
+	for index := 0; index < len(script); {
+		rest := script[index:]
+		end, code := 1, true
+		switch {
+		case rest[0] == '\'' || rest[0] == '"':
+			end, code = quotedEnd(rest, rest[0]), false
+		case strings.HasPrefix(rest, "--"):
+			if end = strings.IndexByte(rest, '\n'); end < 0 {
+				end = len(rest)
+			}
+			code = false
+		case strings.HasPrefix(rest, "/*"):
+			if end = strings.Index(rest, "*/"); end < 0 {
+				end = len(rest)
+			} else {
+				end += len("*/")
+			}
+			code = false
+		case rest[0] == '$':
+			if tag := dollarQuoteTag.FindString(rest); tag != "" {
+				if closing := strings.Index(rest[len(tag):], tag); closing < 0 {
+					end = len(rest)
+				} else {
+					end = len(tag) + closing + len(tag)
+				}
+				code = false
+			} else if placeholder := sqlPlaceholder.FindString(rest); placeholder != "" {
+				end = len(placeholder)
+			}
+		}
+		visit(rest[:end], code)
+		index += end
+	}
+}
+
+// quotedEnd returns the length of a quoted string at the start of a given string, where
+// the quote is escaped by doubling it, or the length of the string if it's unterminated.
+func quotedEnd(value string, quote byte) int {
+	for index := 1; index < len(value); index++ {
+		if value[index] == quote {
+			if index+1 < len(value) && value[index+1] == quote {
+				index++
+				continue
+			}
+			return index + 1
+		}
+	}
+	return len(value)
+}
+
+// sqlPlaceholderCount returns the highest numbered placeholder, e.g. `$2`, in a statement.
+//
+// Placeholders in quoted strings and identifiers, dollar quoted strings and comments aren't counted.
+func sqlPlaceholderCount(statement string) (count int) {
+	scanSQL(statement, func(token string, code bool) {
+		if !code || len(token) < 2 || token[0] != '$' {
+			return
+		}
+		if value, err := strconv.Atoi(token[1:]); err == nil && value > count {
+			count = value
+		}
+	})
+	return
+}
+
+var (
+	dollarQuoteTag = regexp.MustCompile(`^\$([A-Za-z_][A-Za-z0-9_]*)?\$`)
+	sqlPlaceholder = regexp.MustCompile(`^\$[0-9]+`)
+)
@@ -0,0 +1,40 @@
+package jobkit
+
+import (
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestSplitSQLStatements(t *testing.T) {
+	assert := assert.New(t)
+
+	statements := SplitSQLStatements(`
+-- vacuum the things; carefully
+delete from things where name = 'a;b' and note = 'it''s; fine';
+update "odd;table" set value = 1 /* not; a; split */ where id = $1;
+
+create function noop() returns void as $body$ begin; end; $body$ language plpgsql;
+select $$;$$;
+;
+select 1`)
+	assert.Equal([]string{
+		"-- vacuum the things; carefully\ndelete from things where name = 'a;b' and note = 'it''s; fine'",
+		`update "odd;table" set value = 1 /* not; a; split */ where id = $1`,
+		`create function noop() returns void as $body$ begin; end; $body$ language plpgsql`,
+		`select $$;$$`,
+		`select 1`,
+	}, statements)
+
+	assert.Empty(SplitSQLStatements(" ; \n ;"))
+}
+
+func TestSQLPlaceholderCount(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(0, sqlPlaceholderCount("select 1"))
+	assert.Equal(2, sqlPlaceholderCount("select $2, $1"))
+	assert.Equal(12, sqlPlaceholderCount("select $12"))
+	assert.Equal(1, sqlPlaceholderCount(`select $1, '$2', "$3" -- $4
+/* $5 */ $tag$ $6 $tag$, $$ $7 $$`), "placeholders in literals and comments aren't counted")
+}
@@ -0,0 +1,124 @@
+package jobkit
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/blend/go-sdk/cron"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the instrumentation name used for jobkit spans.
+const TracerName = "github.com/blend/jobkit"
+
+// Span names.
+const (
+	SpanJobExecute          = "job.execute"
+	SpanJobNotify           = "job.notify"
+	SpanShellActionExecute  = "shell_action.execute"
+	SpanHTTPActionExecute   = "http_action.execute"
+	SpanSQLActionExecute    = "sql_action.execute"
+	SpanWorkflowStepExecute = "workflow_action.step"
+)
+
+// NewTracerProvider returns a tracer provider that batches spans to a given exporter.
+//
+// Any opentelemetry span exporter can be used, e.g. an otlp exporter in production
+// or `tracetest.NewInMemoryExporter()` in tests.
+func NewTracerProvider(exporter sdktrace.SpanExporter, options ...sdktrace.TracerProviderOption) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(append([]sdktrace.TracerProviderOption{sdktrace.WithBatcher(exporter)}, options...)...)
+}
+
+type tracerProviderKey struct{}
+
+// WithTracerProvider adds a tracer provider to a context.
+func WithTracerProvider(ctx context.Context, provider trace.TracerProvider) context.Context {
+	return context.WithValue(ctx, tracerProviderKey{}, provider)
+}
+
+// GetTracerProvider gets a tracer provider from a context, falling back
+// to the global opentelemetry tracer provider if one is not set.
+func GetTracerProvider(ctx context.Context) trace.TracerProvider {
+	if value := ctx.Value(tracerProviderKey{}); value != nil {
+		if typed, ok := value.(trace.TracerProvider); ok && typed != nil {
+			return typed
+		}
+	}
+	return otel.GetTracerProvider()
+}
+
+// TraceContextHeaders returns the W3C trace context of the span in a given context as http headers.
+func TraceContextHeaders(ctx context.Context) http.Header {
+	headers := http.Header{}
+	propagation.TraceContext{}.Inject(ctx, propagation.HeaderCarrier(headers))
+	return headers
+}
+
+// TraceContextEnviron returns the W3C trace context of the span in a given context
+// as environment variables, i.e. `TRACEPARENT` and `TRACESTATE`.
+func TraceContextEnviron(ctx context.Context) (output []string) {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	for key, value := range carrier {
+		output = append(output, strings.ToUpper(key)+"="+value)
+	}
+	sort.Strings(output)
+	return
+}
+
+// startSpan starts a span with the tracer provider in a given context.
+func startSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return GetTracerProvider(ctx).Tracer(TracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// endSpan sets the status of a span from an error and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		span.SetStatus(codes.Ok, "")
+	}
+	span.End()
+}
+
+// jobInvocationSpanAttributes returns the span attributes for a job invocation.
+//
+// Only the names of the invocation parameters are included, as their values can be secrets.
+func jobInvocationSpanAttributes(ji *cron.JobInvocation) []attribute.KeyValue {
+	if ji == nil {
+		return nil
+	}
+	attributes := []attribute.KeyValue{
+		attribute.String("job.name", ji.JobName),
+		attribute.String("job.invocation_id", ji.ID),
+	}
+	if len(ji.Parameters) > 0 {
+		names := make([]string, 0, len(ji.Parameters))
+		for name := range ji.Parameters {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		attributes = append(attributes, attribute.StringSlice("job.parameters", names))
+	}
+	return attributes
+}
+
+// jobInvocationSpanStatus returns the status of a job invocation for its span
+// before the status is set on the invocation itself.
+func jobInvocationSpanStatus(ctx context.Context, err error) cron.JobInvocationStatus {
+	if err == nil {
+		return cron.JobInvocationStatusSuccess
+	}
+	if ctx.Err() != nil {
+		return cron.JobInvocationStatusCancelled
+	}
+	return cron.JobInvocationStatusErrored
+}
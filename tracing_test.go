@@ -0,0 +1,138 @@
+package jobkit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/ref"
+	"github.com/blend/go-sdk/uuid"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newTestTracerProvider() (*sdktrace.TracerProvider, *tracetest.InMemoryExporter) {
+	exporter := tracetest.NewInMemoryExporter()
+	return sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)), exporter
+}
+
+func TestJobExecuteTracing(t *testing.T) {
+	assert := assert.New(t)
+
+	provider, exporter := newTestTracerProvider()
+
+	action := NewShellAction([]string{"sh", "-c", "echo $TRACEPARENT"}, OptShellActionConfig(ShellActionConfig{
+		HideOutput: ref.Bool(true),
+	}))
+	job := MustNewJob(cron.NewJob(cron.OptJobName("test-job"), cron.OptJobAction(action.Execute)),
+		OptJobTracerProvider(provider),
+	)
+
+	ji := &cron.JobInvocation{
+		ID:         uuid.V4().String(),
+		JobName:    job.Name(),
+		Started:    cron.Now(),
+		Parameters: cron.JobParameters{"foo": "bar", "token": "secret"},
+	}
+	assert.Nil(job.Execute(cron.WithJobInvocation(context.Background(), ji)))
+
+	spans := exporter.GetSpans()
+	assert.Len(spans, 2)
+
+	shellSpan, jobSpan := spans[0], spans[1]
+	assert.Equal(SpanShellActionExecute, shellSpan.Name)
+	assert.Equal(SpanJobExecute, jobSpan.Name)
+	assert.Equal(jobSpan.SpanContext.SpanID(), shellSpan.Parent.SpanID())
+	assert.Equal(jobSpan.SpanContext.TraceID(), shellSpan.SpanContext.TraceID())
+
+	attributes := make(map[string]string)
+	for _, attribute := range jobSpan.Attributes {
+		attributes[string(attribute.Key)] = attribute.Value.Emit()
+	}
+	assert.Equal("test-job", attributes["job.name"])
+	assert.Equal(ji.ID, attributes["job.invocation_id"])
+	assert.Contains(attributes["job.parameters"], "foo")
+	assert.Contains(attributes["job.parameters"], "token")
+	assert.NotContains(attributes["job.parameters"], "secret", "parameter values aren't exported")
+	assert.Empty(attributes["job.parameter.foo"])
+	assert.Equal(string(cron.JobInvocationStatusSuccess), attributes["job.status"])
+
+	output := strings.TrimSpace(ji.State.(*JobInvocationOutput).Output.String())
+	assert.Equal(fmt.Sprintf("00-%s-%s-01", shellSpan.SpanContext.TraceID(), shellSpan.SpanContext.SpanID()), output)
+}
+
+func TestJobExecuteTracingError(t *testing.T) {
+	assert := assert.New(t)
+
+	provider, exporter := newTestTracerProvider()
+
+	job := MustNewJob(cron.NewJob(cron.OptJobName("test-job"), cron.OptJobAction(func(_ context.Context) error {
+		return fmt.Errorf("only a test")
+	})), OptJobTracerProvider(provider))
+
+	ctx := cron.WithJobInvocation(context.Background(), &cron.JobInvocation{
+		ID:      uuid.V4().String(),
+		JobName: job.Name(),
+	})
+	assert.NotNil(job.Execute(ctx))
+
+	spans := exporter.GetSpans()
+	assert.Len(spans, 1)
+	assert.Equal("only a test", spans[0].Status.Description)
+	assert.NotEmpty(spans[0].Events)
+}
+
+func TestJobNotifyWebhookTracing(t *testing.T) {
+	assert := assert.New(t)
+
+	provider, exporter := newTestTracerProvider()
+
+	webhooks := make(chan *http.Request, 1)
+	hookServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		webhooks <- req
+		fmt.Fprintf(rw, "OK!\n")
+	}))
+	defer hookServer.Close()
+
+	job := MustNewJob(cron.NewJob(cron.OptJobName("test-job")), OptJobTracerProvider(provider))
+	job.WebhookDefaults = Webhook{URL: hookServer.URL}
+
+	_, parent := provider.Tracer(TracerName).Start(context.Background(), SpanJobExecute)
+	parent.End()
+
+	output := NewJobInvocationOutput()
+	output.SpanContext = parent.SpanContext()
+	ctx := cron.WithJobInvocation(context.Background(), &cron.JobInvocation{
+		ID:      uuid.V4().String(),
+		JobName: job.Name(),
+		State:   output,
+	})
+	job.notify(ctx, cron.FlagErrored)
+
+	req := <-webhooks
+	spans := exporter.GetSpans()
+	assert.Len(spans, 2)
+	notifySpan := spans[1]
+	assert.Equal(SpanJobNotify, notifySpan.Name)
+	assert.Equal(parent.SpanContext().SpanID(), notifySpan.Parent.SpanID())
+	assert.Equal(fmt.Sprintf("00-%s-%s-01", notifySpan.SpanContext.TraceID(), notifySpan.SpanContext.SpanID()), req.Header.Get("Traceparent"))
+}
+
+func TestTraceContextEnviron(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Empty(TraceContextEnviron(context.Background()))
+
+	provider, _ := newTestTracerProvider()
+	ctx, span := provider.Tracer(TracerName).Start(context.Background(), "test")
+	defer span.End()
+
+	environ := TraceContextEnviron(ctx)
+	assert.Len(environ, 1)
+	assert.Equal(fmt.Sprintf("TRACEPARENT=00-%s-%s-01", span.SpanContext().TraceID(), span.SpanContext().SpanID()), environ[0])
+}
@@ -56,81 +56,120 @@ var BinaryAssets = map[string]*BinaryFile{
 			0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0xaa, 0xae, 0x56, 0x48, 0x49, 0x4d, 0xcb, 0xcc, 0x4b, 0x55, 0x50, 0x4a, 0xcb, 0xcf, 0x2f, 0x49, 0x2d, 0x52, 0x52, 0xa8, 0xad, 0xe5, 0xb2, 0xd1, 0x4f, 0xca, 0x4f, 0xa9, 0xb4, 0xe3, 0xb2, 0xd1, 0xcf, 0x28, 0xc9, 0xcd, 0xb1, 0xe3, 0xaa, 0xae, 0x56, 0x48, 0xcd, 0x4b, 0x51, 0xa8, 0xad, 0x05, 0x04, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x6a, 0x95, 0x38, 0x2f, 0x00, 0x00, 0x00,
 		},
 	},
+	"_views/graph.html": &BinaryFile{
+		Name:    "_views/graph.html",
+		ModTime: 1792424422,
+		MD5: []byte{
+			0xd4, 0x1d, 0x8c, 0xd9, 0x8f, 0x00, 0xb2, 0x04, 0xe9, 0x80, 0x09, 0x98, 0xec, 0xf8, 0x42, 0x7e,
+		},
+		CompressedContents: []byte{
+			0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x8d, 0x54, 0x4d, 0x73, 0xd3, 0x30, 0x10, 0x3d, 0xb7, 0xbf, 0x62, 0x47, 0x93, 0x23, 0xb6, 0x7b, 0xe8, 0x81, 0x29, 0x8e, 0x87, 0x0b, 0x30, 0x30, 0x50, 0x0e, 0x0c, 0x70, 0x56, 0xa2, 0x8d, 0x2d, 0x90, 0x2d, 0x23, 0xcb, 0x4d, 0x3b, 0x21, 0xff, 0x9d, 0x5d, 0x59, 0x76, 0x94, 0xb4, 0x07, 0x2e, 0xb1, 0xf5, 0xde, 0x7e, 0xbc, 0x7d, 0x5e, 0xe5, 0x70, 0x00, 0x85, 0x3b, 0xdd, 0x21, 0x88, 0xda, 0xc9, 0xbe, 0x11, 0x70, 0x3c, 0x5e, 0x1f, 0x0e, 0xe0, 0xb1, 0xed, 0x8d, 0xf4, 0x04, 0x37, 0x28, 0x15, 0x3a, 0x01, 0x39, 0x33, 0xa5,
+			0xd2, 0x0f, 0xa0, 0xd5, 0x5a, 0x6c, 0x6d, 0xe7, 0xb1, 0xf3, 0x02, 0xb6, 0x46, 0x0e, 0xc3, 0x5a, 0x8c, 0xbf, 0x33, 0x86, 0x24, 0x55, 0x72, 0x90, 0x1e, 0x32, 0x7c, 0xec, 0x65, 0xa7, 0x44, 0x75, 0x7d, 0x15, 0x92, 0x93, 0xf8, 0x46, 0x1b, 0x95, 0xed, 0xb5, 0xf2, 0x4d, 0x0c, 0x7a, 0x3b, 0x08, 0xce, 0xad, 0x9d, 0x56, 0x14, 0x1e, 0xe2, 0xf9, 0x79, 0x55, 0x8e, 0x26, 0xc9, 0xdb, 0x38, 0x52, 0xb4, 0x75, 0x63, 0xbb, 0x11, 0x81, 0xbd, 0x2a, 0x8d, 0xae, 0x4a, 0x09, 0x8d, 0xc3, 0xdd, 0x5a, 0x14, 0xa2, 0xfa, 0x64, 0x37, 0x43, 0x59, 0xc8, 0xaa, 0x2c, 0x88, 0x38, 0x45, 0x0c, 0xd4, 0xa2,
+			0xfa, 0xc0, 0x43, 0x96, 0x45, 0x78, 0x5f, 0xf8, 0xb2, 0x18, 0x4d, 0x68, 0x58, 0x4c, 0x1d, 0xe7, 0x27, 0xf9, 0xb0, 0x0a, 0xae, 0xc0, 0xdd, 0x1a, 0xf2, 0x1f, 0x1a, 0xf7, 0x5f, 0xac, 0x42, 0xc3, 0x46, 0x30, 0xa7, 0x77, 0x91, 0xce, 0xdf, 0xa9, 0x1a, 0x87, 0x00, 0x5f, 0xcc, 0xc8, 0xa3, 0xcc, 0x23, 0x65, 0x44, 0x69, 0x35, 0xd9, 0x13, 0xce, 0x2d, 0x2a, 0x3d, 0xb6, 0xf0, 0x3f, 0x56, 0x50, 0x37, 0x27, 0xbb, 0x1a, 0x61, 0x65, 0xf0, 0x01, 0xcd, 0x2b, 0x58, 0x75, 0xa4, 0x64, 0x60, 0x5d, 0x51, 0xc2, 0x67, 0xc6, 0x27, 0x0d, 0x89, 0x71, 0x3c, 0x67, 0x22, 0xc7, 0xe3, 0xa3, 0xcf, 0x86,
+			0x56, 0x1a, 0x23, 0xaa, 0x38, 0x40, 0xa8, 0x07, 0x7f, 0x01, 0xff, 0xc0, 0x0d, 0x65, 0x7f, 0xdb, 0x36, 0xa8, 0x46, 0x83, 0x8a, 0x68, 0xaa, 0x87, 0x04, 0x85, 0xca, 0xc0, 0x5e, 0x4c, 0xb1, 0xc7, 0x23, 0x73, 0x9d, 0xa2, 0x97, 0x68, 0x24, 0xb7, 0x3a, 0x29, 0xd4, 0x9d, 0xc2, 0xc7, 0xa8, 0x30, 0x08, 0x9c, 0xa4, 0x06, 0x65, 0xcf, 0x76, 0x40, 0xba, 0xe0, 0x0f, 0x3f, 0x33, 0x5a, 0x44, 0x39, 0x1a, 0xbf, 0x9c, 0x83, 0xd0, 0xe5, 0xb4, 0xb1, 0xea, 0x89, 0x0f, 0xad, 0x74, 0xb5, 0xee, 0xe6, 0x29, 0xa6, 0xcf, 0xdb, 0xdc, 0x26, 0x35, 0x63, 0x80, 0xc3, 0xd6, 0x3e, 0xa0, 0x48, 0x36, 0xe3,
+			0x97, 0xdd, 0x14, 0x3c, 0x06, 0xeb, 0xc9, 0xef, 0x65, 0x8b, 0x34, 0xf6, 0xe8, 0x0c, 0x76, 0x5b, 0x56, 0x7a, 0x3c, 0x06, 0x4f, 0x12, 0x96, 0xe7, 0xe3, 0x2d, 0x6a, 0x6e, 0xa7, 0x36, 0xcc, 0x8e, 0xfd, 0xe0, 0x69, 0xff, 0xda, 0xc4, 0xf9, 0xef, 0x33, 0x74, 0x96, 0x3a, 0x67, 0xb0, 0xc7, 0x4b, 0x52, 0x84, 0xcf, 0xf7, 0xd9, 0xe8, 0x21, 0xcc, 0x7c, 0xfa, 0x38, 0x97, 0x63, 0x66, 0xde, 0xf6, 0x62, 0xd1, 0x10, 0x6d, 0x46, 0xda, 0xb9, 0x8f, 0xd1, 0x6a, 0x7e, 0x0f, 0x8a, 0x2e, 0x3b, 0x9d, 0x36, 0x9f, 0x6b, 0x6a, 0xba, 0x95, 0x6b, 0xc1, 0xbf, 0x77, 0x20, 0x9d, 0xb3, 0xfb, 0xcc, 0xe9,
+			0xba, 0xf1, 0x6f, 0xa8, 0xa4, 0xd7, 0xf6, 0x0e, 0x6e, 0xf2, 0xd7, 0xe4, 0xd7, 0xf4, 0x4d, 0x41, 0xee, 0x3c, 0xed, 0xea, 0x73, 0xf7, 0xb8, 0x57, 0xfe, 0xde, 0xd9, 0xf6, 0x45, 0xf7, 0x4e, 0xec, 0xe4, 0x1e, 0xd8, 0x0e, 0x16, 0xfc, 0x6b, 0x37, 0x2d, 0x0f, 0x7b, 0x12, 0x80, 0x9f, 0xb4, 0x2b, 0x76, 0x4f, 0x20, 0xec, 0xb5, 0x6f, 0x74, 0x12, 0xba, 0x30, 0xc9, 0xae, 0xcd, 0xb7, 0x79, 0x81, 0x26, 0x33, 0xe3, 0xe5, 0xbd, 0xc0, 0xe7, 0x3b, 0x7c, 0x0e, 0x2f, 0x68, 0x02, 0x26, 0xb7, 0x3d, 0x6e, 0x3c, 0x61, 0x7d, 0x75, 0x6f, 0x81, 0x46, 0x1e, 0xc8, 0x28, 0x04, 0x4f, 0x3e, 0xd5, 0xe8,
+			0x50, 0xc1, 0xe6, 0x09, 0xac, 0x6f, 0xc8, 0x18, 0xe6, 0xf2, 0xb2, 0xe8, 0x63, 0xe2, 0x54, 0x2b, 0x96, 0x3a, 0xfb, 0xff, 0xdc, 0x59, 0xeb, 0x97, 0xff, 0xcf, 0x53, 0xe8, 0x3f, 0x9d, 0xe8, 0x54, 0xe1, 0x79, 0x05, 0x00, 0x00,
+		},
+	},
 	"_views/header.html": &BinaryFile{
 		Name:    "_views/header.html",
-		ModTime: 1576613209,
+		ModTime: 1792425395,
 		MD5: []byte{
 			0xd4, 0x1d, 0x8c, 0xd9, 0x8f, 0x00, 0xb2, 0x04, 0xe9, 0x80, 0x09, 0x98, 0xec, 0xf8, 0x42, 0x7e,
 		},
 		CompressedContents: []byte{
-			0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0x94, 0x55, 0x4d, 0x8f, 0xdb, 0x38, 0x0c, 0x3d, 0xdb, 0xbf, 0x82, 0xab, 0x5e, 0x5a, 0x20, 0xb6, 0x33, 0xe9, 0x4c, 0xd1, 0xf5, 0xd8, 0xc1, 0x02, 0xed, 0x62, 0x8f, 0x5d, 0xa0, 0xbd, 0xec, 0x51, 0x91, 0xe8, 0x98, 0x8d, 0x2c, 0x19, 0x92, 0x9c, 0x66, 0x26, 0xf0, 0x7f, 0x5f, 0x48, 0x76, 0x3e, 0x66, 0x52, 0xec, 0x07, 0x06, 0x18, 0xd3, 0xcf, 0x8f, 0xe4, 0x23, 0x25, 0x32, 0xc7, 0x23, 0x48, 0x6c, 0x48, 0x23, 0xb0, 0x16, 0xb9, 0x44, 0xcb, 0x60, 0x1c, 0xd3, 0xea, 0x97, 0xcf, 0x5f, 0x3e, 0x7d, 0xfb, 0xeb, 0xcf, 0xdf, 0xa1,
-			0xf5, 0x9d, 0x5a, 0xa7, 0x55, 0x78, 0x80, 0xe2, 0x7a, 0x5b, 0x33, 0xd4, 0x2c, 0x00, 0xc8, 0xe5, 0x3a, 0x4d, 0xaa, 0x0e, 0x3d, 0x07, 0xd1, 0x72, 0xeb, 0xd0, 0xd7, 0x6c, 0xf0, 0x4d, 0xf6, 0x91, 0x9d, 0x71, 0xcd, 0x3b, 0xac, 0xd9, 0x9e, 0xf0, 0x47, 0x6f, 0xac, 0x67, 0x20, 0x8c, 0xf6, 0xa8, 0x7d, 0xcd, 0x7e, 0x90, 0xf4, 0x6d, 0x2d, 0x71, 0x4f, 0x02, 0xb3, 0xf8, 0xb2, 0x00, 0xd2, 0xe4, 0x89, 0xab, 0xcc, 0x09, 0xae, 0xb0, 0xbe, 0x8b, 0x51, 0x14, 0xe9, 0x1d, 0x58, 0x54, 0x35, 0x73, 0xfe, 0x49, 0xa1, 0x6b, 0x11, 0x3d, 0x83, 0xd6, 0x62, 0x53, 0xb3, 0xc2, 0x79, 0xee, 0x49, 0x14,
-			0xc2, 0xb9, 0x62, 0xa0, 0x1d, 0xf9, 0xbc, 0x23, 0x9d, 0x0b, 0xe7, 0x18, 0x14, 0xc1, 0xd7, 0x09, 0x4b, 0xbd, 0x07, 0x67, 0xc5, 0x85, 0xfb, 0xfd, 0x9a, 0xfa, 0xdd, 0xb1, 0x75, 0x55, 0x4c, 0xb4, 0x7f, 0x73, 0xc8, 0x48, 0x18, 0xed, 0x7e, 0xee, 0x16, 0x94, 0xad, 0xd3, 0x64, 0x63, 0xe4, 0x13, 0x1c, 0xd3, 0x24, 0x69, 0x8c, 0xf6, 0x99, 0xa3, 0x67, 0x2c, 0xe1, 0xee, 0xbe, 0x3f, 0x3c, 0xa6, 0xc9, 0x98, 0x26, 0x6f, 0xbc, 0xe9, 0xb3, 0xd0, 0xb4, 0x48, 0x79, 0xce, 0x48, 0x4b, 0x3c, 0x94, 0xf0, 0xeb, 0x63, 0x9a, 0x24, 0xde, 0xf4, 0x25, 0x2c, 0x83, 0xa5, 0xb0, 0xf1, 0x65, 0xb4, 0x2c,
-			0x6d, 0xdb, 0xc9, 0x1c, 0xd3, 0x24, 0x1f, 0x76, 0x99, 0xe6, 0xfb, 0x0d, 0xb7, 0xe1, 0x01, 0x6b, 0x50, 0x04, 0x6b, 0xe0, 0x8b, 0x17, 0x5f, 0xc8, 0x63, 0xf7, 0x12, 0xf1, 0x66, 0xbb, 0x55, 0x18, 0x13, 0x76, 0xa4, 0xb3, 0x16, 0x63, 0x4c, 0x78, 0x58, 0x45, 0x51, 0x49, 0xcf, 0xa5, 0x24, 0xbd, 0x2d, 0x61, 0x09, 0x1f, 0x27, 0xe4, 0x4a, 0xf9, 0x32, 0xff, 0xf0, 0xc1, 0x62, 0x37, 0x8b, 0x9f, 0x0f, 0x6e, 0x0a, 0xc5, 0xed, 0x96, 0x74, 0x16, 0x45, 0xdf, 0x84, 0x7a, 0xbf, 0xec, 0x0f, 0xb0, 0x0c, 0x7f, 0x8f, 0x17, 0x6a, 0xac, 0x0a, 0x56, 0x0f, 0x13, 0x77, 0x06, 0xa7, 0x02, 0xcf, 0xa8,
-			0xb7, 0x5c, 0x3b, 0xf2, 0x64, 0x74, 0x09, 0x13, 0x03, 0x96, 0xf9, 0xca, 0x81, 0x18, 0x36, 0x24, 0xb2, 0x0d, 0x3e, 0x13, 0xda, 0xb7, 0xf9, 0xfd, 0x62, 0xb9, 0xc8, 0x57, 0x8b, 0xbb, 0x77, 0x97, 0xbe, 0x34, 0xc6, 0x76, 0x51, 0x97, 0x24, 0xd7, 0x2b, 0xfe, 0x54, 0x92, 0x56, 0xa4, 0x31, 0xdb, 0x28, 0x23, 0x76, 0x17, 0x9a, 0x32, 0x5b, 0xf3, 0xfa, 0x74, 0x56, 0xcb, 0x29, 0xb7, 0x30, 0xca, 0xd8, 0x12, 0xde, 0x34, 0x4d, 0x73, 0xe5, 0xc0, 0x37, 0xa8, 0x80, 0x47, 0x9f, 0x97, 0x84, 0xc4, 0xe3, 0xc1, 0x67, 0x51, 0x70, 0x48, 0x5e, 0x82, 0x36, 0x1a, 0x6f, 0x1c, 0xcb, 0xd6, 0xec, 0xd1,
-			0xfe, 0x7f, 0xf7, 0xd0, 0x6a, 0x4e, 0x1a, 0x2d, 0xb4, 0x77, 0x0b, 0x78, 0x85, 0xac, 0x6e, 0x90, 0xf7, 0x37, 0xc8, 0xfd, 0x0d, 0xf2, 0x70, 0x83, 0x7c, 0xb8, 0x39, 0xc9, 0xb9, 0x17, 0x63, 0x3a, 0xa9, 0xd8, 0x58, 0xe4, 0x52, 0xd8, 0xa1, 0xdb, 0x9c, 0x6f, 0x5b, 0x74, 0x89, 0xda, 0x25, 0x0a, 0x63, 0xf9, 0x74, 0x56, 0x83, 0x96, 0x68, 0x43, 0xc3, 0xa7, 0x0a, 0xaa, 0x62, 0x1e, 0x87, 0xaa, 0x98, 0x36, 0x44, 0x15, 0xc6, 0x62, 0xde, 0x17, 0x68, 0x81, 0x64, 0xcd, 0x4e, 0x93, 0xc0, 0x40, 0x28, 0xee, 0x5c, 0xcd, 0x86, 0x5d, 0xd6, 0x9b, 0xe9, 0xf0, 0xb3, 0x86, 0x0e, 0x28, 0xe3, 0xec,
-			0x4b, 0xda, 0x5f, 0x11, 0x2e, 0xe2, 0xaf, 0x5f, 0x32, 0x3c, 0xf4, 0x5c, 0x47, 0x7e, 0x52, 0x85, 0xd1, 0xb8, 0x38, 0x4c, 0x33, 0xc0, 0x40, 0x72, 0xcf, 0xb3, 0xf3, 0x7b, 0xcd, 0x3a, 0x23, 0xb1, 0x14, 0x8a, 0xc4, 0xee, 0x11, 0xe4, 0x70, 0x2a, 0x63, 0xf5, 0xb0, 0x64, 0x70, 0x66, 0x85, 0x70, 0xaf, 0x05, 0xcc, 0x33, 0x15, 0xee, 0x72, 0x4c, 0x97, 0x24, 0x95, 0xeb, 0xb9, 0xbe, 0x62, 0x84, 0x15, 0x11, 0x83, 0x04, 0xa3, 0x66, 0xe1, 0x7f, 0x09, 0xad, 0xe9, 0xf0, 0x11, 0x62, 0x9a, 0x12, 0xee, 0xf2, 0xfb, 0xb8, 0x3b, 0x7a, 0xae, 0xe7, 0x10, 0xfc, 0xb4, 0xcc, 0xd8, 0x6d, 0xaa, 0x30,
-			0xd0, 0x30, 0x5f, 0x5d, 0xb6, 0x3e, 0x1e, 0xe1, 0x6d, 0xfe, 0xc9, 0x1f, 0xf2, 0xaf, 0x9e, 0x7b, 0xcc, 0xff, 0x40, 0x0f, 0xec, 0x93, 0xd1, 0x0d, 0x6d, 0xd9, 0xbb, 0xfc, 0x1b, 0x79, 0x85, 0x5f, 0xec, 0x67, 0x6c, 0xf8, 0xa0, 0x3c, 0x8c, 0x63, 0x55, 0xf0, 0xa9, 0x88, 0x42, 0xd2, 0xfe, 0x1f, 0xca, 0x11, 0xa8, 0x3d, 0x5a, 0xb6, 0x4e, 0xff, 0x0b, 0x39, 0x8e, 0xec, 0xa9, 0xf8, 0x9f, 0x32, 0x4e, 0x92, 0xf7, 0xe4, 0x68, 0xa3, 0xf0, 0x37, 0x37, 0xb3, 0x93, 0x2a, 0x8e, 0x29, 0x17, 0xa1, 0xdb, 0x61, 0xbb, 0x22, 0xb7, 0xa2, 0x3d, 0x7d, 0x4c, 0x2a, 0xd2, 0xfd, 0xe0, 0xe7, 0x9f, 0x0b,
-			0x87, 0x0a, 0x85, 0x37, 0xf6, 0xba, 0x23, 0xf1, 0x3b, 0x03, 0xff, 0xd4, 0x47, 0x42, 0x74, 0x86, 0x5e, 0x71, 0x81, 0xad, 0x51, 0x12, 0x6d, 0xcd, 0xbe, 0xce, 0xe0, 0x9e, 0xab, 0x01, 0x6b, 0x76, 0x3c, 0xc2, 0xeb, 0x66, 0x5d, 0xe2, 0x8e, 0xe3, 0x59, 0x56, 0x11, 0x74, 0xcd, 0x15, 0x5d, 0xaa, 0x3f, 0x59, 0x55, 0xa1, 0x79, 0x30, 0x66, 0x60, 0xba, 0xd3, 0x68, 0xd7, 0xe9, 0xf1, 0x08, 0xa8, 0x25, 0x8c, 0xe3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x2c, 0xe9, 0xbe, 0x3f, 0x07, 0x00, 0x00,
+			0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x95, 0x56, 0x4b, 0x6f, 0xdb, 0x38, 0x10, 0x3e, 0xdb, 0xbf, 0x62, 0x96, 0x3d, 0x6c, 0x02, 0x58, 0x96, 0xe3, 0x26, 0x41, 0xd7, 0x91, 0x8c, 0x05, 0xda, 0x6d, 0x81, 0xbd, 0xa4, 0x40, 0x73, 0xd9, 0x23, 0x2d, 0x8d, 0x2c, 0x26, 0x34, 0x29, 0x90, 0x94, 0xe3, 0x24, 0xf0, 0x7f, 0xdf, 0x21, 0x29, 0xcb, 0xaf, 0x76, 0x83, 0xbd, 0x58, 0xd4, 0xf0, 0x9b, 0x99, 0x6f, 0x9e, 0xf2, 0xdb, 0x1b, 0x94, 0x58, 0x09, 0x85, 0xc0, 0x6a, 0xe4, 0x25, 0x1a, 0x06, 0xdb, 0xed, 0x30, 0xfb, 0xed, 0xcb, 0xfd, 0xe7, 0x87, 0x7f, 0xbe, 0xff, 0x05,
+			0xb5, 0x5b, 0xc9, 0xf9, 0x30, 0xf3, 0x0f, 0x90, 0x5c, 0x2d, 0x73, 0x86, 0x8a, 0x79, 0x01, 0x81, 0xe7, 0xc3, 0x41, 0xb6, 0x42, 0xc7, 0xa1, 0xa8, 0xb9, 0xb1, 0xe8, 0x72, 0xd6, 0xba, 0x2a, 0xf9, 0xc4, 0x7a, 0xb9, 0xe2, 0x2b, 0xcc, 0xd9, 0x5a, 0xe0, 0x73, 0xa3, 0x8d, 0x63, 0x50, 0x68, 0xe5, 0x50, 0x11, 0xee, 0x59, 0x94, 0xae, 0xce, 0x4b, 0x5c, 0x8b, 0x02, 0x93, 0xf0, 0x32, 0x02, 0xa1, 0x84, 0x13, 0x5c, 0x26, 0xb6, 0xe0, 0x12, 0xf3, 0xab, 0x60, 0x45, 0x0a, 0xf5, 0x04, 0x06, 0x65, 0xce, 0xac, 0x7b, 0x91, 0x68, 0x6b, 0x44, 0x32, 0x53, 0x1b, 0xac, 0x72, 0x96, 0x5a, 0xc7, 0x9d,
+			0x28, 0xd2, 0xc2, 0xda, 0xb4, 0x15, 0x4f, 0xc2, 0x8d, 0x57, 0x42, 0x8d, 0xe9, 0x8d, 0x41, 0xea, 0x75, 0x6d, 0x61, 0x44, 0xe3, 0xc0, 0x9a, 0x62, 0x8f, 0x7d, 0x3c, 0x84, 0x3e, 0x5a, 0x36, 0xcf, 0xd2, 0x08, 0x7b, 0x4f, 0x21, 0x11, 0xc4, 0xdd, 0xfe, 0x5c, 0xcd, 0x33, 0xa3, 0xc3, 0x42, 0x97, 0x2f, 0xf0, 0x36, 0x1c, 0x0c, 0x2a, 0x8a, 0x32, 0xb1, 0xe2, 0x15, 0x67, 0x70, 0x75, 0xdd, 0x6c, 0xee, 0x86, 0x83, 0xed, 0x70, 0xf0, 0xc1, 0xe9, 0x26, 0xf1, 0x49, 0x0b, 0x90, 0xd7, 0x44, 0xa8, 0x12, 0x37, 0x33, 0xf8, 0x83, 0x6e, 0x07, 0x74, 0x35, 0x83, 0x89, 0x3f, 0x49, 0xac, 0xdc, 0x2c,
+			0x9c, 0x8c, 0x58, 0xd6, 0xf1, 0x48, 0xca, 0xe3, 0xf6, 0x29, 0x51, 0x7c, 0xbd, 0xe0, 0xc6, 0x3f, 0x60, 0x0e, 0x52, 0xd0, 0x0f, 0x1f, 0x1d, 0xdd, 0x08, 0x87, 0xab, 0x63, 0x89, 0xd3, 0xcb, 0xa5, 0xc4, 0xe0, 0x90, 0x88, 0x93, 0xf7, 0x60, 0x13, 0x6e, 0xa6, 0x81, 0xd4, 0xa0, 0xe1, 0x65, 0x29, 0xd4, 0x92, 0x5c, 0xc3, 0xa7, 0x28, 0x39, 0x60, 0x3e, 0x19, 0xdf, 0xde, 0x1a, 0x5c, 0x75, 0xe4, 0xbb, 0xc2, 0x45, 0x53, 0xdc, 0x2c, 0xc9, 0x5a, 0x20, 0x7d, 0x66, 0xea, 0xe3, 0xa4, 0xd9, 0x90, 0xbd, 0x49, 0x0c, 0xa7, 0x83, 0x86, 0xa8, 0x60, 0x7a, 0x13, 0xb1, 0x9d, 0x30, 0x06, 0xd8, 0x4b,
+			0x9d, 0xe1, 0xca, 0x52, 0x03, 0x68, 0x35, 0x83, 0x88, 0x20, 0x0a, 0x53, 0x0b, 0x45, 0xbb, 0x10, 0x45, 0xb2, 0xc0, 0x57, 0x81, 0xe6, 0x62, 0x7c, 0x3d, 0x9a, 0x8c, 0xc6, 0xd3, 0xd1, 0xd5, 0xe5, 0x3e, 0x2f, 0x95, 0x36, 0xab, 0xc0, 0xab, 0x14, 0xb6, 0x91, 0xfc, 0x65, 0x26, 0x14, 0x75, 0x0d, 0x26, 0x0b, 0xa9, 0x8b, 0xa7, 0x3d, 0x4c, 0xea, 0xa5, 0x3e, 0xad, 0xce, 0x74, 0x12, 0x7d, 0x17, 0x5a, 0x6a, 0x33, 0x83, 0x0f, 0x55, 0x55, 0x1d, 0x28, 0xf0, 0x05, 0x4a, 0xe0, 0x41, 0xe7, 0x18, 0x30, 0x70, 0xb8, 0x71, 0x49, 0x20, 0xec, 0x9d, 0xcf, 0x40, 0x69, 0x85, 0x67, 0x8a, 0xb3, 0x5a,
+			0xaf, 0xd1, 0xfc, 0x7f, 0x75, 0x9f, 0x6a, 0x4e, 0x01, 0x18, 0xa8, 0xaf, 0x46, 0x70, 0x22, 0x99, 0x9e, 0x49, 0x3e, 0x9e, 0x49, 0xae, 0xcf, 0x24, 0x37, 0x67, 0x92, 0xdb, 0xb3, 0x4a, 0x76, 0xb9, 0xd8, 0x0e, 0x23, 0x8b, 0x85, 0xa1, 0x56, 0x2d, 0x4c, 0xbb, 0x5a, 0xf4, 0xdd, 0x16, 0x54, 0x02, 0xf7, 0x12, 0x0b, 0x6d, 0x78, 0xac, 0x55, 0x4b, 0x7d, 0x6c, 0x7c, 0xc2, 0x63, 0x04, 0x34, 0x18, 0x71, 0x1c, 0xb2, 0x34, 0x6e, 0x88, 0xcc, 0x8f, 0x45, 0xb7, 0x2f, 0xc8, 0xb3, 0x28, 0x73, 0xb6, 0x9b, 0x04, 0xda, 0x07, 0x92, 0x5b, 0x4b, 0x5b, 0xe3, 0x29, 0x69, 0x74, 0x2c, 0x7e, 0x52, 0x89,
+			0x0d, 0x96, 0x61, 0xf6, 0x4b, 0xb1, 0x3e, 0x00, 0xec, 0xc9, 0x1f, 0xbe, 0x24, 0xb8, 0x69, 0xb8, 0x0a, 0xf8, 0x41, 0xe6, 0x47, 0x63, 0xaf, 0x10, 0x67, 0x80, 0x41, 0xc9, 0x1d, 0x4f, 0xfa, 0xf7, 0x9c, 0xad, 0x74, 0x89, 0xb3, 0x42, 0x0a, 0x6a, 0x0e, 0x28, 0xdb, 0x5d, 0x18, 0xd3, 0x9b, 0x09, 0x83, 0x1e, 0xe5, 0xcd, 0x9d, 0x12, 0xe8, 0x66, 0xca, 0xf7, 0x72, 0x70, 0x47, 0x00, 0x4b, 0xbe, 0x0f, 0x10, 0x7e, 0x45, 0x04, 0x23, 0xfe, 0x90, 0x33, 0xff, 0x3b, 0x83, 0x5a, 0xaf, 0xf0, 0x0e, 0x82, 0x1b, 0x5a, 0x07, 0xe3, 0xeb, 0xb0, 0x3b, 0x48, 0xaf, 0x33, 0xc1, 0x77, 0xcb, 0x8c, 0x9d,
+			0xbb, 0xf2, 0x03, 0x0d, 0x5d, 0xeb, 0xb2, 0xf9, 0xdb, 0x1b, 0x5c, 0x8c, 0x3f, 0xbb, 0xcd, 0xf8, 0x07, 0x6d, 0x26, 0x1c, 0x7f, 0x43, 0x07, 0xec, 0xb3, 0x56, 0x95, 0x58, 0xb2, 0xcb, 0xf1, 0x83, 0x70, 0x12, 0xef, 0xcd, 0x17, 0xac, 0x78, 0x2b, 0x1d, 0x6d, 0xf0, 0x2c, 0xe5, 0x31, 0x88, 0x94, 0xa2, 0xf8, 0x8f, 0x70, 0x0a, 0x9a, 0x6a, 0xda, 0xf9, 0x91, 0x0d, 0xb9, 0x10, 0x15, 0x9c, 0x3a, 0xf1, 0x9d, 0x70, 0xaf, 0xe4, 0x4b, 0xf8, 0x30, 0x0c, 0x7e, 0x69, 0xc9, 0xb3, 0xed, 0xec, 0x9c, 0x65, 0x26, 0x8e, 0xc4, 0xee, 0x90, 0x3c, 0x73, 0xa3, 0x68, 0x5b, 0x84, 0x5c, 0x39, 0xad, 0xa5,
+			0x13, 0x4d, 0xce, 0x1e, 0x6a, 0x61, 0x69, 0xd5, 0x37, 0x54, 0x19, 0x0e, 0xc2, 0xaa, 0xdf, 0x1d, 0xb8, 0x1a, 0x41, 0x86, 0xbe, 0xb9, 0x83, 0x47, 0xbd, 0xb0, 0xc0, 0x0d, 0x82, 0x69, 0x15, 0x50, 0xc9, 0xfd, 0x37, 0x47, 0x2d, 0xb1, 0x04, 0xad, 0x0e, 0x70, 0x6c, 0xfe, 0x55, 0x4b, 0xa9, 0x9f, 0xa9, 0x4d, 0x2e, 0x3c, 0xef, 0x44, 0x13, 0xf1, 0xcb, 0xa3, 0x8c, 0xf7, 0x09, 0xf1, 0xe1, 0x22, 0x19, 0x8a, 0x51, 0xbd, 0x97, 0xa8, 0xb0, 0xae, 0x76, 0x85, 0x6f, 0xe5, 0x39, 0x80, 0x1e, 0x7d, 0xf8, 0x52, 0xcc, 0xf7, 0x95, 0x6d, 0x44, 0x83, 0x7e, 0x40, 0xec, 0x71, 0xbc, 0xdf, 0x7b, 0xf1,
+			0x3c, 0xa6, 0xab, 0x6f, 0x1c, 0x57, 0xd3, 0xd8, 0x29, 0x2e, 0xa4, 0xed, 0x9b, 0xc5, 0x97, 0x33, 0x4b, 0xc9, 0xec, 0xcf, 0x1c, 0x2c, 0x0d, 0x6f, 0xea, 0x63, 0xe3, 0x7f, 0xeb, 0x05, 0x7c, 0x0b, 0xe2, 0x53, 0xe3, 0x4b, 0xfa, 0x96, 0xd1, 0xda, 0x79, 0xfa, 0x85, 0xe9, 0x2c, 0x6d, 0xe5, 0xfc, 0x9d, 0x2a, 0x7b, 0x63, 0x6b, 0x61, 0xc5, 0x42, 0xe2, 0x9f, 0xb6, 0x8f, 0x39, 0xec, 0x61, 0x5e, 0xf8, 0x71, 0xf2, 0x9f, 0x4f, 0xe4, 0xa6, 0xa8, 0x77, 0x97, 0x83, 0x4c, 0xa8, 0xa6, 0x75, 0xdd, 0xff, 0x01, 0x8b, 0x12, 0x0b, 0xa7, 0xcd, 0x61, 0xcb, 0x87, 0x7b, 0x06, 0xee, 0xa5, 0x09, 0x80,
+			0xa0, 0x0c, 0xb4, 0xce, 0x0b, 0xac, 0xb5, 0xa4, 0xc2, 0xe6, 0xec, 0x47, 0x27, 0x5c, 0x73, 0xd9, 0x12, 0x86, 0x8a, 0x77, 0xda, 0xa8, 0x7b, 0xbb, 0xdb, 0x6d, 0x4f, 0x2b, 0xf5, 0xbc, 0x4e, 0x4b, 0xdf, 0x9f, 0xb2, 0x94, 0xe2, 0xf2, 0xcb, 0x26, 0x0a, 0xe2, 0xd2, 0x42, 0x9a, 0xff, 0xbe, 0x37, 0xfe, 0x05, 0xe9, 0x25, 0x27, 0x35, 0x20, 0x09, 0x00, 0x00,
 		},
 	},
 	"_views/index.html": &BinaryFile{
 		Name:    "_views/index.html",
-		ModTime: 1580150842,
+		ModTime: 1792426031,
 		MD5: []byte{
 			0xd4, 0x1d, 0x8c, 0xd9, 0x8f, 0x00, 0xb2, 0x04, 0xe9, 0x80, 0x09, 0x98, 0xec, 0xf8, 0x42, 0x7e,
 		},
 		CompressedContents: []byte{
-			0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0x84, 0x92, 0x31, 0xeb, 0xdb, 0x30, 0x10, 0xc5, 0x67, 0xfb, 0x53, 0x1c, 0x22, 0x43, 0x0b, 0x8d, 0x45, 0xd6, 0x22, 0x8b, 0xce, 0x85, 0x76, 0x6c, 0xc7, 0x70, 0xf6, 0x5d, 0x62, 0xa5, 0xb2, 0x64, 0x24, 0x39, 0x09, 0x18, 0x7f, 0xf7, 0x62, 0xd9, 0xa6, 0xc9, 0xd2, 0xff, 0x24, 0xee, 0xde, 0x7b, 0x3f, 0x73, 0x0f, 0x4f, 0x13, 0x10, 0x5f, 0x8c, 0x63, 0x10, 0xc6, 0x11, 0x3f, 0x05, 0xcc, 0x73, 0x39, 0x4d, 0x90, 0xb8, 0x1f, 0x2c, 0x26, 0x06, 0xd1, 0x31, 0x12, 0x07, 0x01, 0xd5, 0xa2, 0x28, 0x32, 0x77, 0x30, 0x54, 0x8b, 0xd6,
-			0xbb, 0xc4, 0x2e, 0x09, 0x68, 0x2d, 0xc6, 0x58, 0x8b, 0xf1, 0xcf, 0x71, 0x59, 0xa1, 0x71, 0x1c, 0xe0, 0x75, 0x38, 0xf2, 0x73, 0x40, 0x47, 0x42, 0x97, 0x45, 0x0e, 0xbf, 0xf8, 0x3b, 0x63, 0xe9, 0xf8, 0x30, 0x94, 0xba, 0xcd, 0xf4, 0x2d, 0x8a, 0x25, 0x7b, 0x0d, 0x86, 0x74, 0x59, 0x64, 0xff, 0xf2, 0x16, 0x6a, 0xb4, 0x2f, 0xb9, 0x26, 0x30, 0x52, 0x1b, 0xc6, 0xbe, 0x11, 0x59, 0x2d, 0x94, 0x35, 0x5a, 0x21, 0x74, 0x81, 0x2f, 0xb5, 0x90, 0x42, 0x7f, 0xf7, 0x4d, 0x54, 0x12, 0xb5, 0x92, 0xd6, 0xac, 0x79, 0x39, 0xda, 0x0c, 0x94, 0x2b, 0x71, 0x7f, 0xdf, 0xee, 0x1c, 0x30, 0x24, 0x83,
-			0x36, 0xca, 0x9b, 0x6f, 0xce, 0x09, 0x1b, 0xcb, 0xe7, 0xfd, 0xf4, 0x79, 0x2e, 0x8b, 0xc5, 0x7c, 0xb8, 0xf7, 0xf0, 0xb5, 0x5e, 0x9b, 0xc8, 0x8b, 0x80, 0xee, 0xca, 0x70, 0xc8, 0xcd, 0x7d, 0x81, 0xc3, 0xcd, 0x37, 0x59, 0xff, 0x65, 0xf8, 0xf1, 0xc3, 0x13, 0xdb, 0xd5, 0xf8, 0x9f, 0xef, 0x04, 0xff, 0x10, 0xf0, 0x69, 0x01, 0x57, 0xbf, 0x03, 0x0e, 0x2b, 0xe2, 0xf3, 0xbf, 0x18, 0xdb, 0xc8, 0xdb, 0xa4, 0x52, 0xd0, 0x2a, 0x11, 0xb4, 0xde, 0xc6, 0x01, 0x5d, 0x7d, 0x3a, 0xe9, 0x9f, 0x1e, 0x6e, 0xbe, 0x89, 0x60, 0x3d, 0x12, 0x13, 0xf8, 0x00, 0x3d, 0xa6, 0xb6, 0x63, 0x82, 0xd4, 0x31,
-			0x44, 0xc6, 0xd0, 0x76, 0x10, 0xd9, 0x72, 0x9b, 0x7c, 0xa8, 0x94, 0x4c, 0xa4, 0x95, 0x4c, 0x41, 0xef, 0x70, 0x47, 0x99, 0xfd, 0x51, 0x0d, 0x17, 0xef, 0xd3, 0x56, 0xc3, 0xd6, 0xdc, 0x5b, 0x62, 0x97, 0xab, 0xed, 0xd7, 0xd9, 0xb0, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xaf, 0xc1, 0xa5, 0x42, 0x5a, 0x02, 0x00, 0x00,
+			0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x85, 0x52, 0xc1, 0x4e, 0xc3, 0x30, 0x0c, 0x3d, 0x6f, 0x5f, 0x61, 0x45, 0x3b, 0x80, 0xc4, 0x5a, 0xed, 0x8a, 0xd2, 0x0a, 0x89, 0x03, 0x12, 0x12, 0x5c, 0x90, 0xe0, 0x38, 0xa5, 0x8d, 0xb7, 0x04, 0xd2, 0x64, 0x24, 0xee, 0x3a, 0xa9, 0xe2, 0xdf, 0x49, 0xd3, 0x56, 0x6c, 0x07, 0xe0, 0xe4, 0xc4, 0x7e, 0xef, 0xd9, 0x7e, 0x49, 0xdf, 0x83, 0xc4, 0x9d, 0xb6, 0x08, 0x4c, 0x5b, 0x89, 0x27, 0x06, 0x5f, 0x5f, 0xcb, 0xbe, 0x07, 0xc2, 0xe6, 0x60, 0x04, 0xc5, 0xb4, 0x42, 0x21, 0xd1, 0x33, 0xc8, 0x86, 0x0a, 0x97, 0xfa, 0x08, 0x5a,
+			0x16, 0xac, 0x76, 0x96, 0xd0, 0x12, 0x83, 0xda, 0x88, 0x10, 0x0a, 0xd6, 0x7e, 0xac, 0x87, 0x94, 0x88, 0x4a, 0x1e, 0xce, 0x2f, 0x6b, 0x3c, 0x1d, 0x84, 0x95, 0xac, 0x5c, 0x2e, 0x12, 0xf9, 0x0c, 0xaf, 0xb4, 0x91, 0xeb, 0x4e, 0x4b, 0x52, 0x13, 0xe8, 0x2e, 0xb0, 0x81, 0xbb, 0xf7, 0x5a, 0x46, 0x78, 0xc2, 0x0f, 0x71, 0xc1, 0x5b, 0x73, 0xc6, 0xab, 0x7c, 0x9c, 0xa8, 0xf6, 0x6d, 0x53, 0xb1, 0x54, 0x5d, 0x70, 0xa3, 0x4b, 0x2e, 0x40, 0x79, 0xdc, 0x15, 0x2c, 0x67, 0xe5, 0xa3, 0xab, 0x02, 0xcf, 0x45, 0xc9, 0xf3, 0x58, 0x48, 0xfc, 0xbc, 0x35, 0x49, 0x30, 0x1f, 0x15, 0xe7, 0x78, 0xb1,
+			0xe7, 0x41, 0x78, 0xd2, 0xc2, 0x84, 0xfc, 0xdd, 0x55, 0x5b, 0x12, 0x95, 0xc1, 0xed, 0xbc, 0x7a, 0x5c, 0x7c, 0x31, 0x80, 0x57, 0xc7, 0x06, 0x6e, 0x8b, 0xd1, 0x89, 0x94, 0xf0, 0xc2, 0xee, 0x11, 0x56, 0xc9, 0xb9, 0x1b, 0x58, 0x45, 0x66, 0xaa, 0xbf, 0x6a, 0xec, 0x9e, 0x9c, 0x44, 0x33, 0x02, 0xff, 0xe8, 0xe3, 0x5d, 0xc7, 0xe0, 0x6a, 0x10, 0xce, 0xde, 0xbc, 0x38, 0x8c, 0x12, 0xd7, 0x3f, 0x34, 0x34, 0x01, 0xa7, 0x1b, 0x27, 0x5f, 0x72, 0x92, 0x50, 0x3b, 0x13, 0xa2, 0x59, 0xc5, 0x66, 0x53, 0x3e, 0x3b, 0x88, 0xf8, 0x00, 0xc6, 0xc5, 0x39, 0x25, 0x38, 0x0f, 0x8d, 0xa0, 0x5a, 0xc5,
+			0x23, 0x29, 0x84, 0x80, 0xc2, 0xd7, 0x2a, 0x06, 0x83, 0x35, 0x39, 0x9f, 0xf1, 0x9c, 0x64, 0xf4, 0x24, 0xca, 0xcc, 0xe2, 0x56, 0x26, 0xed, 0xff, 0x6c, 0xd8, 0x39, 0x47, 0xb3, 0x0d, 0x11, 0xdb, 0x69, 0x52, 0x90, 0xdd, 0xd3, 0x29, 0x7b, 0xa1, 0x48, 0xc9, 0x1e, 0x90, 0x80, 0x59, 0x47, 0x7a, 0xa7, 0x6b, 0x41, 0xda, 0xd9, 0xb0, 0xfe, 0x6c, 0xb1, 0xc5, 0x30, 0x32, 0xb8, 0xf2, 0xf9, 0xaf, 0x5e, 0x5f, 0xd0, 0xb6, 0x33, 0x2d, 0x9b, 0x5b, 0x4d, 0x13, 0x4e, 0xef, 0x75, 0x21, 0x31, 0x0f, 0x95, 0x4d, 0x1f, 0x76, 0x82, 0x7e, 0x03, 0x61, 0xf1, 0xab, 0xa6, 0xd0, 0x02, 0x00, 0x00,
 		},
 	},
 	"_views/invocation.html": &BinaryFile{
 		Name:    "_views/invocation.html",
-		ModTime: 1580026038,
+		ModTime: 1792427160,
 		MD5: []byte{
 			0xd4, 0x1d, 0x8c, 0xd9, 0x8f, 0x00, 0xb2, 0x04, 0xe9, 0x80, 0x09, 0x98, 0xec, 0xf8, 0x42, 0x7e,
 		},
 		CompressedContents: []byte{
-			0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0xcc, 0x58, 0x6d, 0x6f, 0xdb, 0x36, 0x10, 0xfe, 0xec, 0xfe, 0x0a, 0x82, 0x5f, 0xea, 0x60, 0xb5, 0xb4, 0xac, 0xfd, 0xb2, 0xd6, 0xf2, 0x5e, 0xda, 0x0c, 0x48, 0xd0, 0xb5, 0x03, 0x3a, 0x6c, 0xc0, 0x96, 0xa1, 0xa0, 0xc9, 0x93, 0xc5, 0x9a, 0x22, 0x55, 0x92, 0x8a, 0x13, 0x28, 0xfe, 0xef, 0x03, 0x49, 0xbd, 0x47, 0x76, 0xd2, 0xa2, 0x7b, 0xf9, 0x12, 0x46, 0xe2, 0x1d, 0xef, 0x79, 0x9e, 0xbb, 0x23, 0x29, 0x57, 0x15, 0x62, 0x90, 0x72, 0x09, 0x08, 0x73, 0x79, 0xa5, 0x28, 0xb1, 0x5c, 0x49, 0x8c, 0xf6, 0xfb, 0x47, 0x55, 0x85,
-			0x2c, 0xe4, 0x85, 0x20, 0x16, 0x10, 0xce, 0x80, 0x30, 0xd0, 0x18, 0x45, 0x6e, 0x66, 0xc9, 0xf8, 0x15, 0xe2, 0x2c, 0xc1, 0x54, 0x49, 0x0b, 0xd2, 0x62, 0x44, 0x05, 0x31, 0x26, 0xc1, 0xe5, 0x76, 0xe1, 0x5e, 0x11, 0x2e, 0x41, 0xa3, 0xfe, 0xc3, 0x02, 0xae, 0x0b, 0x22, 0x19, 0x5e, 0x3d, 0x9a, 0x79, 0xe7, 0x9e, 0x7d, 0xc6, 0x05, 0x5b, 0xec, 0x38, 0xb3, 0x59, 0x6d, 0xf4, 0xbd, 0xc1, 0xce, 0x77, 0xa3, 0x39, 0x5b, 0x3d, 0x9a, 0x79, 0x7b, 0x37, 0xce, 0x96, 0xa5, 0xe8, 0xf9, 0xad, 0x35, 0x10, 0x46, 0x75, 0x99, 0xaf, 0xb1, 0x9f, 0x9d, 0x2d, 0x05, 0x5f, 0x2d, 0x09, 0xca, 0x34, 0xa4,
-			0x09, 0x8e, 0xf1, 0xea, 0x42, 0xad, 0xcd, 0x32, 0x26, 0xab, 0x65, 0x2c, 0xf8, 0x94, 0xc5, 0x07, 0xb5, 0x8e, 0xab, 0x0a, 0x45, 0xbf, 0x71, 0xd8, 0xfd, 0xac, 0x18, 0x88, 0xe8, 0x42, 0xad, 0xdf, 0x90, 0x1c, 0xd0, 0x2d, 0x2a, 0xb5, 0x00, 0x49, 0x15, 0x03, 0xb4, 0xdf, 0xe3, 0xd5, 0xb4, 0xd5, 0x7e, 0x3f, 0xb1, 0xba, 0x29, 0x88, 0x1c, 0xd9, 0x9f, 0xbf, 0xf2, 0xa6, 0x7e, 0xa6, 0xb5, 0x5e, 0xc6, 0xa5, 0xf0, 0xe4, 0xe2, 0x9a, 0xdd, 0x48, 0x95, 0x54, 0xc0, 0xf5, 0x42, 0xf3, 0x4d, 0x66, 0x9d, 0x14, 0x16, 0xae, 0x6d, 0x78, 0x0a, 0x5c, 0x97, 0xa4, 0x2f, 0x44, 0x69, 0xad, 0xcb, 0x58,
-			0x4d, 0x8b, 0x14, 0xdc, 0x51, 0x8b, 0x54, 0x69, 0x8b, 0xd2, 0x3e, 0x88, 0x61, 0x3c, 0x01, 0xd8, 0xa7, 0x80, 0x53, 0x25, 0x13, 0xcc, 0xd4, 0x4e, 0x0a, 0x45, 0x98, 0x7f, 0x65, 0x95, 0x12, 0x96, 0x17, 0x09, 0x7e, 0x55, 0xbf, 0x45, 0x17, 0x6a, 0x8d, 0xde, 0xfa, 0x60, 0x78, 0xe5, 0x14, 0xe9, 0xb1, 0x6a, 0xc7, 0x21, 0x39, 0x97, 0xd9, 0x26, 0xc3, 0x8b, 0x9c, 0x58, 0x9a, 0xb5, 0x4f, 0x8c, 0x5f, 0x71, 0x16, 0x6a, 0x27, 0xcc, 0x02, 0xe3, 0x65, 0x8e, 0x46, 0x75, 0x72, 0xba, 0x78, 0x86, 0xa7, 0x44, 0xe3, 0xda, 0xd8, 0x60, 0x58, 0xeb, 0x34, 0x9c, 0xf7, 0x32, 0x9a, 0x9c, 0x08, 0x81,
-			0x43, 0xa6, 0x7a, 0x73, 0x8e, 0x6a, 0x2b, 0x75, 0xa1, 0x79, 0x4e, 0xf4, 0x8d, 0x7b, 0xce, 0x89, 0xde, 0x70, 0x19, 0xbc, 0xea, 0x14, 0x74, 0xca, 0x70, 0x99, 0x2a, 0x47, 0xda, 0xe7, 0xf6, 0x9d, 0x25, 0x16, 0xda, 0x7c, 0xce, 0x96, 0xd9, 0xa9, 0x1f, 0xab, 0x0a, 0xf1, 0xb4, 0x2f, 0xaf, 0xb3, 0x2b, 0x0d, 0xba, 0x45, 0xf0, 0x11, 0x61, 0x5d, 0x4a, 0xc9, 0xe5, 0xc6, 0xf7, 0x5b, 0x03, 0xb8, 0xaf, 0xb2, 0x13, 0x97, 0x1b, 0x44, 0x4b, 0xad, 0x41, 0x5a, 0x71, 0x83, 0x5a, 0x87, 0x72, 0xbb, 0x30, 0x05, 0x97, 0x12, 0x74, 0x82, 0xb5, 0x6b, 0xda, 0xe7, 0xe8, 0x34, 0xfa, 0x7a, 0x98, 0xa3,
-			0xf3, 0xb6, 0xa1, 0xdd, 0x22, 0x8d, 0xeb, 0xaa, 0x03, 0x59, 0x55, 0x08, 0x84, 0x81, 0x69, 0x84, 0x35, 0x44, 0x4a, 0x24, 0x05, 0x21, 0x80, 0xb5, 0x20, 0x47, 0xd2, 0x79, 0xc9, 0x76, 0x44, 0xb7, 0xb8, 0x6a, 0x71, 0xa8, 0x92, 0xcf, 0xeb, 0xd7, 0x2f, 0x50, 0x80, 0xf8, 0xcd, 0x41, 0x7c, 0x3b, 0x62, 0x50, 0x17, 0xa9, 0xd1, 0x74, 0x88, 0xf1, 0x20, 0x44, 0xd0, 0x5a, 0xe9, 0x7b, 0x00, 0x32, 0x22, 0x37, 0x6e, 0x03, 0xfb, 0x4c, 0x7c, 0x29, 0xe1, 0x87, 0x81, 0x1d, 0x16, 0xcf, 0x94, 0x94, 0x82, 0x31, 0x47, 0x91, 0xb5, 0x36, 0x43, 0x68, 0x34, 0x03, 0xba, 0xbd, 0x1f, 0x18, 0x55, 0x79,
-			0x21, 0xc0, 0xc2, 0x24, 0xb4, 0x23, 0x51, 0xeb, 0x1a, 0x1f, 0x47, 0xfd, 0x58, 0x82, 0x71, 0xeb, 0xde, 0x1f, 0xd8, 0x04, 0xa2, 0xa5, 0xdc, 0x4a, 0xb5, 0x93, 0x77, 0xc2, 0x4b, 0xd6, 0x44, 0x8f, 0x43, 0x27, 0x0c, 0xb6, 0xba, 0x7f, 0xa5, 0x3d, 0x33, 0x6e, 0xac, 0xd2, 0x37, 0xfd, 0x0e, 0xd5, 0x16, 0x58, 0xbf, 0x47, 0x9f, 0x8d, 0x36, 0xeb, 0xda, 0x04, 0xdd, 0x22, 0x9d, 0xd2, 0xa7, 0x4f, 0x9f, 0x7e, 0xeb, 0xf7, 0xee, 0xec, 0xd9, 0xff, 0x83, 0xc0, 0x4f, 0x5c, 0x72, 0x93, 0x4d, 0x30, 0x18, 0x96, 0xe0, 0xcb, 0xba, 0x24, 0xa2, 0x73, 0xf3, 0x07, 0x68, 0x85, 0xf6, 0xfb, 0x45, 0x57,
-			0x10, 0x43, 0xbe, 0x8d, 0xe9, 0x80, 0x70, 0x9b, 0xbe, 0xff, 0x8e, 0x39, 0x15, 0x8a, 0x6e, 0x5b, 0xde, 0x67, 0x82, 0x14, 0x66, 0x48, 0xfb, 0xd4, 0xdf, 0x3f, 0x20, 0x4c, 0xe0, 0x43, 0xc5, 0x3d, 0xbd, 0x03, 0xdf, 0xd5, 0xe7, 0x50, 0x11, 0x18, 0x2e, 0x29, 0xbc, 0x2f, 0x2d, 0xad, 0x55, 0x99, 0x92, 0xb0, 0x06, 0x37, 0xd4, 0xad, 0x05, 0x7a, 0xa7, 0xf8, 0x33, 0x1d, 0xbb, 0xf1, 0x0e, 0xa8, 0x5f, 0x88, 0x26, 0x39, 0x58, 0xd0, 0x26, 0xf4, 0xcd, 0x97, 0x3f, 0x33, 0x4f, 0xbb, 0x43, 0xb1, 0xbe, 0xb1, 0x4c, 0xee, 0x46, 0x21, 0x8b, 0xde, 0x60, 0xd6, 0x81, 0x0a, 0x0e, 0x5d, 0x8b, 0xcf,
-			0x96, 0x85, 0x86, 0x51, 0xf3, 0xf4, 0x28, 0xdc, 0xa2, 0x54, 0xe9, 0x9c, 0xd8, 0xf7, 0x20, 0xaf, 0xb8, 0x56, 0xd2, 0x8b, 0xe2, 0x3c, 0x82, 0x28, 0x8d, 0x18, 0x13, 0xaa, 0xb4, 0x1b, 0xc7, 0x1d, 0x85, 0xce, 0xb4, 0xbe, 0x5f, 0x9a, 0x7f, 0x50, 0x8c, 0x33, 0x77, 0xca, 0x3c, 0x44, 0x87, 0x00, 0xf4, 0x53, 0xf9, 0x7e, 0x61, 0x56, 0xbe, 0x3f, 0x2c, 0xe8, 0x7c, 0xb1, 0xe3, 0x92, 0xa9, 0x1d, 0x3e, 0x74, 0x51, 0xea, 0x15, 0xab, 0xe0, 0x72, 0x8b, 0x34, 0x88, 0x04, 0x1b, 0x7b, 0x23, 0xc0, 0x64, 0x00, 0xb6, 0xbd, 0x58, 0xba, 0xfd, 0x9e, 0xd3, 0x98, 0x1a, 0x13, 0x5f, 0xbb, 0x75, 0x23,
-			0xea, 0xce, 0xac, 0x38, 0x78, 0x1a, 0xaa, 0x79, 0x61, 0x91, 0xd1, 0xb4, 0xb3, 0xfc, 0xd0, 0x18, 0x7e, 0x30, 0xbe, 0x93, 0xbd, 0xc9, 0x51, 0xf3, 0x94, 0xdb, 0x87, 0x1b, 0xef, 0x60, 0xfd, 0x9a, 0xcb, 0xad, 0x19, 0x79, 0xf4, 0x5c, 0x42, 0x82, 0xe2, 0x18, 0xfd, 0x0a, 0x3a, 0xe7, 0x92, 0x88, 0x88, 0x14, 0x85, 0xb8, 0xf9, 0x81, 0x31, 0x25, 0xe7, 0x29, 0xb7, 0x27, 0x2f, 0x8e, 0x19, 0x34, 0xeb, 0xd7, 0x56, 0x57, 0x44, 0x23, 0xc7, 0x06, 0x25, 0x48, 0xc2, 0xae, 0xf5, 0x98, 0xd7, 0xd3, 0x9e, 0xa8, 0x2a, 0x40, 0xce, 0x99, 0xa2, 0x65, 0x0e, 0xd2, 0x46, 0x1b, 0xb0, 0x67, 0x02, 0xdc,
-			0xbf, 0x3f, 0xde, 0x9c, 0xb3, 0xf9, 0xe3, 0x5e, 0x32, 0x1e, 0x9f, 0xf4, 0xdd, 0x72, 0xa2, 0xb7, 0xae, 0x69, 0x12, 0xf4, 0xe7, 0x5f, 0x2d, 0x24, 0x3f, 0x93, 0x72, 0xdb, 0x04, 0x08, 0x8e, 0x91, 0x7b, 0xfd, 0xbb, 0xe6, 0x16, 0x50, 0x82, 0xe6, 0x8c, 0x58, 0x72, 0x82, 0x92, 0x15, 0xaa, 0x42, 0x85, 0x7a, 0x9f, 0x9d, 0x9b, 0xf5, 0x53, 0x91, 0x86, 0x42, 0x10, 0x0a, 0xf3, 0xf8, 0x52, 0xc6, 0x9b, 0x27, 0xe8, 0xf1, 0xa5, 0xbe, 0x94, 0x6d, 0xe8, 0xfd, 0x8b, 0x50, 0x9b, 0x3d, 0xad, 0xee, 0xb4, 0xdc, 0x85, 0x5a, 0x77, 0x27, 0x7e, 0xb8, 0xe4, 0x47, 0x61, 0x68, 0xaf, 0x16, 0x3d, 0xa5,
-			0x5b, 0x6c, 0x73, 0x3c, 0x6c, 0x88, 0xd6, 0x05, 0x9f, 0x4c, 0x06, 0xed, 0x2e, 0x0b, 0xc7, 0x11, 0x1c, 0xbf, 0x3b, 0xf7, 0xa1, 0xb8, 0x6c, 0x81, 0xa9, 0x73, 0x75, 0x76, 0x05, 0xd2, 0xbe, 0x53, 0xa5, 0xa6, 0x30, 0x1f, 0x7f, 0x20, 0x45, 0xc6, 0x6a, 0x20, 0xf9, 0x67, 0x7f, 0x27, 0x7d, 0x47, 0x52, 0x0b, 0xfa, 0x0d, 0x91, 0xca, 0x24, 0x55, 0x85, 0xa4, 0xda, 0xf9, 0xe3, 0xe2, 0x16, 0x95, 0x92, 0x5f, 0xbf, 0x97, 0x44, 0xaa, 0x8e, 0xf6, 0x0c, 0x4c, 0x44, 0x18, 0xf3, 0x70, 0x5e, 0x73, 0x63, 0x41, 0x82, 0x9e, 0x63, 0x9f, 0x2f, 0x21, 0xf1, 0x13, 0x34, 0x87, 0x71, 0x32, 0x83, 0x9a,
-			0x17, 0xef, 0xde, 0xbe, 0x89, 0x0a, 0xa2, 0x0d, 0xcc, 0x21, 0xf2, 0x39, 0xf7, 0x7f, 0xbf, 0xc2, 0x97, 0xb2, 0x59, 0x79, 0x7f, 0x6f, 0x84, 0x4f, 0x5f, 0xff, 0x21, 0x4b, 0x37, 0x07, 0xf0, 0x78, 0xf1, 0x43, 0x4d, 0xd0, 0x3a, 0x9c, 0x44, 0x6e, 0x83, 0x7d, 0x19, 0x7e, 0x3f, 0x40, 0x09, 0x0a, 0x81, 0x1f, 0x10, 0xb1, 0xbd, 0xef, 0x8e, 0x43, 0x82, 0x89, 0xa8, 0x50, 0x06, 0x9a, 0x86, 0x69, 0x3a, 0x46, 0x34, 0xc5, 0xa3, 0xc1, 0x7d, 0xaf, 0xce, 0x87, 0xb4, 0x0e, 0x96, 0xe2, 0xe8, 0x1b, 0xb6, 0x1e, 0x06, 0xbf, 0x89, 0xa4, 0x4a, 0xd9, 0xf6, 0x37, 0x91, 0xce, 0xf7, 0xef, 0x00, 0x00,
-			0x00, 0xff, 0xff, 0x74, 0xf5, 0xa2, 0xa9, 0x52, 0x11, 0x00, 0x00,
+			0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0xcd, 0x5b, 0xeb, 0x6f, 0xdc, 0xb8, 0x11, 0xff, 0xec, 0xfb, 0x2b, 0x08, 0x35, 0x40, 0xd6, 0xb8, 0x78, 0xd7, 0xbe, 0xa4, 0x05, 0x9a, 0xec, 0xba, 0xcd, 0x25, 0x2e, 0xea, 0x6b, 0x1e, 0x6e, 0xec, 0xf4, 0x80, 0x5e, 0x8a, 0x80, 0x96, 0xb8, 0x5e, 0xc6, 0x5a, 0x4a, 0xa1, 0xa8, 0xac, 0x5d, 0x5f, 0xfe, 0xf7, 0x0e, 0x9f, 0x22, 0x29, 0x69, 0x5f, 0x71, 0xec, 0x7e, 0xf1, 0x5a, 0xe2, 0x90, 0xfc, 0xcd, 0x70, 0x5e, 0xe4, 0x50, 0x37, 0x37, 0x28, 0x23, 0x53, 0xca, 0x08, 0x4a, 0x28, 0xfb, 0x52, 0xa4, 0x58, 0xd0, 0x82, 0x25, 0xe8,
+			0xeb, 0xd7, 0x1f, 0x6e, 0x6e, 0x90, 0x20, 0xf3, 0x32, 0xc7, 0x02, 0xda, 0x66, 0x04, 0x67, 0x84, 0x27, 0x68, 0x28, 0x5b, 0xc6, 0x19, 0xfd, 0x82, 0x68, 0x36, 0x49, 0xd2, 0x82, 0x09, 0xc2, 0x44, 0x82, 0xd2, 0x1c, 0x57, 0xd5, 0x24, 0xa9, 0x2f, 0xf7, 0xe4, 0x2b, 0x0c, 0xc3, 0x71, 0xe4, 0x3f, 0xec, 0x91, 0xab, 0x12, 0xb3, 0x2c, 0x39, 0xfc, 0x61, 0x47, 0x75, 0xf6, 0xe8, 0x67, 0x34, 0xcf, 0xf6, 0x16, 0x34, 0x13, 0x33, 0x43, 0xf4, 0xd7, 0x2a, 0x91, 0x7d, 0x2f, 0x38, 0xcd, 0x80, 0x5c, 0xd1, 0xcb, 0xdf, 0x9d, 0x71, 0x9d, 0x7b, 0xfd, 0xce, 0x39, 0x20, 0x4a, 0x79, 0x3d, 0x3f, 0x4f,
+			0x54, 0xeb, 0xce, 0x38, 0xa7, 0x87, 0x63, 0x8c, 0x66, 0x9c, 0x4c, 0x27, 0xc9, 0x28, 0x39, 0xfc, 0xa5, 0x38, 0xaf, 0xc6, 0x23, 0x7c, 0x38, 0x1e, 0x41, 0x43, 0x07, 0xc5, 0xa7, 0xe2, 0x7c, 0x04, 0x2c, 0x0e, 0xff, 0x45, 0xc9, 0xe2, 0x75, 0x91, 0x91, 0x7c, 0x08, 0x3d, 0xde, 0xe0, 0x39, 0x41, 0xbf, 0xa3, 0x9a, 0xe7, 0x84, 0xa5, 0xf0, 0x12, 0xb8, 0x4d, 0x0e, 0xbb, 0xa9, 0xbe, 0x7e, 0xed, 0x18, 0xbd, 0x02, 0x06, 0x22, 0xfa, 0xe3, 0x97, 0x8a, 0x54, 0xb5, 0x38, 0xea, 0xf1, 0xa8, 0xce, 0x15, 0x73, 0x23, 0xc3, 0x5d, 0x24, 0x95, 0x69, 0x4e, 0xae, 0xf6, 0x38, 0xbd, 0x98, 0x09, 0x29,
+			0x0a, 0x41, 0xae, 0x84, 0x7e, 0xd2, 0xbc, 0x02, 0x13, 0x9e, 0x20, 0x6a, 0x21, 0xe4, 0x8a, 0x19, 0xb6, 0x70, 0x49, 0x25, 0x6b, 0xc3, 0xa2, 0x16, 0x65, 0x2d, 0xd6, 0xe2, 0x70, 0xd4, 0x01, 0x58, 0x2d, 0x01, 0x85, 0xf5, 0x9b, 0x24, 0x59, 0xb1, 0x60, 0x79, 0x81, 0x33, 0xf5, 0x4a, 0x14, 0x45, 0x2e, 0x68, 0x39, 0x49, 0x5e, 0x9a, 0xb7, 0x08, 0xc6, 0x44, 0x6f, 0xd5, 0x64, 0xc9, 0xa1, 0x94, 0xc8, 0x1d, 0x01, 0xfc, 0x4b, 0x25, 0x40, 0x03, 0xe6, 0x93, 0x4a, 0x80, 0x5e, 0x72, 0x0f, 0xee, 0x02, 0x73, 0x46, 0xd9, 0xc5, 0x12, 0xb4, 0xa7, 0xba, 0x8b, 0x43, 0x0b, 0xa3, 0xd3, 0xa9, 0x3f,
+			0xc1, 0x73, 0x2e, 0xe8, 0x14, 0xa7, 0xa2, 0x92, 0xca, 0xbe, 0x26, 0x3b, 0xd8, 0xf6, 0xb9, 0x0d, 0x91, 0xe3, 0xfc, 0xbc, 0x9e, 0x2f, 0xe1, 0xc0, 0x01, 0x0c, 0x98, 0x20, 0x2c, 0xd3, 0x80, 0xad, 0x56, 0xb9, 0xdf, 0x50, 0xb9, 0xa4, 0x65, 0x59, 0x0b, 0xdb, 0x9b, 0x63, 0x91, 0xce, 0xdc, 0x13, 0x10, 0xd2, 0x4c, 0xdb, 0xae, 0x6e, 0x25, 0x19, 0xad, 0xe7, 0x28, 0xb2, 0xd3, 0x83, 0xbd, 0x27, 0x49, 0x97, 0xd2, 0x52, 0x5e, 0x09, 0x4d, 0x68, 0xf4, 0x34, 0x6c, 0x57, 0x6a, 0x5c, 0xcd, 0x71, 0x9e, 0x27, 0xda, 0x52, 0xbc, 0x36, 0xc9, 0xb7, 0x53, 0xf5, 0x92, 0xd3, 0x39, 0xe6, 0xd7, 0xf2,
+			0x19, 0x7e, 0x2f, 0x28, 0xd3, 0xbd, 0x8c, 0x09, 0x34, 0x62, 0xa2, 0x6c, 0x5a, 0x48, 0x09, 0x28, 0xdb, 0x3a, 0x15, 0xe0, 0xa8, 0x9c, 0x3d, 0xed, 0x8c, 0x67, 0x07, 0xdd, 0x8b, 0x2b, 0xe9, 0xea, 0x0a, 0xd6, 0x83, 0x7c, 0x46, 0x09, 0xaf, 0x99, 0x56, 0x16, 0xb3, 0xd0, 0x12, 0xb0, 0x2f, 0x75, 0x29, 0x6c, 0x5a, 0xa1, 0xb4, 0xe6, 0x1c, 0xfc, 0x5c, 0x7e, 0x8d, 0x5c, 0x07, 0xa0, 0xaa, 0x4a, 0xca, 0xc0, 0xb7, 0x4d, 0x12, 0x2e, 0x9d, 0xe6, 0x53, 0x74, 0x30, 0xdc, 0x0f, 0xd7, 0xec, 0xd8, 0x39, 0x54, 0x39, 0x88, 0xed, 0x7a, 0xd8, 0x80, 0x94, 0x8b, 0x96, 0x57, 0xa4, 0x1b, 0xa1, 0x81,
+			0x98, 0x62, 0x96, 0x92, 0x3c, 0x27, 0x99, 0x03, 0x19, 0x89, 0x4e, 0x89, 0xcc, 0xd7, 0x7a, 0x23, 0x1c, 0xf8, 0xfb, 0xd4, 0xbc, 0x7e, 0x86, 0x34, 0xc4, 0x9f, 0x7a, 0xf1, 0x2d, 0x30, 0x70, 0xe9, 0x66, 0xb2, 0x32, 0x0d, 0x31, 0xf6, 0x42, 0x04, 0x6b, 0x2a, 0xf8, 0x0a, 0x80, 0x19, 0x66, 0x17, 0x84, 0x6f, 0x8d, 0x6f, 0x8a, 0x69, 0x3f, 0xb0, 0x7e, 0xe1, 0x55, 0x75, 0x9a, 0x92, 0xaa, 0x5a, 0x8a, 0xcc, 0xd1, 0x84, 0xd0, 0xd2, 0x19, 0x49, 0x2f, 0x57, 0x03, 0x4b, 0x0b, 0x08, 0x91, 0x44, 0x90, 0xcd, 0xa1, 0x5d, 0xd2, 0xb2, 0x5c, 0x21, 0xb4, 0x79, 0x2d, 0x48, 0x16, 0x03, 0x9b, 0x16,
+			0x1c, 0xc4, 0x96, 0xad, 0xb7, 0xa6, 0x76, 0x96, 0x2e, 0x74, 0x4b, 0x26, 0x36, 0x16, 0x18, 0x4f, 0xfd, 0xb9, 0x26, 0x95, 0x1c, 0x7a, 0xf5, 0xdc, 0x95, 0xe6, 0xb5, 0x66, 0x97, 0x0c, 0x1c, 0x57, 0x6b, 0x7a, 0xeb, 0xa9, 0xc0, 0x45, 0x69, 0x3b, 0x0d, 0x02, 0xe1, 0x9d, 0x38, 0x8f, 0x19, 0xad, 0x44, 0x01, 0x2c, 0x7a, 0xfe, 0x83, 0x83, 0xb4, 0x7d, 0x0f, 0xf2, 0x24, 0x0a, 0xe5, 0x86, 0x04, 0x16, 0x90, 0x4f, 0xd3, 0xc7, 0x8f, 0x1f, 0xff, 0x59, 0x45, 0x76, 0x20, 0xfb, 0xbf, 0x60, 0xe0, 0x6f, 0x94, 0xd1, 0x6a, 0xd6, 0xc1, 0x41, 0xa8, 0x85, 0x2f, 0x8c, 0xc2, 0x0e, 0x8f, 0xab, 0x7f,
+			0x13, 0x5e, 0x00, 0x0b, 0x7b, 0x8d, 0x42, 0x84, 0xfc, 0x5a, 0xd2, 0x80, 0x61, 0xb7, 0x7c, 0xf7, 0xc7, 0x79, 0x9a, 0x17, 0xe9, 0xa5, 0xe3, 0xfb, 0x28, 0xc7, 0x65, 0x15, 0xb2, 0x7d, 0xa0, 0xb2, 0x53, 0xa2, 0x1b, 0x92, 0x3e, 0xe5, 0xee, 0x8e, 0x0f, 0x6d, 0xf9, 0xf4, 0x29, 0x41, 0x45, 0xc1, 0x5f, 0x7e, 0xac, 0x45, 0x6a, 0xa4, 0xd2, 0x25, 0x42, 0x03, 0x2e, 0x94, 0x9b, 0x03, 0xda, 0x52, 0xfe, 0x19, 0x1f, 0xc9, 0xdf, 0x16, 0xa8, 0x13, 0xcc, 0x21, 0x89, 0x10, 0x84, 0x9b, 0x94, 0xe4, 0xf6, 0x23, 0xfa, 0x41, 0x13, 0xb2, 0x4d, 0x3e, 0xdb, 0xe9, 0x2b, 0xf5, 0x2a, 0x2a, 0x82, 0x9d,
+			0x06, 0x94, 0xee, 0xd0, 0x98, 0xf8, 0xce, 0xb8, 0xe4, 0x24, 0x32, 0x1e, 0x8f, 0x85, 0xdf, 0x11, 0xf8, 0x30, 0x80, 0xf9, 0x91, 0xb0, 0x2f, 0x94, 0x83, 0x02, 0x48, 0xa1, 0xc8, 0x1e, 0x5a, 0x28, 0x56, 0x18, 0x1d, 0x52, 0x71, 0x8e, 0xa3, 0x25, 0xa1, 0xa3, 0x2b, 0x2a, 0x8e, 0x21, 0x1f, 0x70, 0xcd, 0x0f, 0x88, 0x7d, 0xf3, 0x74, 0xd2, 0x4f, 0xb8, 0x4c, 0x90, 0xb1, 0xe8, 0x14, 0xf3, 0x6d, 0xc9, 0xfd, 0xd1, 0xe9, 0x6f, 0x0a, 0x39, 0x02, 0xc4, 0xb8, 0x2e, 0x1b, 0x30, 0xb9, 0x51, 0x91, 0xd7, 0x73, 0x96, 0xac, 0x16, 0xf0, 0x32, 0xa7, 0xbc, 0xda, 0x3c, 0x80, 0xcf, 0xc6, 0x3a, 0x80,
+			0x5d, 0xf4, 0x02, 0xde, 0x04, 0xcb, 0x03, 0x16, 0x62, 0x06, 0xd7, 0x82, 0x74, 0xc2, 0x52, 0xe2, 0x91, 0xe4, 0x3a, 0x4a, 0xed, 0x83, 0x94, 0xa2, 0x38, 0xd9, 0x68, 0x7a, 0x18, 0xda, 0xdd, 0xea, 0xa8, 0x0d, 0x53, 0xc7, 0x80, 0xca, 0x63, 0x1c, 0x84, 0x6b, 0xbc, 0xa6, 0xbe, 0x7d, 0x93, 0x38, 0xce, 0x21, 0x3a, 0x35, 0x5e, 0x9e, 0x5e, 0x30, 0x9c, 0xaf, 0x29, 0x0b, 0x4d, 0xac, 0xac, 0xb7, 0xcd, 0x6b, 0x28, 0x03, 0x03, 0x26, 0x14, 0x42, 0xdf, 0x60, 0x81, 0x7c, 0xf4, 0xfb, 0xc0, 0x85, 0xec, 0xf9, 0x3e, 0xf6, 0x1e, 0x24, 0x36, 0xc3, 0xd5, 0x4c, 0xe0, 0x0b, 0x27, 0xb4, 0x93, 0xe3,
+			0x97, 0x3d, 0x12, 0x6b, 0xb9, 0xd4, 0x80, 0xb5, 0x13, 0xb3, 0xf7, 0xbd, 0x0f, 0x1e, 0xc2, 0x18, 0xf1, 0xe2, 0xe4, 0x3d, 0x1a, 0xbc, 0xaf, 0xc0, 0x98, 0x47, 0xe8, 0xf4, 0xba, 0x12, 0x64, 0xbe, 0x1b, 0x72, 0xf4, 0x64, 0x3d, 0x8e, 0xe4, 0x10, 0x67, 0x54, 0x6d, 0xe7, 0xb2, 0x5a, 0xe5, 0x40, 0xec, 0x23, 0x2f, 0x6a, 0x96, 0x7d, 0x9c, 0xd3, 0x3c, 0xa7, 0xd2, 0x3b, 0xc3, 0x0c, 0xe1, 0xfa, 0xaa, 0xe9, 0x56, 0x74, 0xb2, 0xb1, 0xf4, 0x6e, 0x65, 0x94, 0x61, 0x81, 0xcf, 0x71, 0xd5, 0x38, 0x8b, 0xd7, 0xf8, 0x0a, 0xbd, 0x3b, 0x3d, 0xdd, 0x4a, 0x34, 0xd0, 0x17, 0xba, 0x36, 0xbe, 0xfd,
+			0xfc, 0x5a, 0x90, 0x6e, 0xd6, 0xdc, 0x3f, 0xb1, 0x89, 0x9c, 0x11, 0x3e, 0xa7, 0x4c, 0x27, 0x90, 0x5d, 0xde, 0x19, 0xcc, 0x87, 0x87, 0x5b, 0x1d, 0xf5, 0x46, 0x8f, 0x5e, 0x1e, 0xf6, 0x6d, 0x67, 0x9e, 0x21, 0x31, 0x23, 0xa8, 0xe4, 0x85, 0x74, 0x5f, 0xe8, 0x02, 0x44, 0x5f, 0xea, 0xdc, 0x18, 0xdc, 0x35, 0x1a, 0x4b, 0x6f, 0x19, 0x72, 0xe2, 0xc1, 0x70, 0xc6, 0x39, 0x1e, 0x29, 0x3a, 0x84, 0xc1, 0x2c, 0x97, 0xc1, 0xd6, 0xb9, 0xfd, 0x25, 0xb5, 0x1b, 0x36, 0x39, 0x8f, 0x7e, 0x42, 0x78, 0x0a, 0xc1, 0x41, 0x41, 0xb9, 0xe0, 0x38, 0x05, 0x40, 0x84, 0xd3, 0x22, 0x43, 0x26, 0x4b, 0x69,
+			0xac, 0x5f, 0x8e, 0x0b, 0xe4, 0x0b, 0x2a, 0x66, 0x94, 0xb5, 0xe8, 0x9d, 0x6b, 0x18, 0x42, 0xc8, 0x8c, 0xa5, 0xd9, 0x9c, 0x00, 0xac, 0x13, 0x32, 0xff, 0x7e, 0x76, 0x76, 0x72, 0x5a, 0xcf, 0x95, 0xaa, 0xb8, 0xa8, 0x39, 0x13, 0xa2, 0xb4, 0x2f, 0xc3, 0xc0, 0xd9, 0x22, 0xff, 0x5e, 0xc7, 0x0a, 0x2b, 0xcf, 0x15, 0xbe, 0x7b, 0x82, 0xb9, 0x28, 0x78, 0xde, 0xec, 0x99, 0xde, 0x11, 0xb5, 0xef, 0x69, 0x4c, 0x73, 0xa5, 0x51, 0x78, 0x42, 0x1c, 0xbe, 0x26, 0x62, 0x56, 0xc8, 0x25, 0x40, 0x71, 0xcb, 0xfb, 0x77, 0xaf, 0x56, 0x9a, 0xfe, 0x5d, 0x9f, 0xa0, 0xc0, 0x9e, 0x4d, 0x27, 0x0b, 0x1e,
+			0xaf, 0x51, 0x7c, 0x94, 0x26, 0x30, 0xb8, 0x20, 0x21, 0x2f, 0xba, 0xaf, 0x8a, 0xf3, 0x3f, 0xed, 0xef, 0xef, 0xa2, 0x41, 0x2e, 0x7a, 0x09, 0x9e, 0x48, 0x82, 0x2d, 0xf3, 0x0a, 0x69, 0x78, 0x3d, 0xc3, 0xea, 0xb8, 0xba, 0xac, 0x71, 0xc3, 0xf0, 0x7a, 0xa7, 0xbb, 0x98, 0x77, 0xa4, 0x2a, 0x0b, 0x06, 0xf8, 0x64, 0xa4, 0xd8, 0x52, 0xd5, 0xec, 0x66, 0x63, 0xdb, 0x38, 0xf3, 0x9d, 0x19, 0x9e, 0xd2, 0x9c, 0xa8, 0x1e, 0x2d, 0xa6, 0xd7, 0xe7, 0x37, 0x5e, 0xff, 0x17, 0xba, 0x02, 0x71, 0x76, 0x5d, 0x76, 0x29, 0x40, 0xd8, 0xfa, 0x08, 0xb9, 0x85, 0x8f, 0x09, 0x2d, 0x90, 0x9f, 0x4d, 0xd0,
+			0xfa, 0xb9, 0x63, 0xaa, 0x33, 0x5e, 0x33, 0x08, 0x2f, 0x2a, 0x83, 0x43, 0x03, 0x61, 0x9f, 0x76, 0x5b, 0x1b, 0xe2, 0x6d, 0xb7, 0x30, 0xa7, 0xff, 0x7c, 0xb5, 0xb6, 0x7f, 0xfd, 0x8e, 0xdb, 0x3a, 0x75, 0x90, 0x3a, 0x07, 0xb1, 0x75, 0x6c, 0xeb, 0x20, 0x5f, 0xc8, 0x89, 0xdf, 0x5b, 0x3d, 0xdb, 0x7f, 0x9a, 0x1d, 0x92, 0x7e, 0x34, 0x20, 0xed, 0xc0, 0x63, 0x21, 0xab, 0x48, 0xe6, 0x01, 0x9e, 0xb8, 0xfd, 0x57, 0xb6, 0x1c, 0xba, 0x69, 0xc7, 0x23, 0x78, 0xf2, 0x5b, 0xde, 0x15, 0x8b, 0x0a, 0x3d, 0x9f, 0x4e, 0x49, 0xaa, 0xce, 0x67, 0xa2, 0x56, 0xb7, 0xfd, 0x6f, 0xde, 0xc3, 0xff, 0x76,
+			0x6c, 0xf9, 0xba, 0x99, 0x74, 0x2c, 0xce, 0x8b, 0xec, 0xda, 0x3c, 0x80, 0xfc, 0xb9, 0xf4, 0x30, 0xe8, 0x01, 0x65, 0x19, 0xb9, 0x7a, 0x84, 0x1e, 0x54, 0x16, 0x42, 0x14, 0xf9, 0xe2, 0x85, 0x69, 0xc3, 0xcf, 0x0e, 0x9b, 0x3c, 0xc2, 0x8d, 0x32, 0x74, 0x2c, 0x35, 0x09, 0x04, 0xe0, 0xc9, 0x82, 0x7e, 0x46, 0xd1, 0x9a, 0x4e, 0x47, 0x9c, 0x4b, 0xf2, 0x25, 0x67, 0xa8, 0xd1, 0x2c, 0xa6, 0xc3, 0xc8, 0xd6, 0x9e, 0x9a, 0x73, 0x08, 0x8f, 0x48, 0xca, 0xd0, 0x8a, 0x30, 0x3c, 0x8c, 0x68, 0xe3, 0xf1, 0xc7, 0x5e, 0xc3, 0xa3, 0x34, 0x03, 0x78, 0x72, 0x0f, 0x0e, 0xf9, 0x54, 0x4b, 0x23, 0x79,
+			0x78, 0x90, 0xea, 0xf1, 0x4d, 0xa6, 0x22, 0x48, 0xb9, 0xc6, 0x51, 0xc8, 0x77, 0xb5, 0x12, 0x40, 0xd0, 0x36, 0x90, 0x5a, 0xa9, 0x3f, 0x4e, 0xd3, 0x82, 0x67, 0x54, 0x3a, 0xbd, 0x79, 0x2d, 0xcf, 0x46, 0x73, 0xf2, 0x14, 0x81, 0xc7, 0x20, 0xa6, 0x77, 0x97, 0xea, 0x91, 0x32, 0xd6, 0xba, 0x86, 0xc7, 0x1d, 0x5d, 0x5e, 0xb4, 0x62, 0xf6, 0xcb, 0x51, 0x6e, 0xaa, 0x3d, 0x41, 0x45, 0x4e, 0x6c, 0x5d, 0xea, 0x0f, 0x89, 0x5b, 0x55, 0xa7, 0x61, 0xa4, 0x5c, 0x5a, 0xfc, 0x68, 0xf8, 0xee, 0xa8, 0x6d, 0xec, 0x0f, 0xff, 0x14, 0x9e, 0xf5, 0x4a, 0x74, 0x51, 0x55, 0xa3, 0x11, 0xc3, 0x4e, 0x70,
+			0x04, 0xde, 0x31, 0x75, 0xab, 0xa8, 0xd1, 0x2b, 0xf4, 0x76, 0x61, 0xa3, 0xb3, 0xc0, 0xa7, 0xe0, 0xf4, 0x17, 0x31, 0x56, 0x23, 0x8a, 0x6a, 0x18, 0xbd, 0x78, 0x5a, 0x75, 0x8c, 0x7e, 0x38, 0xba, 0x66, 0xe1, 0x8b, 0x5f, 0xc6, 0x23, 0xca, 0x6a, 0xf2, 0x96, 0x1d, 0xc9, 0xe9, 0x60, 0xae, 0x67, 0x28, 0x35, 0xef, 0x32, 0x04, 0x21, 0x55, 0xa1, 0xf0, 0x32, 0x9e, 0x8d, 0x58, 0x88, 0x8a, 0x1d, 0xfd, 0x7a, 0xdc, 0x2a, 0x78, 0xa8, 0x5a, 0x47, 0x07, 0x07, 0x9d, 0xc5, 0x8d, 0x35, 0x80, 0x84, 0xa5, 0x8d, 0x5e, 0x20, 0x71, 0x79, 0xc3, 0x54, 0x36, 0x7a, 0x56, 0xb6, 0xab, 0x94, 0xe1, 0x63,
+			0xd9, 0x70, 0x32, 0x9d, 0x7e, 0x75, 0xea, 0x74, 0x09, 0xe2, 0xef, 0xd3, 0x69, 0xcf, 0xa9, 0xe9, 0x0d, 0x93, 0x62, 0xdf, 0xdc, 0x08, 0x58, 0x2e, 0x76, 0x17, 0x1c, 0x1b, 0x38, 0xc6, 0xfd, 0xb3, 0x42, 0x34, 0x72, 0x94, 0xa7, 0xcb, 0xc1, 0xe1, 0x73, 0xd0, 0x12, 0x9f, 0xc5, 0x07, 0xbd, 0xdb, 0x67, 0xd7, 0x2a, 0xe7, 0xd1, 0x8d, 0xab, 0x7d, 0xb9, 0x9f, 0x1e, 0x05, 0x51, 0xa4, 0x59, 0xe0, 0x20, 0x74, 0xf8, 0xc2, 0xb1, 0x75, 0xe8, 0x9d, 0xf6, 0xae, 0xdd, 0x79, 0x28, 0x7b, 0x5d, 0xa4, 0xd3, 0x35, 0xe9, 0x30, 0xe6, 0x24, 0x58, 0x72, 0xb2, 0x3c, 0xfc, 0xb9, 0x2e, 0xcd, 0xd1, 0x71,
+			0xdf, 0x1a, 0xc9, 0xad, 0x34, 0x7a, 0x10, 0xb9, 0x56, 0x7d, 0x67, 0xc1, 0x78, 0xe0, 0x68, 0x66, 0x75, 0x74, 0xbd, 0x72, 0x68, 0x2f, 0x6b, 0xdd, 0x69, 0xee, 0x81, 0x84, 0x54, 0xf6, 0xa2, 0xc7, 0xb6, 0x81, 0xee, 0xb9, 0x90, 0x77, 0x70, 0xc4, 0xfd, 0xc6, 0x3a, 0x0b, 0xe2, 0x4e, 0xf3, 0x41, 0x33, 0x69, 0x2b, 0xdf, 0xfb, 0x15, 0xd3, 0xae, 0x34, 0xd0, 0x95, 0xef, 0x56, 0xa7, 0x87, 0xfa, 0x7d, 0x73, 0x2e, 0x1e, 0xb7, 0x48, 0x1f, 0x7c, 0x1b, 0xe9, 0x24, 0xd6, 0x1c, 0x44, 0x61, 0x3d, 0x5c, 0xd1, 0x36, 0xe7, 0x59, 0x2c, 0xce, 0xbd, 0x6a, 0xc6, 0x29, 0xbb, 0x6c, 0x59, 0x8d, 0x19,
+			0x3e, 0x36, 0x9c, 0xed, 0x83, 0x96, 0x41, 0xd6, 0x55, 0x6b, 0xb7, 0x8a, 0xbd, 0x86, 0x9f, 0x5d, 0x2b, 0xba, 0xd8, 0xa9, 0x96, 0x06, 0x98, 0x96, 0xab, 0xb5, 0x1c, 0xdb, 0xde, 0x1e, 0x92, 0x28, 0x8d, 0xed, 0x95, 0x61, 0x24, 0x3b, 0xa9, 0x4c, 0xc6, 0xcb, 0x05, 0xaf, 0x96, 0xfb, 0xc8, 0xf6, 0x31, 0xc2, 0xfa, 0xb3, 0xbb, 0x79, 0xba, 0x8b, 0xc9, 0x5b, 0x0c, 0xb4, 0x59, 0x8e, 0xbe, 0x89, 0x74, 0xbc, 0x52, 0x59, 0x30, 0x61, 0x47, 0x69, 0x67, 0x2b, 0xb1, 0xd8, 0x4b, 0x81, 0x9d, 0x0a, 0xdd, 0x6c, 0xa8, 0xa2, 0xf7, 0x23, 0xdb, 0xb0, 0x74, 0xc2, 0xbb, 0xdb, 0x8b, 0xbc, 0x82, 0x3c,
+			0xe2, 0x5e, 0xdd, 0xb3, 0x04, 0x70, 0xa7, 0xae, 0xf9, 0x1f, 0xe4, 0xba, 0xe5, 0x35, 0x4f, 0x78, 0xa1, 0x46, 0x58, 0xd7, 0x5f, 0x6f, 0xe9, 0x65, 0xb7, 0x74, 0x96, 0xd1, 0x72, 0xc1, 0x9f, 0x4a, 0x6c, 0xec, 0x34, 0x6b, 0xd6, 0x4e, 0x19, 0xd5, 0xe2, 0xe7, 0x72, 0xb4, 0x05, 0x2c, 0x23, 0x59, 0x67, 0x3b, 0x14, 0x63, 0x79, 0x9e, 0x7e, 0xae, 0x29, 0x27, 0xd9, 0xe6, 0x8e, 0xb5, 0x07, 0x0e, 0x36, 0x03, 0x6e, 0x02, 0xe2, 0xd6, 0x82, 0x88, 0x42, 0x20, 0x33, 0xd2, 0x95, 0x28, 0x6e, 0x63, 0x73, 0xa0, 0x66, 0x9b, 0x91, 0x3c, 0x53, 0x43, 0x2e, 0x66, 0x84, 0xaf, 0x17, 0x4a, 0x62, 0xf6,
+			0x41, 0xa3, 0xb7, 0x8c, 0x26, 0xf1, 0x48, 0xd6, 0x0e, 0x36, 0x74, 0xbb, 0xf1, 0x30, 0xda, 0x6a, 0xbe, 0xc5, 0xa7, 0x87, 0xce, 0xb5, 0x7b, 0xbd, 0x9d, 0x8f, 0xed, 0x69, 0xde, 0xd8, 0xd5, 0xde, 0xb6, 0x6f, 0x8d, 0x6e, 0xe2, 0xde, 0x57, 0xfe, 0x6b, 0x51, 0xdc, 0xa9, 0x97, 0x95, 0x7b, 0xca, 0x76, 0x9a, 0x4b, 0xff, 0x4b, 0x6e, 0x25, 0x37, 0x35, 0x2c, 0xc5, 0xc9, 0x69, 0x74, 0xf3, 0xb9, 0x05, 0x2b, 0xf3, 0x6e, 0xd0, 0x77, 0x5e, 0x7d, 0xf6, 0xb7, 0x59, 0xfd, 0x77, 0x9f, 0x7d, 0x2a, 0x7d, 0x9d, 0x9b, 0x01, 0xe1, 0x44, 0x45, 0x7b, 0x33, 0xda, 0xb0, 0xef, 0x2e, 0x7e, 0x44, 0x61,
+			0xef, 0xe1, 0x77, 0x9c, 0x63, 0x3a, 0x42, 0x29, 0xb5, 0xae, 0xca, 0xf4, 0x7d, 0xa4, 0x0b, 0xce, 0xd3, 0xde, 0x93, 0x32, 0xab, 0xc0, 0xdb, 0x56, 0xe4, 0xf6, 0x85, 0xad, 0x78, 0x77, 0xbd, 0x1e, 0xbf, 0xb7, 0xcc, 0x95, 0xba, 0xc8, 0x27, 0x08, 0x9f, 0x43, 0x23, 0xcb, 0x8a, 0x85, 0x7f, 0x99, 0x2f, 0xa8, 0x0b, 0x7b, 0x05, 0xad, 0x1c, 0x9c, 0x2a, 0xe2, 0x24, 0x9f, 0x24, 0x95, 0xb8, 0xce, 0x49, 0x35, 0x23, 0x44, 0xb8, 0xfb, 0xfa, 0xf2, 0x6c, 0x9b, 0xa6, 0xa3, 0xb4, 0xaa, 0x46, 0x57, 0x72, 0xdc, 0x61, 0x2a, 0x43, 0xea, 0x48, 0xf7, 0xac, 0x52, 0x4e, 0x61, 0x5f, 0x51, 0xf1, 0xb4,
+			0xa1, 0xfc, 0x64, 0x09, 0x3f, 0xa9, 0xdb, 0xf6, 0x9a, 0x64, 0x29, 0xf9, 0x94, 0x8a, 0xf5, 0x89, 0x17, 0xe4, 0xfc, 0x15, 0xe0, 0xad, 0xa2, 0x1e, 0x5e, 0x17, 0xbd, 0x40, 0xa3, 0x11, 0x32, 0xf7, 0x0c, 0xf2, 0x21, 0x2e, 0xcb, 0xfc, 0xfa, 0x79, 0x96, 0x15, 0x6c, 0x00, 0x73, 0xed, 0x3e, 0x5b, 0x46, 0x60, 0xc7, 0x37, 0x54, 0x5f, 0x30, 0x47, 0x92, 0x1b, 0x34, 0x41, 0x8c, 0x2c, 0x5c, 0x8f, 0x81, 0x69, 0x56, 0x8c, 0x16, 0x25, 0x61, 0x83, 0xac, 0x48, 0x6b, 0x55, 0x02, 0xb8, 0x20, 0xe2, 0x28, 0x57, 0xd5, 0x80, 0x9f, 0xaf, 0x8f, 0xb3, 0xc1, 0x43, 0x6f, 0x31, 0x1e, 0xee, 0xfa, 0xdd,
+			0xe6, 0x98, 0x5f, 0xca, 0xdb, 0x7d, 0x13, 0xf4, 0xdb, 0x7f, 0x1c, 0x24, 0xd5, 0x02, 0x20, 0xed, 0x04, 0xba, 0xe3, 0x50, 0xbe, 0xfe, 0x95, 0x43, 0x88, 0x03, 0xea, 0x81, 0xbc, 0x86, 0xf2, 0x08, 0xe9, 0xef, 0x39, 0x76, 0xd1, 0xe4, 0x10, 0xdd, 0x68, 0x4d, 0x95, 0xef, 0xa1, 0x5d, 0xfe, 0x0c, 0x39, 0x29, 0x73, 0x9c, 0x92, 0xc1, 0xe8, 0x03, 0x1b, 0x5d, 0x3c, 0x42, 0x0f, 0x3f, 0xf0, 0x0f, 0xec, 0xa1, 0x19, 0x73, 0x07, 0x0c, 0x6c, 0xa0, 0xbb, 0xa3, 0xc9, 0x64, 0x82, 0x12, 0xf3, 0x4d, 0xc8, 0xae, 0x1d, 0xc8, 0x8e, 0x94, 0x7c, 0xb8, 0x3a, 0x38, 0xff, 0xed, 0xf1, 0xc1, 0x3c, 0x41,
+			0x3f, 0xaa, 0x61, 0xe1, 0x47, 0xbf, 0xdb, 0x9f, 0x27, 0x66, 0x2c, 0x63, 0xf5, 0x0a, 0xf7, 0x42, 0x22, 0x54, 0xf0, 0xcc, 0x4c, 0x5f, 0x9f, 0x69, 0x43, 0xf0, 0x16, 0xa6, 0x65, 0xdf, 0xe0, 0xf4, 0x9a, 0x8b, 0x28, 0xfa, 0x90, 0x69, 0x68, 0xce, 0x9a, 0xec, 0x85, 0x6b, 0x6f, 0x59, 0xdb, 0x9e, 0x39, 0x9d, 0xd5, 0xa0, 0xbe, 0xa1, 0x5b, 0xd6, 0xfd, 0x5f, 0xc8, 0x16, 0xe7, 0x99, 0x9d, 0x08, 0x07, 0xb2, 0x2e, 0xaf, 0xbb, 0x0d, 0x5f, 0x4a, 0xa6, 0xc0, 0x4f, 0x3e, 0x42, 0xde, 0xcb, 0x53, 0x2d, 0x1a, 0x78, 0x6d, 0xd8, 0x08, 0x3c, 0x5c, 0xcc, 0x4d, 0xd3, 0xb2, 0x9c, 0xb5, 0xe5, 0x1f,
+			0x52, 0xf8, 0x3c, 0x4a, 0x9d, 0x23, 0x95, 0xd1, 0xb8, 0xa3, 0x2f, 0xa0, 0x49, 0xa7, 0x45, 0xcd, 0x61, 0x31, 0xe3, 0x8f, 0x81, 0x86, 0x7a, 0x11, 0xb7, 0xff, 0x26, 0x48, 0x5d, 0xb1, 0x79, 0x83, 0x59, 0x51, 0xc9, 0x50, 0xc2, 0x8a, 0x85, 0xba, 0x9d, 0x0b, 0x1d, 0x19, 0xbd, 0xfa, 0xc8, 0xe0, 0xbd, 0x27, 0x04, 0x52, 0x0d, 0x71, 0x96, 0x29, 0x38, 0xaf, 0x68, 0x25, 0x08, 0x23, 0x7c, 0x90, 0xa8, 0x15, 0xcf, 0x19, 0xc8, 0x6f, 0x40, 0x7c, 0x55, 0x94, 0x2c, 0xe4, 0xf2, 0x23, 0xb9, 0x09, 0xfa, 0xe5, 0xf4, 0xed, 0x9b, 0x61, 0x89, 0x79, 0x45, 0x06, 0x64, 0xe8, 0xe9, 0x86, 0xb7, 0x20,
+			0x92, 0x52, 0x35, 0xfd, 0x98, 0x7c, 0x90, 0x63, 0xa9, 0x67, 0xa3, 0xdf, 0x46, 0x91, 0x56, 0x82, 0xb8, 0x2d, 0x08, 0x1b, 0xcf, 0x6e, 0x6f, 0x4d, 0xc7, 0xf3, 0xf7, 0x39, 0x04, 0xd7, 0x61, 0x77, 0x28, 0x83, 0x8d, 0x29, 0xb9, 0x03, 0x4a, 0x3d, 0xff, 0x1a, 0x33, 0xba, 0x43, 0xa0, 0x78, 0x4a, 0xa0, 0x4e, 0x61, 0x6f, 0x45, 0xac, 0xf3, 0xb0, 0xde, 0x23, 0xb7, 0x2a, 0x08, 0x4e, 0xbe, 0xc0, 0xd9, 0x20, 0x64, 0xab, 0x57, 0xa1, 0xa3, 0xcf, 0xa2, 0xcc, 0xcf, 0x8d, 0xff, 0x99, 0xe3, 0xb4, 0x28, 0x84, 0xfb, 0xcc, 0xb1, 0xe9, 0xfb, 0x3f, 0x62, 0xb8, 0x8f, 0x23, 0x25, 0x39, 0x00, 0x00,
 		},
 	},
 	"_views/job.html": &BinaryFile{
 		Name:    "_views/job.html",
-		ModTime: 1580493407,
+		ModTime: 1792426031,
 		MD5: []byte{
 			0xd4, 0x1d, 0x8c, 0xd9, 0x8f, 0x00, 0xb2, 0x04, 0xe9, 0x80, 0x09, 0x98, 0xec, 0xf8, 0x42, 0x7e,
 		},
 		CompressedContents: []byte{
-			0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0xcc, 0x58, 0x5f, 0x6f, 0xdc, 0x28, 0x10, 0x7f, 0x4e, 0x3e, 0x05, 0xb2, 0xf2, 0x78, 0x5e, 0x27, 0xaa, 0xa2, 0x53, 0x4e, 0x8e, 0x75, 0xa7, 0x24, 0xa7, 0x6b, 0xa5, 0x5e, 0xab, 0xa6, 0xba, 0x87, 0x7b, 0x89, 0x58, 0x33, 0xbb, 0x26, 0xb5, 0xc1, 0x07, 0x78, 0x93, 0xca, 0xeb, 0xef, 0x7e, 0x02, 0x83, 0x8d, 0xbd, 0xde, 0x7f, 0x4d, 0xa3, 0x76, 0x5f, 0xbc, 0xc0, 0xcc, 0x8f, 0x99, 0xdf, 0x0c, 0x0c, 0x50, 0xd7, 0x88, 0xc0, 0x82, 0x32, 0x40, 0xc1, 0x23, 0x9f, 0x07, 0xa8, 0x69, 0x4e, 0xeb, 0x1a, 0x29, 0x28, 0xca, 0x1c, 0x2b,
-			0x40, 0x41, 0x06, 0x98, 0x80, 0x08, 0xd0, 0x4c, 0x8f, 0xc4, 0x84, 0xae, 0x10, 0x25, 0xd7, 0x41, 0xca, 0x99, 0x02, 0xa6, 0x02, 0x94, 0xe6, 0x58, 0xca, 0xeb, 0xa0, 0xfa, 0x12, 0xea, 0x2e, 0x4c, 0x19, 0x08, 0xe4, 0x37, 0x42, 0x78, 0x2e, 0x31, 0x23, 0x41, 0x72, 0x7a, 0x62, 0x94, 0x3d, 0xf9, 0x8c, 0xe6, 0x24, 0x7c, 0xa2, 0x44, 0x65, 0x56, 0xe8, 0x77, 0x19, 0x68, 0xdd, 0xa5, 0xa0, 0x24, 0x39, 0x3d, 0x31, 0xf2, 0xfa, 0x7b, 0x12, 0x57, 0xb9, 0xa7, 0x37, 0x17, 0x80, 0x49, 0x2a, 0xaa, 0x62, 0x1e, 0x98, 0xd1, 0x93, 0x38, 0xa7, 0x49, 0x8c, 0x51, 0x26, 0x60, 0x71, 0x1d, 0x44, 0x41,
-			0xf2, 0x8e, 0xcf, 0x65, 0x1c, 0xe1, 0x24, 0x8e, 0x72, 0x3a, 0x25, 0xf1, 0xc8, 0xe7, 0x51, 0x5d, 0xa3, 0xd9, 0x3f, 0x14, 0x9e, 0xde, 0x73, 0x02, 0xf9, 0xec, 0x6f, 0x5c, 0x00, 0x5a, 0xa3, 0x4a, 0xe4, 0xc0, 0x52, 0x4e, 0x00, 0x35, 0x4d, 0x90, 0x4c, 0x88, 0x34, 0xcd, 0x00, 0x37, 0x8e, 0xaa, 0xdc, 0x18, 0x1a, 0xb5, 0x96, 0xba, 0xef, 0x80, 0xbf, 0x12, 0x0b, 0x45, 0x71, 0x2e, 0xf5, 0xb4, 0x0f, 0x0a, 0xcf, 0x73, 0x78, 0x70, 0x94, 0x36, 0xcd, 0x2e, 0x59, 0xc1, 0x9f, 0x2c, 0xeb, 0x7b, 0x01, 0x17, 0x9c, 0x2b, 0x07, 0x18, 0x67, 0x22, 0xda, 0x24, 0x5b, 0x73, 0xea, 0xb8, 0x0d, 0x09,
-			0x5d, 0x51, 0xd2, 0xc6, 0xc9, 0xb4, 0x65, 0x81, 0xf3, 0x1c, 0x8d, 0x42, 0x72, 0x11, 0x5e, 0xea, 0x2e, 0x05, 0xcf, 0x2a, 0x4c, 0x81, 0x69, 0x7c, 0xed, 0x6b, 0x5d, 0xa3, 0x33, 0xa9, 0xb0, 0x92, 0xe8, 0xb7, 0x6b, 0x9f, 0x9f, 0x7b, 0xd3, 0xa7, 0x0d, 0x18, 0x4f, 0xbd, 0xa0, 0x42, 0xaa, 0x30, 0xe5, 0x79, 0x55, 0xb0, 0x36, 0x62, 0xb1, 0x2c, 0x31, 0xf3, 0x24, 0xcc, 0x1c, 0xc6, 0x88, 0x20, 0x99, 0x1c, 0x2b, 0x05, 0x2d, 0xb0, 0xf8, 0xaa, 0xed, 0x29, 0xb0, 0x58, 0x52, 0xd6, 0x4a, 0x87, 0x82, 0x2e, 0x33, 0x65, 0x72, 0x86, 0xa6, 0x9c, 0x5d, 0x07, 0x92, 0xa7, 0x14, 0x6b, 0x90, 0x48,
-			0xa3, 0x24, 0xf7, 0x55, 0x9a, 0x82, 0x94, 0xe8, 0x13, 0x56, 0x60, 0xbb, 0xf4, 0xf4, 0xc6, 0x85, 0x76, 0x48, 0x8f, 0xdc, 0xe8, 0xb9, 0xb4, 0x37, 0xdd, 0x74, 0x04, 0xb3, 0xa5, 0xa3, 0xd3, 0x88, 0xd3, 0x05, 0x5a, 0x2a, 0xeb, 0xf7, 0xec, 0xbe, 0x57, 0x45, 0xe7, 0xb3, 0xab, 0x5e, 0x6a, 0x13, 0xd4, 0xc3, 0xb4, 0x63, 0x1e, 0x28, 0xe4, 0x12, 0x76, 0x21, 0xff, 0x7a, 0x20, 0xf2, 0x13, 0x16, 0x8c, 0xb2, 0xa5, 0x8f, 0xcc, 0x88, 0x6d, 0xc4, 0xd9, 0x85, 0xe3, 0x72, 0x12, 0x46, 0xe7, 0xb9, 0x59, 0x21, 0x5d, 0x58, 0x07, 0x46, 0xac, 0xd1, 0x82, 0x8b, 0x02, 0xab, 0x87, 0x32, 0x55, 0x0e,
-			0x31, 0xca, 0x2e, 0xfc, 0x9c, 0xf7, 0x56, 0xe9, 0x6b, 0x45, 0x35, 0xc3, 0x32, 0x53, 0x78, 0xd9, 0x85, 0xf5, 0x33, 0x57, 0x38, 0x47, 0x9f, 0x2a, 0x26, 0xbd, 0xa0, 0x7a, 0x9e, 0x8e, 0xf0, 0x37, 0x3c, 0xd4, 0x9a, 0x2d, 0xc6, 0x4f, 0xe3, 0xd2, 0x9d, 0x10, 0x5c, 0x00, 0xd9, 0xe1, 0x54, 0x9b, 0x85, 0x9e, 0x07, 0x4e, 0x65, 0x8d, 0xe0, 0x3f, 0x74, 0x8e, 0x9a, 0x66, 0x64, 0x43, 0x5d, 0xeb, 0x04, 0xeb, 0xbb, 0xdb, 0xa4, 0xae, 0x6b, 0x60, 0x64, 0x22, 0xea, 0x3e, 0xe2, 0x0f, 0x63, 0x25, 0xcd, 0x79, 0xfa, 0xa5, 0xe3, 0xe4, 0xe3, 0xd5, 0x25, 0xba, 0xcb, 0x71, 0x29, 0x81, 0xa0, 0xcf,
-			0xb4, 0x80, 0x6f, 0x0b, 0xb6, 0x45, 0xb8, 0xba, 0x54, 0x19, 0x5a, 0x23, 0x52, 0x09, 0xac, 0x28, 0x67, 0x0f, 0x82, 0x57, 0x8c, 0x3c, 0x14, 0x34, 0xcf, 0xa9, 0xfc, 0x69, 0x1c, 0xbe, 0x3c, 0xff, 0x7e, 0x0e, 0x5f, 0x9e, 0x1f, 0xed, 0xb0, 0xfd, 0x22, 0x84, 0xd0, 0x6b, 0x55, 0x8f, 0x1d, 0xa5, 0xa1, 0x27, 0xcb, 0xa3, 0x4d, 0xf1, 0x32, 0x68, 0x2d, 0x72, 0xbf, 0xd7, 0x5b, 0x92, 0x54, 0x2a, 0xae, 0xf9, 0xb4, 0xb4, 0xff, 0xd5, 0xb6, 0x5d, 0x10, 0x7c, 0x1b, 0xda, 0xb5, 0xe8, 0x15, 0xbf, 0x1b, 0xce, 0x16, 0x74, 0x39, 0xb3, 0x2a, 0xb7, 0x54, 0xea, 0xaa, 0x4c, 0x3e, 0x88, 0x5b, 0x58,
-			0xe0, 0x2a, 0x37, 0x5b, 0xe7, 0xc0, 0x87, 0xcd, 0x68, 0xda, 0x92, 0x93, 0x38, 0xdd, 0x36, 0x36, 0xa3, 0x49, 0x4d, 0xc5, 0xd8, 0x8f, 0xd5, 0x65, 0xc6, 0x1d, 0xdb, 0x81, 0xd5, 0xd6, 0x88, 0x0e, 0xc6, 0x4f, 0x7a, 0x0f, 0xb0, 0xa5, 0xec, 0x07, 0xc7, 0x00, 0x7d, 0x02, 0x7d, 0xd8, 0xa4, 0x9c, 0x7d, 0x43, 0x34, 0xde, 0xe3, 0xe7, 0x1b, 0x5e, 0x31, 0x75, 0x4c, 0x34, 0x3a, 0x06, 0x87, 0x67, 0xc0, 0xfd, 0xc0, 0x88, 0x2a, 0x28, 0xe4, 0xf6, 0xe0, 0xed, 0x31, 0xf4, 0x8f, 0x25, 0xbc, 0x86, 0x99, 0x03, 0xd8, 0xed, 0x7b, 0x02, 0xc2, 0x4b, 0x38, 0x26, 0xef, 0x92, 0xf0, 0x88, 0xcc, 0xf2,
-			0x93, 0xcc, 0x1e, 0x51, 0xb7, 0x05, 0xee, 0xee, 0x19, 0xd2, 0xf6, 0x28, 0x7b, 0xc4, 0x2e, 0x54, 0x00, 0xa1, 0x55, 0xb1, 0xb9, 0x0d, 0x5d, 0x04, 0x07, 0x6f, 0xe5, 0x7a, 0x5e, 0x7f, 0xcf, 0x2d, 0x05, 0x4c, 0x33, 0x6b, 0x0c, 0x5c, 0xa3, 0x47, 0x4e, 0x19, 0x0a, 0x50, 0x60, 0xae, 0x05, 0x5a, 0x78, 0xe2, 0x2a, 0xd0, 0xbb, 0xea, 0x0e, 0x65, 0xdb, 0xbc, 0xbe, 0x05, 0x99, 0x0a, 0x5a, 0xea, 0xc0, 0xfc, 0x08, 0xe7, 0xbd, 0xe9, 0x7d, 0x0e, 0xb4, 0xea, 0x24, 0x07, 0x43, 0x73, 0xfb, 0xed, 0x63, 0xaf, 0xff, 0xbb, 0x8c, 0x38, 0x3d, 0x39, 0xf9, 0x28, 0x60, 0x45, 0x79, 0x25, 0xd1, 0x5b,
-			0xb6, 0xe2, 0xa9, 0xc9, 0x53, 0xa9, 0xe1, 0xac, 0x49, 0xb1, 0xb9, 0xf0, 0xf8, 0xea, 0xa6, 0xed, 0xfe, 0xf4, 0xc5, 0xc8, 0x36, 0x95, 0xa0, 0x25, 0x90, 0x96, 0x06, 0xa5, 0xef, 0x5d, 0xad, 0x5b, 0x4a, 0xd8, 0x8b, 0xa1, 0xca, 0x92, 0x7b, 0x85, 0x85, 0xd2, 0xbb, 0xa4, 0xca, 0xfa, 0xce, 0x3f, 0x29, 0xa3, 0x32, 0x1b, 0xf7, 0x7e, 0xc4, 0x02, 0x17, 0xa0, 0x40, 0xc8, 0x61, 0xbf, 0xbe, 0xfc, 0x54, 0xa3, 0x3e, 0x5b, 0x87, 0x47, 0x9d, 0xfa, 0x88, 0x35, 0xec, 0xea, 0x5a, 0x71, 0xd4, 0x5a, 0xa5, 0x3b, 0xac, 0xa1, 0xb1, 0x9a, 0x73, 0xf2, 0xd5, 0x5e, 0xbb, 0x46, 0x59, 0x53, 0x09, 0x01,
-			0xac, 0x3b, 0x95, 0xf7, 0x0e, 0x91, 0x31, 0x39, 0xa1, 0xcc, 0x04, 0x65, 0x5f, 0x36, 0x76, 0x89, 0x16, 0x60, 0x66, 0xdd, 0x47, 0x6b, 0x24, 0x16, 0xe9, 0x9b, 0x37, 0x6f, 0xae, 0x4c, 0x38, 0x15, 0x39, 0x08, 0x6f, 0xd2, 0xa6, 0xd9, 0x0d, 0x2f, 0xca, 0x1c, 0x14, 0xcc, 0xde, 0xca, 0x7f, 0x41, 0x70, 0xd4, 0x34, 0x61, 0xbf, 0x8b, 0x4c, 0x5b, 0xe1, 0x54, 0x06, 0x66, 0x74, 0x59, 0x73, 0xb0, 0x3d, 0x13, 0xc8, 0x7d, 0xcc, 0x5e, 0x08, 0xd4, 0x06, 0xf9, 0xbb, 0xb3, 0xb3, 0x8d, 0x91, 0x3e, 0x2e, 0x92, 0xb2, 0x14, 0x1e, 0x2a, 0x95, 0xee, 0xd8, 0xbb, 0x7d, 0x7e, 0x27, 0xc0, 0xdc, 0xb1,
-			0x72, 0x17, 0x80, 0xb9, 0x18, 0xec, 0xf6, 0xad, 0x7d, 0xa6, 0xe9, 0x0e, 0x76, 0x4a, 0x54, 0x2c, 0xc5, 0x0a, 0x76, 0x38, 0x7b, 0x27, 0x84, 0x66, 0x2c, 0xe5, 0x64, 0x63, 0x2f, 0x1d, 0x4a, 0x44, 0x4e, 0xc4, 0xba, 0x11, 0x1e, 0x64, 0x90, 0x23, 0x3b, 0xc6, 0xfe, 0x03, 0x51, 0xa5, 0x14, 0x37, 0xa7, 0xc8, 0xf6, 0x5f, 0x57, 0x27, 0xb7, 0xbe, 0xff, 0x38, 0x5b, 0xde, 0xf1, 0xf9, 0xc4, 0x53, 0xd0, 0x16, 0xe1, 0xb7, 0xb7, 0xe6, 0xfa, 0xfc, 0xa1, 0x52, 0x65, 0xa5, 0x3a, 0x33, 0xdd, 0x2a, 0xf6, 0xaf, 0xe1, 0x75, 0x8d, 0x84, 0x3e, 0xdf, 0xa1, 0x33, 0xca, 0x08, 0x3c, 0xff, 0x82, 0xce,
-			0x1e, 0xe9, 0xe8, 0xf9, 0xc4, 0x9d, 0x74, 0xd6, 0x48, 0xc0, 0x0a, 0x44, 0x5b, 0x6f, 0x8f, 0x58, 0xd8, 0x67, 0x8f, 0xf4, 0xc5, 0x2b, 0x59, 0x63, 0xec, 0x5b, 0xba, 0xbe, 0xcc, 0x0b, 0xd7, 0xaa, 0x86, 0xf2, 0x16, 0x67, 0xf7, 0xd6, 0x00, 0x6c, 0x45, 0x85, 0xad, 0x2a, 0x47, 0x40, 0x1d, 0xbf, 0x3c, 0xb5, 0x96, 0x5b, 0x18, 0x2f, 0xcc, 0x7c, 0x03, 0x65, 0x12, 0xd9, 0xe1, 0xba, 0xc6, 0x2b, 0xa4, 0xb3, 0x84, 0x94, 0x33, 0xa2, 0x13, 0x7a, 0xf4, 0xa2, 0xa9, 0x27, 0xde, 0x9e, 0xc2, 0x7a, 0xf4, 0xf0, 0x9c, 0x8d, 0x23, 0x57, 0x7b, 0xe2, 0xc8, 0x18, 0x97, 0x9c, 0xda, 0x9a, 0x3e, 0x78,
-			0x8c, 0x74, 0x2f, 0x8f, 0x33, 0xfb, 0x6e, 0x6c, 0xf5, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x5d, 0xa1, 0x7a, 0xd3, 0x55, 0x16, 0x00, 0x00,
+			0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0xd5, 0x5a, 0x5b, 0x4f, 0xdc, 0x38, 0x14, 0x7e, 0x6e, 0x7f, 0x85, 0x15, 0xf1, 0xd0, 0x4a, 0x3b, 0x33, 0xa0, 0x0a, 0x55, 0xac, 0xc2, 0x68, 0x2b, 0x60, 0x55, 0xba, 0x6d, 0x61, 0x81, 0xdd, 0x95, 0xf6, 0x05, 0x79, 0x12, 0xcf, 0xc4, 0x6d, 0xc6, 0x4e, 0x6d, 0x07, 0xa8, 0x80, 0xff, 0xbe, 0xc7, 0xb7, 0xc4, 0xc9, 0x24, 0x73, 0x29, 0xa5, 0x74, 0x79, 0xe8, 0x24, 0xf6, 0xf1, 0xe7, 0x73, 0xf5, 0x39, 0x3e, 0xe9, 0xed, 0x2d, 0x4a, 0xc9, 0x94, 0x32, 0x82, 0xa2, 0x4f, 0x7c, 0x12, 0xa1, 0xfb, 0xfb, 0xe7, 0xb7, 0xb7, 0x48, 0x91,
+			0x79, 0x91, 0x63, 0x05, 0x83, 0x19, 0xc1, 0x29, 0x11, 0x11, 0x1a, 0xea, 0x99, 0x38, 0xa5, 0x57, 0x88, 0xa6, 0xfb, 0x51, 0xc2, 0x99, 0x22, 0x4c, 0x45, 0x28, 0xc9, 0xb1, 0x94, 0xfb, 0x51, 0xf9, 0x79, 0xa0, 0x87, 0x30, 0xe0, 0x08, 0x14, 0xbe, 0x0c, 0xc8, 0x4d, 0x81, 0x59, 0x1a, 0x8d, 0x9f, 0x3f, 0x33, 0x8b, 0x03, 0xfa, 0x8c, 0xe6, 0xe9, 0xe0, 0x9a, 0xa6, 0x2a, 0x73, 0x44, 0xbf, 0xc9, 0x48, 0xaf, 0x9d, 0x09, 0x9a, 0x02, 0xb9, 0xa1, 0xd7, 0xbf, 0xcf, 0xe2, 0x32, 0x0f, 0xd6, 0x4d, 0x04, 0x70, 0x94, 0x88, 0x72, 0x3e, 0x89, 0xcc, 0xec, 0xb3, 0x38, 0xa7, 0xe3, 0x18, 0xa3, 0x4c,
+			0x90, 0xe9, 0x7e, 0x34, 0x8a, 0xc6, 0xef, 0xf8, 0x44, 0xc6, 0x23, 0x3c, 0x8e, 0x47, 0x30, 0xd1, 0x41, 0x01, 0x62, 0x8e, 0x40, 0xc4, 0xe1, 0xdf, 0x94, 0x5c, 0x7f, 0xe0, 0x29, 0xc9, 0x87, 0x1f, 0xf1, 0x9c, 0xa0, 0x3b, 0x54, 0x8a, 0x9c, 0xb0, 0x04, 0x46, 0x40, 0xd4, 0x68, 0xdc, 0x41, 0x72, 0x7f, 0xdf, 0xc0, 0x8d, 0x47, 0x65, 0x6e, 0x18, 0x1d, 0x59, 0x4e, 0xfd, 0x6f, 0x43, 0x7f, 0x05, 0x16, 0x8a, 0xe2, 0x5c, 0xea, 0x6d, 0x2f, 0x15, 0x9e, 0xe4, 0xe4, 0xd2, 0xab, 0x14, 0x14, 0xba, 0x84, 0x56, 0xf0, 0x6b, 0xa7, 0xf5, 0x95, 0x80, 0x53, 0xce, 0x95, 0x07, 0x8c, 0x33, 0x31, 0x5a,
+			0x54, 0xb6, 0xd6, 0xa9, 0xd7, 0xed, 0x00, 0xa6, 0x68, 0x6a, 0xed, 0x64, 0xde, 0xe5, 0x1c, 0xe7, 0x39, 0x6a, 0x99, 0x64, 0x67, 0xb0, 0xab, 0x87, 0x14, 0xb9, 0x51, 0x83, 0x04, 0x4c, 0x0d, 0xf8, 0x5a, 0x56, 0x60, 0x65, 0x4b, 0x2a, 0xac, 0x24, 0xfa, 0x75, 0x3f, 0xd4, 0xcf, 0xb9, 0x19, 0xd3, 0x0c, 0xb4, 0xb7, 0x9e, 0x52, 0x21, 0x01, 0x82, 0xe7, 0xe5, 0x9c, 0x59, 0x8b, 0xc5, 0x12, 0xac, 0x1d, 0x50, 0x98, 0x3d, 0x0c, 0x13, 0xd1, 0xb8, 0x73, 0xae, 0x10, 0x74, 0x8e, 0xc5, 0x57, 0xcd, 0x0f, 0xfc, 0xce, 0x28, 0xb3, 0xd4, 0x03, 0x41, 0x67, 0x99, 0x32, 0x3e, 0x43, 0xc1, 0xe1, 0xf6,
+			0x23, 0xc9, 0x13, 0xd0, 0x0c, 0x80, 0x8c, 0x34, 0xca, 0xf8, 0xbc, 0x4c, 0x12, 0x22, 0x25, 0x3a, 0x03, 0xbd, 0xb9, 0x21, 0xbd, 0xbd, 0x11, 0xc1, 0x4e, 0xe9, 0x99, 0x03, 0xbd, 0x97, 0x96, 0xa6, 0xda, 0x2e, 0xc5, 0x6c, 0xe6, 0xd5, 0x69, 0xc8, 0xe9, 0x14, 0xcd, 0x94, 0x93, 0x7b, 0x78, 0x5e, 0x2f, 0x45, 0xdb, 0xc3, 0xbd, 0x9a, 0x6a, 0x11, 0x34, 0xc0, 0x74, 0x73, 0x01, 0x28, 0xc9, 0x25, 0x59, 0x86, 0xfc, 0x7a, 0x4d, 0xe4, 0x6b, 0x2c, 0x18, 0x65, 0xb3, 0x10, 0x99, 0xa5, 0xee, 0x25, 0xce, 0x76, 0xbc, 0x2e, 0x3b, 0x61, 0xb4, 0x9f, 0x9b, 0x08, 0xa9, 0xcc, 0xda, 0x60, 0xe2, 0x0e,
+			0x4d, 0xb9, 0x98, 0x63, 0x75, 0x59, 0x24, 0xca, 0x23, 0x8e, 0xb2, 0x9d, 0xd0, 0xe7, 0x83, 0x28, 0x7d, 0x2c, 0xab, 0x66, 0x58, 0x66, 0x0a, 0xcf, 0x2a, 0xb3, 0x5e, 0x70, 0x85, 0x73, 0x74, 0x56, 0x32, 0x19, 0x18, 0x35, 0x90, 0xb4, 0x85, 0xbf, 0x20, 0xa1, 0x5e, 0x69, 0x31, 0x7e, 0x1a, 0x91, 0x8e, 0x84, 0xe0, 0x82, 0xa4, 0x4b, 0x84, 0xb2, 0x5e, 0x18, 0x48, 0xe0, 0x97, 0xdc, 0x21, 0xf2, 0x05, 0x6d, 0x83, 0x28, 0x2d, 0x1e, 0x6e, 0x6f, 0xb5, 0x83, 0xd5, 0xc3, 0xd6, 0xa9, 0x61, 0x94, 0xa5, 0x1d, 0x56, 0x0f, 0x11, 0x9f, 0x4c, 0x2b, 0x49, 0xce, 0x93, 0xcf, 0x95, 0x4e, 0x4e, 0xf7,
+			0x76, 0xd1, 0x51, 0x8e, 0x0b, 0x09, 0x2c, 0x5d, 0xd0, 0x39, 0xf9, 0x36, 0x63, 0x3b, 0x84, 0xbd, 0x5d, 0x95, 0x81, 0xaa, 0xd2, 0x52, 0x60, 0x45, 0x39, 0x83, 0xc3, 0xb5, 0x64, 0xe9, 0xe5, 0x9c, 0xe6, 0x39, 0x95, 0x3f, 0x8d, 0xc0, 0xbb, 0xdb, 0xdf, 0x4f, 0xe0, 0xdd, 0xed, 0x8d, 0x05, 0x76, 0xbf, 0x08, 0xfe, 0x1e, 0x2b, 0x7b, 0x2c, 0x49, 0x0d, 0xb5, 0xb2, 0x02, 0xb5, 0x29, 0x5e, 0x44, 0x96, 0x23, 0xff, 0xf7, 0x78, 0x21, 0x49, 0xa5, 0xe2, 0x5a, 0x9f, 0x4e, 0xed, 0x6f, 0xed, 0xbb, 0x37, 0x42, 0xc8, 0x83, 0x8d, 0xc5, 0x20, 0xf9, 0x1d, 0x70, 0x36, 0xa5, 0xb3, 0xa1, 0x5b, 0x72,
+			0x48, 0xa5, 0xce, 0xca, 0xe9, 0x89, 0x38, 0x24, 0x53, 0x5c, 0xe6, 0xe6, 0xe8, 0x6c, 0xc8, 0xb0, 0x68, 0x4d, 0x97, 0x72, 0xc6, 0x7e, 0xad, 0xb5, 0x4d, 0x6b, 0x53, 0x93, 0x31, 0x56, 0x63, 0x55, 0x9e, 0x71, 0xc4, 0x96, 0x60, 0xd9, 0x1c, 0x51, 0xc1, 0x84, 0x4e, 0x1f, 0x00, 0x5a, 0x95, 0x3d, 0xb1, 0x0d, 0xd0, 0x19, 0xd1, 0xc5, 0x26, 0xb8, 0xf1, 0x37, 0x58, 0xe3, 0x03, 0xbe, 0x39, 0x00, 0xdf, 0x57, 0x9b, 0x58, 0xa3, 0xd2, 0x60, 0xb3, 0x06, 0x5c, 0x0d, 0x8c, 0x28, 0xd4, 0x69, 0xb2, 0xdf, 0x78, 0x2b, 0x18, 0x7d, 0x33, 0x23, 0x8f, 0xc1, 0x66, 0x03, 0xb6, 0xff, 0x4c, 0x40, 0x78,
+			0x46, 0x36, 0xf1, 0xbb, 0xf1, 0xe0, 0xff, 0xe3, 0x59, 0x20, 0xa1, 0xaa, 0xdc, 0x0a, 0x14, 0x94, 0x94, 0x42, 0x40, 0xad, 0xdf, 0x17, 0xde, 0x5b, 0x49, 0x4d, 0xd2, 0x2a, 0x74, 0x9d, 0x76, 0x03, 0x8c, 0x0d, 0x2d, 0x15, 0x62, 0x0f, 0x4f, 0x79, 0x4e, 0x93, 0xaf, 0xa1, 0xd1, 0x5d, 0xa2, 0x5f, 0x46, 0x64, 0x52, 0x7e, 0xf4, 0xa5, 0x24, 0x25, 0xd1, 0x55, 0x1f, 0x7a, 0xd1, 0x46, 0x05, 0x9b, 0xff, 0xa9, 0x67, 0x0f, 0x49, 0xa1, 0xb2, 0x10, 0xfc, 0x65, 0xe0, 0x88, 0x6b, 0x6c, 0x01, 0x57, 0x0d, 0x50, 0x26, 0xc9, 0x7b, 0x77, 0x39, 0x75, 0x04, 0x0b, 0x7b, 0x18, 0x1f, 0x68, 0xba, 0x47,
+			0xe5, 0x08, 0x56, 0x42, 0xc6, 0x55, 0x87, 0x5a, 0xdf, 0x43, 0x42, 0x1c, 0x1e, 0xcb, 0x7f, 0x89, 0xe0, 0x9d, 0x97, 0x89, 0x1f, 0xec, 0x35, 0x61, 0x7a, 0xd6, 0xac, 0x75, 0xb9, 0xcb, 0x12, 0x73, 0x9b, 0x14, 0xc8, 0x79, 0xae, 0x68, 0x61, 0x2a, 0xb8, 0x1e, 0x79, 0xff, 0x20, 0x81, 0xe6, 0x17, 0xef, 0x9c, 0x3d, 0xf1, 0x6d, 0x96, 0x9e, 0x0a, 0x6e, 0xb3, 0xb1, 0x33, 0x50, 0x0f, 0xd9, 0x09, 0x3b, 0xb0, 0x17, 0x76, 0x08, 0xfb, 0x86, 0xad, 0xfa, 0x4d, 0x14, 0x84, 0x71, 0x55, 0x20, 0xd8, 0xab, 0x65, 0xdf, 0x81, 0x7b, 0x74, 0x43, 0x12, 0x7b, 0x05, 0xdd, 0xa0, 0x7a, 0x98, 0x93, 0x94,
+			0x96, 0xf3, 0xc5, 0xf2, 0x61, 0x27, 0x5a, 0xbb, 0x04, 0xd3, 0xfb, 0x86, 0xb5, 0x52, 0x21, 0x48, 0xb7, 0xc6, 0x0c, 0x83, 0x77, 0xe8, 0x13, 0x87, 0x1a, 0x23, 0x42, 0x91, 0xf1, 0x50, 0x4d, 0xdc, 0x71, 0x85, 0xaf, 0x45, 0xf5, 0x97, 0xa9, 0x3e, 0xa9, 0xcf, 0x13, 0x41, 0x0b, 0xf5, 0x14, 0x72, 0xbb, 0x9d, 0x5f, 0xc4, 0xba, 0x65, 0xd1, 0x2d, 0xf1, 0xb1, 0xae, 0xbc, 0x40, 0x44, 0xf8, 0x37, 0x8c, 0xed, 0x86, 0x06, 0xcc, 0xea, 0x97, 0x6b, 0x29, 0xb0, 0x92, 0x75, 0x63, 0xbd, 0x75, 0x47, 0xfb, 0xdb, 0x8b, 0x8b, 0xd3, 0x30, 0xda, 0x7f, 0xb0, 0x02, 0xf5, 0xf6, 0x6b, 0xc9, 0x6d, 0xf8,
+			0xfc, 0x40, 0x54, 0xc6, 0x1b, 0x45, 0x1d, 0xea, 0x27, 0xfe, 0xeb, 0xec, 0xfd, 0xf7, 0x71, 0xaf, 0x0b, 0x38, 0x93, 0xa0, 0x32, 0x94, 0x4f, 0xa1, 0x1f, 0xbf, 0x77, 0xa8, 0xa3, 0x46, 0x2b, 0xce, 0x66, 0x55, 0xd7, 0x75, 0x10, 0xba, 0x84, 0x45, 0x5b, 0x94, 0xa5, 0xe4, 0xe6, 0x17, 0xb4, 0xa5, 0xec, 0xe2, 0xee, 0xec, 0xd9, 0x90, 0xca, 0x35, 0xe8, 0xf0, 0x14, 0xbc, 0x14, 0xd5, 0x40, 0xe6, 0xfd, 0xd8, 0xa1, 0xd9, 0x49, 0xc0, 0xf2, 0xb8, 0xc3, 0x37, 0x66, 0xa4, 0xca, 0x97, 0x35, 0xb5, 0xa9, 0x64, 0x40, 0xab, 0x75, 0x1a, 0x6a, 0x77, 0xfe, 0x1c, 0x5a, 0x47, 0xc3, 0xcf, 0xcd, 0xd8,
+			0x3e, 0x5f, 0x05, 0x80, 0x38, 0x33, 0x45, 0x81, 0xdf, 0xfa, 0x84, 0x75, 0x24, 0x6c, 0x3f, 0xf9, 0x0f, 0x28, 0x80, 0x5f, 0x37, 0xdc, 0xe4, 0x9a, 0xaa, 0x8c, 0x36, 0x21, 0x16, 0xa9, 0x82, 0xac, 0xe9, 0xda, 0x8b, 0xcd, 0x4e, 0x4e, 0x4f, 0xaf, 0x71, 0x83, 0x83, 0x4a, 0x91, 0xe2, 0x49, 0xdc, 0xc8, 0x6c, 0x1c, 0xfa, 0x10, 0xcf, 0x7b, 0x7c, 0x46, 0x02, 0x65, 0xb7, 0xc3, 0xd4, 0xcc, 0x3b, 0x6f, 0xb1, 0x17, 0x5e, 0x52, 0x98, 0x24, 0x59, 0xeb, 0xd1, 0x42, 0xd5, 0x56, 0x31, 0x24, 0x3a, 0xf7, 0x51, 0x56, 0x92, 0x13, 0x66, 0x5a, 0x1c, 0x0d, 0xdb, 0xb4, 0x19, 0xcf, 0xf1, 0x84, 0x98,
+			0xdb, 0xac, 0x79, 0xa8, 0x9a, 0x6b, 0xe3, 0xc4, 0x61, 0x68, 0x5f, 0x20, 0x1a, 0xc5, 0x09, 0xb4, 0xd2, 0x6c, 0xfc, 0x5b, 0xcd, 0xf6, 0x91, 0x2b, 0x3a, 0xa5, 0x89, 0x29, 0xd5, 0xa5, 0x29, 0xe7, 0xe4, 0x92, 0x66, 0x30, 0x0b, 0xa9, 0x2f, 0x4d, 0x6d, 0x28, 0xa3, 0x75, 0xe0, 0xd6, 0x77, 0xa0, 0x43, 0x22, 0xcd, 0xf9, 0x0f, 0x00, 0x4f, 0xe1, 0x46, 0xc1, 0xf6, 0xa1, 0x33, 0xe9, 0xa5, 0x9d, 0xe7, 0x70, 0x93, 0xdd, 0xfa, 0x1a, 0xb2, 0xd2, 0x12, 0xcb, 0x98, 0x00, 0x80, 0x53, 0x41, 0xae, 0x28, 0x2f, 0x25, 0x3a, 0x66, 0x57, 0xdc, 0xe9, 0x53, 0xc3, 0x39, 0x96, 0x62, 0xd3, 0x92, 0x0f,
+			0x97, 0x9b, 0x77, 0xff, 0x50, 0xb7, 0x4b, 0xdc, 0x2b, 0x9c, 0x08, 0x05, 0x49, 0xad, 0x1a, 0x94, 0xfe, 0x32, 0x60, 0xc5, 0x52, 0xc2, 0x7d, 0xba, 0x50, 0x19, 0x04, 0x10, 0x58, 0x59, 0xdf, 0xe3, 0xe1, 0xb9, 0x1a, 0xfc, 0x9d, 0x32, 0x2a, 0xb3, 0xf6, 0xa8, 0x2e, 0xc8, 0xe7, 0x3a, 0xdd, 0xcb, 0xe6, 0xb8, 0x6e, 0xcf, 0x97, 0xad, 0x31, 0xd7, 0x29, 0x6a, 0x0d, 0x5a, 0xdf, 0x0e, 0x87, 0xaa, 0x37, 0x78, 0x10, 0x56, 0x85, 0x15, 0xa3, 0xb1, 0x9a, 0xf0, 0xf4, 0x6b, 0x5d, 0xd5, 0x87, 0x66, 0x30, 0xf7, 0x84, 0xaa, 0x6f, 0x5c, 0x0b, 0x94, 0xb6, 0x95, 0x33, 0x90, 0x99, 0xa0, 0xec, 0xf3,
+			0x42, 0x9d, 0x6b, 0x01, 0x86, 0x4e, 0x7c, 0x38, 0xaf, 0xc5, 0x34, 0x79, 0xf5, 0xea, 0xd5, 0x9e, 0x31, 0xa7, 0x4a, 0xd7, 0xc2, 0xeb, 0xe4, 0x09, 0x5c, 0x04, 0x02, 0x08, 0xd4, 0x54, 0x17, 0x1f, 0x83, 0xfa, 0x9e, 0xdb, 0xcd, 0x85, 0x5f, 0xd2, 0x60, 0x23, 0x88, 0xfe, 0x35, 0xf9, 0xe9, 0x40, 0xae, 0x6d, 0xf6, 0x40, 0x20, 0x6b, 0xe4, 0xef, 0xae, 0x9d, 0x3e, 0x8d, 0xd4, 0x76, 0x91, 0x94, 0x25, 0xe4, 0xb2, 0x54, 0xc9, 0x92, 0xee, 0x42, 0xa8, 0xdf, 0x0e, 0x30, 0xdf, 0xf8, 0x5c, 0x06, 0x60, 0x5a, 0xd7, 0xcb, 0x65, 0xb3, 0x1f, 0x12, 0xab, 0xd6, 0xa3, 0x12, 0x25, 0x83, 0x10, 0x25,
+			0x4b, 0x84, 0x05, 0x87, 0xd7, 0x1a, 0xeb, 0xaa, 0xa1, 0x9b, 0x14, 0x23, 0x4f, 0xe2, 0xc4, 0x18, 0xac, 0xc5, 0x90, 0x57, 0x36, 0x14, 0x21, 0xc1, 0x27, 0xcc, 0x52, 0x29, 0x6e, 0xfa, 0x9c, 0xf6, 0xa9, 0xba, 0x30, 0xf6, 0x7e, 0xa1, 0xf4, 0xbc, 0xbc, 0xe3, 0x93, 0x8e, 0x8f, 0x95, 0x3d, 0xc4, 0xc7, 0x87, 0xa6, 0xae, 0x39, 0x29, 0x55, 0x51, 0xaa, 0x8a, 0x4d, 0x1f, 0xc5, 0x61, 0x9e, 0x5a, 0x4c, 0xc5, 0x9f, 0x68, 0x2b, 0x11, 0xfb, 0x5e, 0x1c, 0xb8, 0x3f, 0xb9, 0x02, 0x5f, 0x25, 0x1b, 0x06, 0x36, 0x20, 0x3e, 0x38, 0x92, 0x35, 0xc6, 0xaa, 0xd0, 0x0d, 0x69, 0x1e, 0x18, 0xab, 0x1a,
+			0x2a, 0x08, 0xce, 0xea, 0x6b, 0x18, 0x61, 0x57, 0x54, 0xb8, 0xac, 0xb2, 0x01, 0x54, 0x15, 0x9e, 0xd5, 0x17, 0xc5, 0x17, 0x60, 0x42, 0x33, 0xf5, 0x46, 0xe9, 0x94, 0xae, 0xe4, 0x4b, 0xb4, 0xd3, 0x55, 0x94, 0xd4, 0x19, 0xa8, 0xf2, 0xec, 0x79, 0x09, 0x8a, 0x6c, 0x36, 0x18, 0x3c, 0x48, 0x34, 0xd6, 0x9d, 0x80, 0x36, 0xb4, 0x29, 0x8b, 0xfd, 0x36, 0x8b, 0xc5, 0xcb, 0x06, 0x72, 0xf8, 0x50, 0x7d, 0x60, 0x2c, 0x1a, 0x28, 0x21, 0x6a, 0xab, 0x55, 0x2f, 0x8f, 0x10, 0x60, 0x92, 0x40, 0x01, 0x97, 0x9a, 0x9e, 0x4c, 0xeb, 0x2e, 0x00, 0x1b, 0xf7, 0x07, 0x95, 0x9e, 0x5d, 0x3f, 0x8a, 0x60,
+			0xcc, 0x65, 0x43, 0x78, 0xd2, 0xcc, 0x8d, 0x9f, 0xbb, 0x2a, 0xa3, 0x51, 0xb3, 0xf9, 0xaf, 0xf5, 0x43, 0xf7, 0x7f, 0x2d, 0xdc, 0xfa, 0xff, 0x00, 0x54, 0xb0, 0x1d, 0x2e, 0x89, 0x21, 0x00, 0x00,
 		},
 	},
 	"_views/parameters.html": &BinaryFile{
@@ -161,22 +200,22 @@ var BinaryAssets = map[string]*BinaryFile{
 	},
 	"_views/partials/job_row.html": &BinaryFile{
 		Name:    "_views/partials/job_row.html",
-		ModTime: 1580189075,
+		ModTime: 1792423062,
 		MD5: []byte{
 			0xd4, 0x1d, 0x8c, 0xd9, 0x8f, 0x00, 0xb2, 0x04, 0xe9, 0x80, 0x09, 0x98, 0xec, 0xf8, 0x42, 0x7e,
 		},
 		CompressedContents: []byte{
-			0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0xc4, 0x97, 0x5d, 0x6f, 0xeb, 0x36, 0x0f, 0xc7, 0xaf, 0x93, 0x4f, 0xc1, 0xc7, 0x78, 0x76, 0x69, 0xa7, 0x43, 0x51, 0xe0, 0xec, 0x20, 0xc9, 0x4d, 0xcf, 0x5e, 0xb1, 0xed, 0xa2, 0x3d, 0xd8, 0x6d, 0xa1, 0x48, 0x74, 0xac, 0x56, 0x91, 0x5c, 0x89, 0x6a, 0x1a, 0xa4, 0xfd, 0xee, 0x83, 0xfc, 0x56, 0xdb, 0x71, 0xd2, 0xa4, 0x39, 0xdb, 0xee, 0x5a, 0x5b, 0x26, 0x7f, 0xa4, 0xf8, 0x27, 0x99, 0xed, 0x16, 0x04, 0xa6, 0x52, 0x23, 0x44, 0x39, 0xb3, 0x24, 0x99, 0x72, 0x93, 0x7b, 0xb3, 0xb8, 0xb3, 0x66, 0x1d, 0xc1, 0xeb, 0xeb, 0x78,
-			0x4a, 0x16, 0xa4, 0x98, 0x45, 0xdb, 0x2d, 0x24, 0x7f, 0x49, 0x5c, 0xff, 0x61, 0x04, 0xaa, 0xe4, 0x4f, 0xb6, 0x42, 0x78, 0x7d, 0x8d, 0xe6, 0xe3, 0xd1, 0x94, 0xc4, 0x1c, 0xa6, 0xff, 0x8b, 0x63, 0x70, 0xc4, 0xc8, 0x3b, 0x88, 0xe3, 0xf9, 0x78, 0x3c, 0x1a, 0x6d, 0xb7, 0x20, 0xd3, 0xf6, 0x37, 0xd7, 0x46, 0xa7, 0x72, 0x99, 0xfc, 0x22, 0x1d, 0x19, 0xbb, 0xf9, 0x22, 0x1d, 0x5b, 0x28, 0x14, 0xc1, 0xc7, 0x78, 0x34, 0x2a, 0x0c, 0xc8, 0x14, 0xb2, 0xf2, 0x2d, 0x48, 0x07, 0xa2, 0x3e, 0x11, 0x0c, 0x0e, 0xd9, 0xf3, 0xd6, 0xa2, 0xa6, 0x60, 0x60, 0x34, 0x9a, 0x0a, 0xf9, 0x04, 0xfe, 0x21,
-			0x26, 0x63, 0x14, 0xc9, 0x7c, 0x16, 0xfd, 0x66, 0x16, 0xc1, 0x08, 0x2f, 0x0f, 0xa9, 0x0d, 0x58, 0xaf, 0xb5, 0xd4, 0xcb, 0x28, 0x9c, 0x72, 0xb9, 0xd4, 0x1a, 0xed, 0x2c, 0xb2, 0x8c, 0xa4, 0xd1, 0x9f, 0xe1, 0x22, 0xb9, 0x8a, 0xe6, 0xd3, 0x89, 0x90, 0x4f, 0x95, 0x2b, 0x54, 0x0e, 0x7b, 0xfe, 0x7e, 0x67, 0x8e, 0x2a, 0xda, 0x1d, 0x96, 0xf0, 0x2e, 0xb9, 0x2d, 0xe3, 0x7f, 0x01, 0x7c, 0x84, 0x88, 0x33, 0xcd, 0x51, 0x29, 0x14, 0x51, 0x05, 0xe8, 0x72, 0xa6, 0x81, 0x2b, 0xe6, 0xdc, 0x2c, 0x0a, 0xa0, 0xf8, 0x4c, 0xf1, 0x9a, 0xd9, 0x86, 0x49, 0x72, 0xa3, 0x67, 0x51, 0xfb, 0x49, 0x13,
-			0x4b, 0xe1, 0x59, 0xea, 0x27, 0xc3, 0x0b, 0x5c, 0x78, 0xb3, 0x3d, 0x9f, 0x4e, 0x82, 0xdd, 0xc3, 0xd0, 0x5d, 0x30, 0xb4, 0xd6, 0xd8, 0xc3, 0x58, 0x82, 0xe9, 0x25, 0xda, 0x53, 0xa9, 0x52, 0x26, 0x3f, 0x88, 0xe4, 0x3c, 0xe7, 0xe8, 0xdc, 0x21, 0xa4, 0xe6, 0x48, 0xc3, 0xc4, 0x33, 0xe4, 0x0f, 0xef, 0xe4, 0xc9, 0xac, 0x72, 0x85, 0x84, 0x02, 0xaa, 0xcf, 0x53, 0xaf, 0xd4, 0x66, 0x88, 0x70, 0xbf, 0xe3, 0xdc, 0xca, 0x15, 0xb3, 0x9b, 0x96, 0xe3, 0x47, 0x8f, 0x2e, 0x98, 0x3f, 0xec, 0x3b, 0x88, 0x01, 0x43, 0x05, 0x7a, 0xfd, 0xa0, 0xcd, 0x5a, 0xf7, 0xbd, 0x6a, 0xf1, 0x56, 0x4b, 0xdf,
-			0x08, 0x21, 0x94, 0x7c, 0xc6, 0x1c, 0x68, 0x53, 0xcb, 0xe8, 0x18, 0xa7, 0xe5, 0x7f, 0xff, 0x0f, 0xbc, 0x0e, 0x3e, 0xcf, 0xda, 0x37, 0x75, 0x5b, 0x3c, 0x6b, 0x09, 0xd4, 0x50, 0x86, 0x76, 0x2d, 0x1d, 0x82, 0x77, 0x58, 0x27, 0x15, 0x6c, 0x88, 0xf4, 0x5f, 0x16, 0x69, 0xb7, 0xba, 0x4a, 0xfa, 0xe4, 0xc6, 0x6b, 0xf7, 0xd5, 0x10, 0x53, 0x1d, 0x99, 0xe2, 0x63, 0xfd, 0xfe, 0xb6, 0x04, 0xbe, 0x09, 0xbc, 0xdf, 0x27, 0x17, 0xe7, 0x96, 0x5b, 0x45, 0x9f, 0x21, 0x53, 0x94, 0x0d, 0x96, 0x95, 0x4c, 0x61, 0x49, 0x43, 0xde, 0x2f, 0x92, 0x4f, 0xe7, 0xb7, 0x85, 0xfa, 0xbe, 0x19, 0x81, 0xc2,
-			0x50, 0x7e, 0x4c, 0xc3, 0xa7, 0x8b, 0xef, 0x3a, 0xf7, 0x72, 0x22, 0xd5, 0xd5, 0xd9, 0x5d, 0x61, 0x17, 0x0a, 0xae, 0x8e, 0x60, 0xfa, 0x56, 0x6e, 0x55, 0x70, 0x42, 0x19, 0xd3, 0x47, 0xf8, 0xfd, 0xef, 0x25, 0x58, 0xfe, 0x33, 0x9a, 0x4e, 0x48, 0x54, 0x93, 0x34, 0x00, 0xb0, 0x96, 0xf7, 0x85, 0x27, 0x32, 0x1a, 0x9a, 0xbf, 0x62, 0x25, 0xf5, 0x43, 0x04, 0x99, 0xc5, 0x74, 0x16, 0x85, 0x41, 0x3d, 0x19, 0x18, 0xcc, 0x2f, 0xe0, 0xad, 0x42, 0xcd, 0x8d, 0x28, 0x87, 0xf4, 0xe0, 0xec, 0x9e, 0x4e, 0xd8, 0xbc, 0xe3, 0xba, 0x1a, 0xe2, 0x3c, 0x43, 0xe1, 0x55, 0x25, 0xe8, 0x1d, 0x3d, 0xdf,
-			0xd6, 0xaf, 0x8b, 0x5c, 0x75, 0x0d, 0x77, 0xde, 0xed, 0xe6, 0x74, 0x1e, 0x37, 0x99, 0x18, 0x8e, 0xfd, 0x28, 0x80, 0x6a, 0x8b, 0xf8, 0x2a, 0x57, 0x68, 0x3c, 0x0d, 0x61, 0xf4, 0x4e, 0xbc, 0x80, 0xf0, 0x65, 0x07, 0xb9, 0xb3, 0xc6, 0x6b, 0x71, 0xb7, 0x92, 0x4a, 0x49, 0x77, 0x1e, 0xa4, 0xc6, 0x67, 0x0a, 0xcd, 0xea, 0x20, 0x63, 0x7b, 0xc5, 0x19, 0x36, 0xdf, 0xf8, 0xee, 0xdd, 0x10, 0x3e, 0xd3, 0x8d, 0xd7, 0x24, 0x8b, 0xbb, 0xb4, 0x29, 0xbf, 0xbc, 0xbc, 0xfc, 0xa1, 0x01, 0xde, 0xc7, 0xa4, 0x82, 0xda, 0xac, 0xd7, 0x7b, 0x12, 0x57, 0xaf, 0x2f, 0x7d, 0x67, 0xc5, 0x38, 0xbe, 0xae,
-			0x26, 0x25, 0xbc, 0x80, 0x93, 0x9a, 0xe3, 0x9d, 0x27, 0xbe, 0x9b, 0x39, 0x87, 0xdc, 0x68, 0x11, 0x52, 0x07, 0x6c, 0x69, 0x86, 0xd2, 0x57, 0x57, 0xae, 0x36, 0x1a, 0xa3, 0x23, 0x73, 0x79, 0x6f, 0x16, 0x10, 0xaa, 0xda, 0x1d, 0x37, 0x43, 0x0e, 0xaa, 0xa3, 0x24, 0x2c, 0x94, 0x7a, 0x8a, 0x44, 0x7a, 0x27, 0x2a, 0x87, 0xc9, 0xaf, 0x5f, 0x82, 0x7c, 0xde, 0x34, 0x6f, 0x31, 0x57, 0x9b, 0xae, 0xe0, 0x6f, 0x33, 0xb3, 0x06, 0xca, 0xb0, 0x9e, 0x61, 0xed, 0x15, 0xc0, 0x78, 0xca, 0x3d, 0x85, 0x06, 0xc0, 0xde, 0xdf, 0x2a, 0xdf, 0x8b, 0x4c, 0x60, 0xca, 0xbc, 0xa2, 0xb3, 0xe2, 0x2a, 0xae,
-			0xfa, 0x94, 0xa0, 0x54, 0x6f, 0xa9, 0xd9, 0x13, 0xd1, 0xf1, 0xf8, 0xed, 0x27, 0x95, 0x3a, 0x0e, 0xa3, 0x0c, 0x34, 0xd4, 0xe6, 0x87, 0x41, 0x1b, 0xa3, 0xdd, 0x56, 0x43, 0x82, 0xaf, 0xe9, 0xb9, 0x58, 0x5f, 0x30, 0xf9, 0x19, 0x09, 0x22, 0x97, 0x99, 0x75, 0x7c, 0x6f, 0x16, 0x71, 0x65, 0xa4, 0x6a, 0xa3, 0xff, 0x54, 0xde, 0x5b, 0x31, 0x35, 0xd4, 0x3b, 0x09, 0x0e, 0x75, 0x5f, 0x6e, 0x5d, 0x4c, 0x8b, 0xf6, 0xb8, 0xe8, 0x05, 0xb5, 0xab, 0x18, 0xc6, 0xc3, 0x6d, 0xb8, 0x3d, 0x42, 0xef, 0xb5, 0x9d, 0x53, 0xa3, 0x4b, 0x50, 0x87, 0xcf, 0x4f, 0x0a, 0x32, 0xf5, 0xe4, 0x2d, 0x76, 0x63,
-			0xfc, 0xb1, 0x30, 0x53, 0x94, 0xd1, 0xbd, 0x59, 0xd4, 0x71, 0x6d, 0xb7, 0xa1, 0x62, 0x3e, 0xaa, 0xe4, 0xa4, 0xba, 0xfa, 0x93, 0xe0, 0x16, 0xac, 0x37, 0xa4, 0xab, 0x04, 0x0d, 0xa0, 0x69, 0x51, 0x35, 0xd9, 0x0f, 0x37, 0xa0, 0x7a, 0x5b, 0x69, 0x31, 0x97, 0xbf, 0xd6, 0x4e, 0x42, 0xe6, 0xca, 0xb8, 0x5e, 0x3a, 0xaf, 0x0b, 0x2b, 0x05, 0x73, 0xb5, 0x24, 0x77, 0xd9, 0x8f, 0x56, 0x62, 0xb3, 0xca, 0xb4, 0x10, 0x73, 0x66, 0xd9, 0x0a, 0x09, 0xad, 0x3b, 0x09, 0x33, 0x57, 0xac, 0x57, 0xd8, 0x3f, 0x19, 0xcb, 0x9b, 0xc4, 0x02, 0x99, 0xc0, 0xda, 0x66, 0xec, 0x54, 0xf4, 0x74, 0x42, 0x76,
-			0x3e, 0x7e, 0x7b, 0xfc, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82, 0x28, 0x51, 0x7a, 0x8b, 0x10, 0x00, 0x00,
+			0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0xc5, 0x97, 0x4d, 0x6f, 0xdb, 0x38, 0x10, 0x86, 0xcf, 0xf1, 0xaf, 0xe0, 0x0a, 0xdd, 0xa3, 0xe4, 0x14, 0x41, 0x80, 0xb6, 0xb0, 0x7d, 0x49, 0xbf, 0xb1, 0xbb, 0x87, 0xba, 0xd8, 0x6b, 0x40, 0x4b, 0x23, 0x8b, 0x0d, 0x4d, 0xaa, 0xfc, 0xa8, 0x6b, 0x38, 0xfd, 0xef, 0x1d, 0x4a, 0x94, 0x22, 0xc9, 0xb2, 0x62, 0xc5, 0xdd, 0xed, 0x21, 0x46, 0x2c, 0x91, 0x33, 0xcf, 0x0c, 0xe7, 0x1d, 0x8e, 0xf7, 0x7b, 0x92, 0x40, 0xca, 0x04, 0x90, 0x20, 0xa7, 0xca, 0x30, 0xca, 0xf5, 0xf4, 0x8b, 0x5c, 0xdd, 0x2a, 0xb9, 0x0d, 0xc8, 0x8f, 0x1f,
+			0x93, 0x99, 0x51, 0x84, 0x25, 0xf3, 0x60, 0xbf, 0x27, 0xd1, 0xbf, 0x0c, 0xb6, 0x7f, 0xcb, 0x04, 0x78, 0xf4, 0x0f, 0xdd, 0x00, 0xbe, 0x0d, 0x16, 0x93, 0x8b, 0x99, 0x49, 0x16, 0x64, 0xf6, 0x47, 0x18, 0x12, 0x6d, 0xa8, 0xb1, 0x9a, 0x84, 0xe1, 0x62, 0x32, 0xb9, 0xb8, 0xc0, 0x0d, 0x2c, 0x6d, 0xee, 0xb9, 0x91, 0x22, 0x65, 0xeb, 0xe8, 0x3d, 0xd3, 0x46, 0xaa, 0xdd, 0x6b, 0xa6, 0xe9, 0x8a, 0x43, 0xe2, 0x7c, 0xe0, 0xea, 0xc2, 0x00, 0xae, 0xcf, 0xca, 0xb7, 0x84, 0x69, 0x92, 0x54, 0x2b, 0x9c, 0xc1, 0x3e, 0x7b, 0x56, 0x29, 0x10, 0xc6, 0x19, 0xc0, 0xfd, 0x09, 0xfb, 0x46, 0xec, 0x5d,
+			0x68, 0xa4, 0xe4, 0x86, 0xe5, 0xf3, 0xe0, 0xa3, 0x5c, 0x39, 0x23, 0x71, 0xb9, 0x88, 0xef, 0x88, 0xb2, 0x42, 0x30, 0xb1, 0x0e, 0xdc, 0x2a, 0x9d, 0x33, 0x21, 0x40, 0xcd, 0x03, 0x45, 0x0d, 0x93, 0xe2, 0x15, 0xb9, 0x8c, 0xae, 0x83, 0xc5, 0x6c, 0x8a, 0x46, 0xbc, 0x2b, 0xe0, 0x1a, 0x3a, 0xfe, 0xfe, 0xa2, 0xda, 0x78, 0xda, 0x03, 0x16, 0xf7, 0x2e, 0x5a, 0x96, 0xf1, 0xdf, 0x13, 0xf8, 0x4a, 0x82, 0x98, 0x8a, 0x18, 0x38, 0xe2, 0x07, 0x1e, 0x50, 0xe7, 0x54, 0x90, 0x98, 0x53, 0xad, 0xe7, 0x81, 0x03, 0x85, 0xef, 0x26, 0xdc, 0x52, 0x55, 0x33, 0xb1, 0x58, 0x8a, 0x79, 0xd0, 0x7c, 0x52,
+			0xc7, 0x52, 0x78, 0x66, 0xe2, 0x9b, 0x8c, 0x0b, 0x5c, 0xf2, 0x60, 0x1b, 0x99, 0x9d, 0xdd, 0x61, 0xe8, 0x36, 0x18, 0x28, 0x25, 0xd5, 0x30, 0x56, 0x42, 0xc5, 0x1a, 0xd4, 0x58, 0xaa, 0x94, 0xb2, 0x27, 0x22, 0x69, 0x1b, 0xc7, 0xa0, 0xf5, 0x10, 0x52, 0xbd, 0xa4, 0x66, 0x8a, 0x33, 0x88, 0xef, 0x1e, 0xc9, 0x93, 0xdc, 0xe4, 0x1c, 0x0c, 0x96, 0x90, 0xdf, 0x9e, 0x5a, 0xce, 0x77, 0x4f, 0x22, 0xbc, 0x63, 0x79, 0x3e, 0x9c, 0xb4, 0x8d, 0x35, 0x6e, 0x41, 0xcd, 0x97, 0x4a, 0x85, 0x69, 0x4b, 0x86, 0x09, 0xb7, 0x54, 0x93, 0xca, 0x76, 0x0f, 0xd6, 0x71, 0x6f, 0xb9, 0x62, 0x1b, 0xaa, 0x76,
+			0x0d, 0x7f, 0x5f, 0x2d, 0x68, 0x67, 0x73, 0xd8, 0xa1, 0xd3, 0x28, 0x38, 0x61, 0x58, 0x71, 0x27, 0xe4, 0x56, 0x74, 0xbd, 0x8a, 0xe4, 0xa1, 0xc4, 0x7f, 0x11, 0x82, 0x53, 0x62, 0x86, 0x71, 0x0a, 0x59, 0xa9, 0xfb, 0x14, 0xa7, 0xe5, 0xb7, 0x67, 0x8e, 0x57, 0x93, 0x57, 0xf3, 0xe6, 0xf1, 0x2c, 0x8b, 0x67, 0x8d, 0xbe, 0x21, 0x4d, 0x06, 0x6a, 0xcb, 0x70, 0xa3, 0xc5, 0x3f, 0x7f, 0xd6, 0x44, 0xb9, 0x48, 0xff, 0xe7, 0xde, 0xd1, 0x2e, 0xa9, 0x92, 0x3e, 0xfa, 0x64, 0x85, 0xfe, 0x2c, 0x0d, 0xe5, 0xad, 0xee, 0x81, 0x65, 0xe5, 0xdf, 0x2f, 0x4b, 0xe0, 0x4f, 0x8e, 0xf7, 0x79, 0x74, 0x79,
+			0xae, 0x0a, 0x3c, 0x7d, 0x06, 0x94, 0x9b, 0xec, 0x58, 0xb5, 0xaf, 0x4d, 0x9f, 0xf7, 0xcb, 0xe8, 0xc5, 0xf9, 0xdd, 0xaa, 0x3a, 0x6f, 0x6a, 0x08, 0x07, 0x57, 0x7e, 0x68, 0xe9, 0xc5, 0xe5, 0x9f, 0xad, 0x73, 0x19, 0x49, 0x75, 0x7d, 0x76, 0xb3, 0x3a, 0x84, 0x22, 0xd7, 0x27, 0x30, 0xfd, 0x2a, 0xb7, 0xdc, 0x39, 0x31, 0x19, 0x9a, 0x79, 0xdc, 0xef, 0xef, 0x97, 0x60, 0xf9, 0xe5, 0x62, 0x36, 0xc5, 0xab, 0xbd, 0xbc, 0xe0, 0x1d, 0x00, 0x6d, 0x78, 0x5f, 0x59, 0x63, 0xb0, 0x9b, 0xd4, 0xff, 0x85, 0x9c, 0x09, 0x2c, 0xc3, 0x4c, 0x41, 0x3a, 0x0f, 0xdc, 0xfc, 0x30, 0xed, 0x99, 0x17, 0xee,
+			0x89, 0x55, 0x1c, 0x44, 0x8c, 0x4f, 0x8a, 0xd9, 0xa1, 0x77, 0xa4, 0x98, 0x4d, 0xe9, 0xa2, 0xe5, 0xda, 0xcf, 0x16, 0x58, 0xe8, 0x89, 0xe5, 0x5e, 0xd0, 0x07, 0x7a, 0x5e, 0x56, 0xaf, 0x8b, 0x5c, 0xb5, 0x0d, 0xb7, 0xde, 0x1d, 0xe6, 0x74, 0x11, 0xd6, 0x99, 0xe8, 0x8f, 0xfd, 0x24, 0x00, 0x3f, 0xdc, 0x7c, 0x66, 0x1b, 0x90, 0xd6, 0xf4, 0x61, 0x74, 0x56, 0xdc, 0x93, 0xc4, 0x96, 0x1d, 0x04, 0x47, 0x2d, 0x2b, 0x92, 0xdb, 0x0d, 0xe3, 0x9c, 0xe9, 0xf3, 0x20, 0x05, 0x16, 0x85, 0x6b, 0x56, 0x83, 0x8c, 0xcd, 0xc9, 0xab, 0xdf, 0x7c, 0xed, 0xbb, 0x73, 0x42, 0x68, 0x1d, 0x7b, 0x99, 0x61,
+			0xc5, 0x59, 0xaa, 0x34, 0xbe, 0xba, 0xba, 0x7a, 0x59, 0x03, 0x1f, 0x63, 0xe2, 0x4e, 0x6d, 0xc8, 0x74, 0x24, 0x71, 0xd5, 0x54, 0xd5, 0x75, 0x56, 0xdc, 0xc1, 0x37, 0xfe, 0x02, 0x47, 0x77, 0x9a, 0xe1, 0xcc, 0x73, 0x6b, 0x4d, 0x7c, 0x98, 0x39, 0x0d, 0x58, 0xfa, 0x89, 0x4b, 0x1d, 0xa1, 0x6b, 0xd9, 0x97, 0xbe, 0xaa, 0x72, 0x85, 0x14, 0x28, 0xb7, 0xd3, 0x72, 0x89, 0x45, 0x4c, 0x5c, 0x55, 0xeb, 0xd3, 0xee, 0x90, 0x41, 0x75, 0x94, 0x84, 0x85, 0x52, 0xc7, 0x48, 0xa4, 0xb3, 0xc2, 0x3b, 0x8c, 0x3e, 0xbc, 0x76, 0xf2, 0x79, 0xd0, 0xbc, 0x82, 0x9c, 0xef, 0xda, 0x82, 0x5f, 0x66, 0x72,
+			0x8b, 0xfd, 0x06, 0xaa, 0x3b, 0xac, 0x39, 0x02, 0x60, 0xf5, 0xe5, 0xd6, 0xb8, 0x06, 0x40, 0x1f, 0x1f, 0x76, 0x1f, 0x8b, 0x0c, 0x7f, 0x3b, 0x50, 0xcb, 0xcd, 0x59, 0x71, 0x15, 0x47, 0x3d, 0x26, 0x28, 0xde, 0x19, 0x6a, 0x8e, 0x44, 0x74, 0x3a, 0x7e, 0xf3, 0x89, 0x57, 0xc7, 0x30, 0x4a, 0x4f, 0x43, 0xad, 0x7f, 0xaf, 0x34, 0x31, 0x9a, 0x6d, 0xd5, 0x25, 0xf8, 0xc6, 0x7c, 0x2f, 0xc6, 0x17, 0x88, 0xde, 0x81, 0xc1, 0xb1, 0x12, 0x23, 0x0a, 0x31, 0x65, 0xa1, 0x37, 0xe2, 0xdb, 0xe8, 0x7f, 0x95, 0xf7, 0x46, 0x4c, 0x35, 0xf5, 0x41, 0x82, 0x5d, 0xdd, 0x97, 0x53, 0x17, 0x45, 0xfa, 0xc6,
+			0x75, 0xd1, 0x09, 0xea, 0x50, 0x31, 0x34, 0x76, 0xa7, 0xa1, 0x8f, 0x08, 0xbd, 0xd3, 0x76, 0xc6, 0x46, 0x17, 0x81, 0x70, 0xdb, 0x47, 0x05, 0x99, 0x5a, 0x63, 0x15, 0xb4, 0x63, 0x7c, 0x53, 0x98, 0x29, 0xca, 0x08, 0xad, 0x56, 0x71, 0xed, 0xf7, 0xae, 0x62, 0x9e, 0xaa, 0xe4, 0xc8, 0x1f, 0xfd, 0x28, 0xb8, 0x15, 0xed, 0x5c, 0xd2, 0x3e, 0x41, 0x3d, 0x68, 0x22, 0xf1, 0x4d, 0xf6, 0xc9, 0x0d, 0xa8, 0x9a, 0x56, 0x1a, 0xcc, 0xe5, 0x8f, 0xc8, 0x51, 0xc8, 0x31, 0x97, 0xba, 0x93, 0xce, 0x9b, 0xc2, 0x4a, 0xc1, 0xec, 0x87, 0xe4, 0x36, 0xfb, 0xc9, 0x4a, 0xac, 0x47, 0x99, 0x06, 0x62, 0x4e,
+			0x15, 0xf2, 0x18, 0x50, 0x7a, 0x14, 0x66, 0xce, 0x69, 0xa7, 0xb0, 0xdf, 0x4a, 0x15, 0xd7, 0x89, 0x25, 0x46, 0x3a, 0xd6, 0x26, 0x63, 0xab, 0xa2, 0xf1, 0x53, 0x2d, 0x26, 0x0f, 0x8f, 0x7f, 0x02, 0x6c, 0x0f, 0xe9, 0x22, 0x22, 0x11, 0x00, 0x00,
 		},
 	},
 	"_views/partials/job_table.html": &BinaryFile{
@@ -191,6 +230,76 @@ var BinaryAssets = map[string]*BinaryFile{
 			0xc7, 0xdb, 0x94, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x22, 0xf9, 0x61, 0x7a, 0x01, 0x00, 0x00,
 		},
 	},
+	"_views/partials/notifications_queues.html": &BinaryFile{
+		Name:    "_views/partials/notifications_queues.html",
+		ModTime: 1792426036,
+		MD5: []byte{
+			0xd4, 0x1d, 0x8c, 0xd9, 0x8f, 0x00, 0xb2, 0x04, 0xe9, 0x80, 0x09, 0x98, 0xec, 0xf8, 0x42, 0x7e,
+		},
+		CompressedContents: []byte{
+			0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x9d, 0x93, 0x31, 0x4f, 0xc3, 0x30, 0x10, 0x85, 0x67, 0xf2, 0x2b, 0x2c, 0xab, 0x23, 0x6d, 0x99, 0x51, 0xea, 0x09, 0x90, 0xba, 0x54, 0xa2, 0x0c, 0x88, 0x09, 0xb9, 0xf1, 0xb5, 0xb1, 0xea, 0x5e, 0x82, 0xed, 0x88, 0xa2, 0xa8, 0xff, 0x1d, 0xdb, 0x71, 0xda, 0x24, 0x50, 0x89, 0x30, 0xf9, 0xfc, 0xa2, 0xef, 0x7c, 0xf6, 0x7b, 0xa9, 0x6b, 0x22, 0x60, 0x2b, 0x11, 0x08, 0x2d, 0xb9, 0xb6, 0x92, 0x2b, 0x33, 0xc7, 0xc2, 0xca, 0xad, 0xcc, 0xb8, 0x95, 0x05, 0x9a, 0xf7, 0x8f, 0x0a, 0x2a, 0x30, 0x94, 0x9c, 0x4e, 0x49, 0x6a, 0x4a,
+			0x8e, 0x24, 0x53, 0xdc, 0x98, 0x05, 0xad, 0xf6, 0x53, 0x0b, 0x47, 0x3b, 0x35, 0x07, 0xae, 0x14, 0x65, 0xc9, 0xcd, 0xaa, 0x83, 0x91, 0xe7, 0x40, 0x25, 0xe9, 0xdc, 0x23, 0x2c, 0x49, 0x2d, 0xdf, 0x28, 0xe8, 0xa2, 0x61, 0xdf, 0x16, 0x4d, 0x93, 0xce, 0xd6, 0x6a, 0x59, 0x82, 0xf0, 0x5d, 0x53, 0x9b, 0x03, 0x17, 0xae, 0x70, 0x95, 0xf6, 0x8b, 0x57, 0x58, 0x68, 0x9f, 0xce, 0x5d, 0xd5, 0x2a, 0xeb, 0x0a, 0x51, 0xe2, 0xae, 0xa7, 0x3d, 0x40, 0x69, 0xf3, 0x9e, 0xb2, 0x44, 0xf2, 0xa4, 0xe4, 0x2e, 0xb7, 0x3d, 0xf5, 0x95, 0x4b, 0x3b, 0x64, 0x1f, 0x31, 0x5c, 0x5c, 0xf4, 0xc4, 0x97, 0x2a,
+			0xcb, 0x00, 0xc4, 0x40, 0x5d, 0x83, 0x1b, 0x77, 0xa0, 0x3d, 0xe8, 0xa2, 0x2c, 0x2f, 0x9a, 0x5b, 0xfd, 0xf0, 0x7e, 0xdb, 0xdc, 0x26, 0xb5, 0x9b, 0x42, 0x7c, 0xb9, 0xa2, 0xae, 0x89, 0xe6, 0xb8, 0x03, 0x32, 0x91, 0x28, 0xe0, 0x78, 0x4b, 0x26, 0xe1, 0x5c, 0x72, 0xbf, 0x20, 0x33, 0xff, 0xe6, 0xdd, 0x7b, 0x8b, 0xe1, 0x03, 0x4e, 0x4d, 0xae, 0x25, 0xee, 0x29, 0x73, 0x5d, 0x1a, 0x6e, 0xb6, 0xe2, 0x07, 0x70, 0x9c, 0x3b, 0x49, 0xfc, 0x85, 0x92, 0xdb, 0x16, 0x8c, 0x0f, 0xe8, 0xd9, 0x5f, 0x6d, 0xf6, 0x57, 0x37, 0x86, 0xb2, 0x37, 0x30, 0xd1, 0x55, 0x87, 0x83, 0x32, 0x70, 0x8d, 0xf8,
+			0xe4, 0xda, 0x37, 0xa4, 0x6c, 0x55, 0x74, 0x00, 0x14, 0x23, 0xa6, 0x8b, 0xa3, 0x05, 0x1f, 0xc7, 0x63, 0x4b, 0x6c, 0xbc, 0x1e, 0x4f, 0xc6, 0x40, 0x8c, 0x07, 0xdb, 0xd4, 0x8c, 0x27, 0xcf, 0xd1, 0x1a, 0x8f, 0xc6, 0xfc, 0xfd, 0xcb, 0xf5, 0x98, 0xd3, 0x6b, 0x1e, 0x0a, 0x1f, 0x4d, 0xdd, 0xf3, 0xe2, 0x02, 0x0c, 0x53, 0x70, 0xf7, 0xc3, 0xdf, 0x18, 0xfb, 0xb3, 0x1e, 0xfe, 0x80, 0x26, 0xf8, 0xae, 0xf0, 0x53, 0xb1, 0xe4, 0xf2, 0xf1, 0x1b, 0x8d, 0x13, 0x6d, 0xc2, 0x8c, 0x04, 0x00, 0x00,
+		},
+	},
+	"_views/pipeline.html": &BinaryFile{
+		Name:    "_views/pipeline.html",
+		ModTime: 1792424652,
+		MD5: []byte{
+			0xd4, 0x1d, 0x8c, 0xd9, 0x8f, 0x00, 0xb2, 0x04, 0xe9, 0x80, 0x09, 0x98, 0xec, 0xf8, 0x42, 0x7e,
+		},
+		CompressedContents: []byte{
+			0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0xe5, 0x57, 0x4b, 0x6f, 0xdc, 0x36, 0x10, 0x3e, 0xdb, 0xbf, 0x82, 0x20, 0x0c, 0xe4, 0x12, 0x49, 0x6d, 0x1d, 0x04, 0x68, 0xa0, 0x15, 0x02, 0xd4, 0x7d, 0x18, 0x48, 0x83, 0x20, 0x45, 0x73, 0x35, 0xb8, 0x22, 0x77, 0x45, 0x94, 0x22, 0x55, 0x92, 0xf2, 0xa3, 0x8e, 0xff, 0x7b, 0x67, 0x28, 0x52, 0xa2, 0x76, 0xd7, 0x81, 0xef, 0x39, 0x69, 0x38, 0x2f, 0xce, 0xe3, 0x9b, 0x59, 0xee, 0xe3, 0x23, 0xe1, 0x62, 0x27, 0xb5, 0x20, 0x74, 0x90, 0x83, 0x50, 0x40, 0x51, 0xf2, 0xf4, 0x74, 0xfe, 0xf8, 0x48, 0xbc, 0xe8, 0x07, 0xc5, 0x3c,
+			0x48, 0x3a, 0xc1, 0xb8, 0xb0, 0x94, 0x94, 0x28, 0xa9, 0xb9, 0xbc, 0x25, 0x92, 0x6f, 0x68, 0x6b, 0xb4, 0x17, 0xda, 0x53, 0xd2, 0x2a, 0xe6, 0xdc, 0x86, 0x8e, 0xff, 0x14, 0xc8, 0x62, 0xe0, 0xc2, 0x92, 0xfc, 0x50, 0x88, 0xfb, 0x81, 0x69, 0x4e, 0x9b, 0xf3, 0xb3, 0x60, 0x9c, 0xe9, 0x77, 0x52, 0xf1, 0xe2, 0x4e, 0x72, 0xdf, 0x45, 0xa5, 0xf7, 0x8e, 0xa2, 0xed, 0xde, 0x4a, 0x0e, 0xea, 0x41, 0x1f, 0xbf, 0x67, 0xf5, 0xa8, 0x32, 0xbb, 0xad, 0x85, 0x88, 0x5a, 0x3b, 0xf6, 0x5b, 0x1a, 0xa4, 0x67, 0xb5, 0x92, 0x4d, 0xcd, 0x48, 0x67, 0xc5, 0x6e, 0x43, 0xab, 0x94, 0x89, 0xa3, 0xcd, 0xa7,
+			0x44, 0xd6, 0x15, 0x6b, 0xea, 0x0a, 0xd4, 0x16, 0x7d, 0x07, 0x17, 0x36, 0x90, 0x68, 0xf9, 0x45, 0x8a, 0xbb, 0x3f, 0x0d, 0x17, 0xaa, 0xfc, 0xc8, 0x7a, 0x01, 0x49, 0xd6, 0x55, 0x90, 0xcd, 0xfa, 0x75, 0x35, 0xaa, 0x10, 0x4e, 0x35, 0xc5, 0x93, 0xbe, 0x60, 0x2c, 0x77, 0xb9, 0xfd, 0x2f, 0x46, 0xef, 0xe4, 0xbe, 0xbc, 0x12, 0xae, 0xb5, 0x72, 0xf0, 0xd2, 0x68, 0x2c, 0xd9, 0x59, 0x3d, 0x1c, 0xdc, 0x73, 0x52, 0xaf, 0xae, 0x86, 0xc9, 0xa7, 0xd0, 0x7c, 0x32, 0x5b, 0x57, 0x0b, 0x8b, 0x92, 0x8a, 0x53, 0x80, 0x48, 0xf2, 0xa9, 0xd0, 0xe1, 0xdc, 0x0b, 0x2e, 0xc7, 0xfe, 0xa8, 0x78, 0x99,
+			0xf9, 0x54, 0xe6, 0x9f, 0x8a, 0xcb, 0xf7, 0xfd, 0x54, 0xb5, 0x50, 0x80, 0x4c, 0xc1, 0x8b, 0x7b, 0x5f, 0xb8, 0x9e, 0x29, 0x45, 0x9b, 0xbf, 0x3c, 0xdb, 0x63, 0xd1, 0x42, 0x1d, 0x50, 0x19, 0xc2, 0xba, 0xd8, 0x5b, 0x36, 0x74, 0xe4, 0xdd, 0x26, 0xcf, 0xe4, 0xf7, 0xc0, 0xc3, 0x68, 0x8f, 0x2e, 0x5c, 0xc5, 0x1b, 0xfc, 0x92, 0x97, 0xf4, 0x3c, 0xdc, 0x65, 0x99, 0xde, 0x0b, 0x72, 0xa1, 0xc4, 0xad, 0x50, 0xaf, 0xc9, 0x85, 0x86, 0xbb, 0x1c, 0xde, 0x3c, 0xc5, 0x50, 0x7e, 0x40, 0xbe, 0x8b, 0xd7, 0x2e, 0x28, 0xc9, 0x4d, 0xa5, 0xe6, 0xe2, 0x3e, 0x9a, 0x06, 0xcb, 0xc9, 0x47, 0x34, 0x39,
+			0x42, 0x22, 0xb3, 0x21, 0x56, 0xfc, 0x16, 0x30, 0x11, 0x6c, 0x54, 0x7e, 0x3e, 0x2f, 0xb1, 0xe3, 0x69, 0x6b, 0xf8, 0x03, 0x1e, 0x7a, 0x66, 0xf7, 0x52, 0xa7, 0x82, 0x4d, 0x5e, 0xcf, 0xea, 0xee, 0x4d, 0xe6, 0x35, 0xaa, 0x58, 0xd1, 0x9b, 0x5b, 0x41, 0x11, 0x03, 0x21, 0x8a, 0x05, 0x66, 0xdd, 0x9b, 0x64, 0x88, 0xb2, 0x71, 0x70, 0x1e, 0x90, 0xdd, 0x67, 0x89, 0xfe, 0x9d, 0x58, 0x2b, 0xc3, 0xc5, 0x06, 0xe0, 0xb7, 0x98, 0xcd, 0x82, 0x83, 0xe4, 0x96, 0xc6, 0x92, 0x74, 0xea, 0x47, 0x2f, 0x60, 0x22, 0xd9, 0xce, 0x03, 0x86, 0x96, 0xa2, 0x09, 0xbe, 0x17, 0xd7, 0xb1, 0x70, 0x48, 0x87,
+			0x48, 0x32, 0xff, 0xf1, 0xc6, 0x59, 0x0d, 0x58, 0xaf, 0xc9, 0x8c, 0x58, 0xcc, 0x01, 0x45, 0xe5, 0x6f, 0xd6, 0x44, 0xed, 0x49, 0x90, 0xe6, 0x25, 0x45, 0x9d, 0x00, 0x1e, 0x62, 0xad, 0x56, 0xdd, 0xcb, 0x44, 0x99, 0x64, 0x25, 0x98, 0xf9, 0x0b, 0x71, 0x0a, 0xe9, 0x3f, 0xbe, 0x0c, 0xe9, 0x9f, 0x47, 0x4d, 0xd2, 0x96, 0xc8, 0xf0, 0x5e, 0xef, 0x8c, 0xed, 0x09, 0x6b, 0x71, 0x36, 0xb3, 0x95, 0x52, 0xda, 0x51, 0x57, 0x27, 0x76, 0xc6, 0x57, 0x32, 0x5a, 0x25, 0x74, 0x8b, 0x60, 0x7b, 0x7a, 0xca, 0x77, 0x22, 0xfa, 0x29, 0x3a, 0x63, 0xe5, 0x7f, 0xb8, 0x0f, 0x23, 0x52, 0x8e, 0x61, 0x3a,
+			0x30, 0x3b, 0x35, 0xfe, 0x78, 0x4b, 0x7c, 0x42, 0x91, 0x80, 0x46, 0xad, 0x00, 0xbf, 0x1e, 0x99, 0x80, 0x9f, 0xe0, 0xa3, 0xfc, 0x0c, 0x95, 0x12, 0xf6, 0x03, 0xdb, 0x0a, 0x45, 0xe8, 0x14, 0xc7, 0xab, 0xbc, 0x28, 0xaf, 0xe8, 0x73, 0x43, 0x30, 0x6f, 0x08, 0xfa, 0x8c, 0xd3, 0x6b, 0x3d, 0x8c, 0x3e, 0x77, 0x2a, 0x91, 0x91, 0x3b, 0x5c, 0x5a, 0xb6, 0x90, 0x07, 0x5d, 0x5d, 0xdf, 0x39, 0x8d, 0x48, 0xba, 0xb0, 0xde, 0x8e, 0xde, 0x9b, 0xbc, 0x55, 0x91, 0x31, 0x53, 0xc5, 0x60, 0x25, 0xd8, 0x3c, 0x64, 0x9c, 0x93, 0x8d, 0x9c, 0x64, 0x87, 0xa1, 0xd4, 0x15, 0xb6, 0xe3, 0xd4, 0x2a, 0xaf,
+			0x3b, 0x5b, 0xe1, 0xe7, 0xdb, 0x48, 0x59, 0x36, 0x62, 0xed, 0xd9, 0x56, 0x89, 0x5c, 0x33, 0x9c, 0x13, 0x91, 0x8d, 0x5b, 0x38, 0xc6, 0x75, 0x1d, 0xf2, 0xac, 0x3d, 0xfe, 0xa0, 0x4e, 0xf1, 0x78, 0x1b, 0x43, 0xf4, 0x1d, 0xee, 0x5c, 0x3f, 0xc2, 0x0d, 0x40, 0xce, 0x3c, 0x4c, 0xea, 0xfa, 0x6a, 0xcd, 0x03, 0x3d, 0x0b, 0xd3, 0xbb, 0x66, 0xfe, 0xaa, 0xd8, 0xe0, 0x0e, 0x99, 0x0b, 0x72, 0x0e, 0x94, 0xad, 0x35, 0x76, 0x66, 0x01, 0x61, 0xa7, 0x92, 0xcc, 0x81, 0xd5, 0x1e, 0xb7, 0x1c, 0x52, 0x61, 0x67, 0x21, 0xc2, 0xd7, 0xd0, 0x5c, 0x16, 0xd1, 0x31, 0x94, 0x61, 0x44, 0x0e, 0x81, 0x3c,
+			0x5a, 0x0b, 0x4f, 0x85, 0x34, 0xbf, 0x4b, 0xd2, 0xbc, 0x49, 0x50, 0x76, 0x83, 0xd4, 0xf0, 0x56, 0xd8, 0x50, 0xcb, 0x60, 0xe4, 0xde, 0x91, 0x1f, 0xca, 0xb7, 0xe1, 0x57, 0xc1, 0x1b, 0xa3, 0xbc, 0x1c, 0x36, 0x14, 0x2b, 0x21, 0x1d, 0x01, 0xe7, 0x5a, 0xea, 0x3d, 0x6d, 0xa6, 0xc6, 0x41, 0xcc, 0x3c, 0x73, 0x76, 0xf4, 0x04, 0xa8, 0xe6, 0xf8, 0xd7, 0x13, 0x1a, 0xf8, 0xe0, 0xab, 0xbc, 0xbe, 0xc2, 0x79, 0x6d, 0x56, 0xc7, 0xe9, 0xad, 0x90, 0x3b, 0x4e, 0xe2, 0x58, 0x7a, 0x70, 0x66, 0x77, 0xed, 0xe5, 0xe5, 0xe5, 0xcf, 0x41, 0xfb, 0x94, 0x66, 0xec, 0x07, 0x68, 0xf2, 0x31, 0xa4, 0xa4,
+			0x6f, 0xac, 0x19, 0x35, 0xbf, 0xe9, 0xa5, 0x52, 0xd2, 0x3d, 0x6b, 0x97, 0x0d, 0xfb, 0x57, 0x82, 0x60, 0x65, 0xfe, 0x46, 0xe8, 0x5b, 0x69, 0xe3, 0x13, 0x61, 0x95, 0x6e, 0x3a, 0xa5, 0x0e, 0xe6, 0x93, 0xf6, 0x92, 0xbe, 0xfc, 0x21, 0x9d, 0x37, 0x30, 0x4b, 0xa7, 0xfa, 0x72, 0x30, 0x08, 0x2a, 0xec, 0x93, 0xb8, 0xff, 0x63, 0x29, 0x00, 0xad, 0x10, 0xa4, 0xf8, 0x97, 0x50, 0x37, 0xb6, 0xad, 0x70, 0x0e, 0x17, 0x41, 0xd2, 0x2d, 0x22, 0x0f, 0x63, 0x52, 0x4e, 0x9c, 0xb6, 0x13, 0x08, 0x44, 0xf8, 0x21, 0xca, 0xed, 0x38, 0x46, 0x6d, 0x93, 0x59, 0x26, 0xb8, 0x63, 0x16, 0x5b, 0x3f, 0x27,
+			0x49, 0xf3, 0xb6, 0xa0, 0xcf, 0xec, 0xe1, 0xf6, 0x1d, 0xa3, 0x62, 0xbe, 0xcb, 0xda, 0x5c, 0x96, 0x63, 0x64, 0x2a, 0xec, 0xf9, 0xfc, 0x84, 0xd0, 0xc6, 0x7f, 0x7b, 0x5c, 0xc1, 0x2f, 0x69, 0x8d, 0xc2, 0xe2, 0x6e, 0xde, 0x36, 0x1f, 0x0d, 0x8e, 0xa1, 0x23, 0x0f, 0xc2, 0x97, 0xc1, 0x7f, 0xf2, 0x7d, 0x08, 0xc0, 0x99, 0x06, 0x85, 0xb8, 0x50, 0x80, 0xc2, 0x85, 0xd8, 0x9c, 0xc7, 0xc5, 0xbb, 0xfa, 0xa3, 0xb1, 0x33, 0xc6, 0xcf, 0x7f, 0x34, 0x16, 0xfb, 0xff, 0x01, 0xac, 0x7d, 0x16, 0x32, 0xa5, 0x0c, 0x00, 0x00,
+		},
+	},
+	"_views/pipeline_run.html": &BinaryFile{
+		Name:    "_views/pipeline_run.html",
+		ModTime: 1792427576,
+		MD5: []byte{
+			0xd4, 0x1d, 0x8c, 0xd9, 0x8f, 0x00, 0xb2, 0x04, 0xe9, 0x80, 0x09, 0x98, 0xec, 0xf8, 0x42, 0x7e,
+		},
+		CompressedContents: []byte{
+			0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0xcd, 0x57, 0x5b, 0x6f, 0xdb, 0x36, 0x14, 0x7e, 0x4e, 0x7e, 0x05, 0x41, 0xf4, 0xb1, 0xb6, 0x9a, 0xa5, 0x18, 0xb0, 0x44, 0x36, 0x36, 0x74, 0x19, 0x90, 0x01, 0x5b, 0x8b, 0x15, 0xd8, 0x6b, 0x40, 0x4b, 0xb4, 0xc5, 0x85, 0x22, 0x35, 0x92, 0x8a, 0x13, 0xa4, 0xfe, 0xef, 0x3b, 0xbc, 0xea, 0x62, 0x59, 0x49, 0xfb, 0xb0, 0xed, 0xc5, 0xe6, 0xe5, 0x5c, 0xbf, 0x73, 0xe1, 0xd1, 0xf3, 0x33, 0x2a, 0xe9, 0x96, 0x09, 0x8a, 0x70, 0xc3, 0x1a, 0xca, 0x61, 0x75, 0xa7, 0x5a, 0x81, 0xd1, 0xe1, 0x70, 0xfe, 0xfc, 0x8c, 0x0c, 0xad, 0x1b,
+			0x4e, 0x0c, 0xdc, 0x56, 0x94, 0x94, 0x54, 0x61, 0xb4, 0xb4, 0x37, 0x79, 0xc9, 0x1e, 0x10, 0x2b, 0x57, 0xb8, 0x90, 0xc2, 0x50, 0x61, 0x30, 0x2a, 0x38, 0xd1, 0x7a, 0x85, 0xdb, 0xfb, 0x85, 0x3d, 0x22, 0x20, 0x46, 0xa1, 0xfe, 0x66, 0x41, 0x1f, 0x1b, 0x22, 0x4a, 0xbc, 0x3e, 0x3f, 0x73, 0xcc, 0x3d, 0xfa, 0x8a, 0xf1, 0x72, 0xb1, 0x67, 0xa5, 0xa9, 0x02, 0xd1, 0x8f, 0x1a, 0x5b, 0xde, 0x9d, 0x62, 0x25, 0x90, 0x3b, 0x7a, 0xfb, 0x7f, 0x96, 0xb7, 0xbc, 0xc7, 0xb7, 0x51, 0x60, 0x51, 0xa1, 0xda, 0x7a, 0x83, 0xdd, 0xed, 0x59, 0xce, 0xd9, 0x3a, 0x27, 0xa8, 0x52, 0x74, 0xbb, 0xc2, 0x59,
+			0xf4, 0x46, 0xe3, 0xf5, 0xa7, 0xb8, 0xcc, 0x33, 0xb2, 0xce, 0x33, 0x20, 0x9b, 0xa1, 0xcf, 0xc0, 0xeb, 0xe5, 0x9f, 0x8c, 0xee, 0x7f, 0x93, 0x25, 0xe5, 0xcb, 0xc8, 0xfb, 0x3b, 0xa9, 0x29, 0xfa, 0x82, 0x5a, 0xc5, 0xa9, 0x28, 0xe0, 0x06, 0x50, 0xc0, 0xeb, 0x19, 0xd2, 0xc3, 0x61, 0x42, 0x99, 0x06, 0xef, 0x46, 0x4c, 0xb7, 0x3f, 0x3b, 0x52, 0x77, 0x93, 0xa8, 0xf3, 0xac, 0xe5, 0xce, 0xf3, 0x2c, 0xb8, 0x3e, 0x82, 0x6c, 0xcb, 0xe9, 0xe3, 0x42, 0xb1, 0x5d, 0x65, 0x2c, 0x4e, 0x86, 0x3e, 0x1a, 0xbf, 0xf3, 0x40, 0x80, 0x02, 0xb6, 0x45, 0x42, 0x9a, 0x81, 0x1e, 0xfd, 0x41, 0x42, 0x28,
+			0xa9, 0xb1, 0xa6, 0x39, 0x1d, 0xa4, 0x8f, 0x65, 0x6b, 0x8c, 0x14, 0x28, 0xad, 0x16, 0x25, 0x11, 0x3b, 0x1f, 0xc1, 0x70, 0xa0, 0x6b, 0xc2, 0x39, 0x1e, 0x83, 0xb5, 0x2c, 0x88, 0x28, 0x28, 0x7f, 0x3d, 0x66, 0xd9, 0x84, 0xf7, 0x78, 0xfd, 0xc1, 0x49, 0x41, 0x7f, 0xb4, 0xc2, 0x82, 0x16, 0x7c, 0xa0, 0xa2, 0xf4, 0xa6, 0x46, 0x14, 0xd2, 0xff, 0x10, 0x0c, 0x9b, 0x26, 0x31, 0x5d, 0x16, 0x35, 0x31, 0x45, 0x95, 0x76, 0x40, 0xc8, 0x4a, 0xef, 0x86, 0xbf, 0xa5, 0x25, 0x6b, 0x6b, 0x34, 0x4a, 0xba, 0x8b, 0xc5, 0x7b, 0x3c, 0x05, 0x32, 0x53, 0xda, 0x78, 0x42, 0x8f, 0xeb, 0xe8, 0xde, 0xc1,
+			0xee, 0x61, 0xf1, 0x91, 0xed, 0xdd, 0xb1, 0xc2, 0xa3, 0xe9, 0x68, 0x1a, 0xc5, 0x6a, 0xa2, 0x9e, 0xec, 0x1e, 0xfe, 0x77, 0x2c, 0x80, 0x19, 0x42, 0x86, 0x02, 0xf9, 0x0a, 0x33, 0xb1, 0x95, 0x20, 0xca, 0xe7, 0xc2, 0x67, 0x03, 0x55, 0x97, 0xe2, 0x7f, 0x96, 0x57, 0x17, 0xbd, 0xd8, 0xf6, 0x10, 0xb4, 0x74, 0xad, 0x06, 0x94, 0xe9, 0xdf, 0x08, 0x43, 0xe1, 0x0a, 0x26, 0x76, 0x38, 0x86, 0xd8, 0x1a, 0x0c, 0xe2, 0x75, 0xc3, 0x04, 0x94, 0xe0, 0x0a, 0x2b, 0x62, 0x98, 0xbc, 0x42, 0x17, 0xcb, 0x77, 0x4e, 0xad, 0x91, 0x92, 0x1b, 0xd6, 0xac, 0x30, 0x00, 0x8f, 0x98, 0x46, 0x91, 0x7d, 0xdd,
+			0xe9, 0xb5, 0x71, 0xe0, 0x9a, 0xce, 0x29, 0xf5, 0x29, 0xc0, 0x69, 0x99, 0xd4, 0x8e, 0xc0, 0x70, 0x20, 0xec, 0x89, 0xf2, 0xa6, 0x75, 0xee, 0xc2, 0xef, 0x55, 0x38, 0xbe, 0x46, 0xde, 0xb4, 0xef, 0x8e, 0xed, 0xda, 0x13, 0x8d, 0x3a, 0x15, 0x11, 0x9e, 0x57, 0xda, 0x46, 0x95, 0x92, 0xea, 0x05, 0xcb, 0x7c, 0xaa, 0x7f, 0xbd, 0x61, 0x5b, 0xc2, 0xbe, 0xc1, 0xa2, 0x86, 0x28, 0xc3, 0x08, 0xff, 0x16, 0xac, 0x6a, 0x26, 0x5a, 0xbd, 0x28, 0x98, 0x2a, 0x38, 0x9d, 0xb1, 0xab, 0x08, 0x75, 0x7e, 0x8d, 0xb4, 0x84, 0xfa, 0xd3, 0x86, 0xec, 0xa8, 0x46, 0x7b, 0xaa, 0x60, 0x7d, 0xcf, 0x9a, 0xe6,
+			0xeb, 0x6d, 0x8e, 0x6c, 0x73, 0x36, 0xd7, 0xad, 0xb1, 0x14, 0x43, 0x8b, 0xb7, 0x52, 0x81, 0x2f, 0xe5, 0x8c, 0xb1, 0x41, 0xf4, 0x35, 0xb4, 0xab, 0x68, 0xa9, 0x22, 0x62, 0xd2, 0xc0, 0x19, 0xe5, 0xba, 0x2d, 0x0a, 0xaa, 0xf5, 0x58, 0x7d, 0x51, 0xd1, 0xe2, 0xfe, 0x15, 0x48, 0x1d, 0xa9, 0x8b, 0x9d, 0x07, 0x5a, 0x8e, 0xaf, 0xbb, 0x41, 0x23, 0xfe, 0x57, 0x9a, 0x41, 0xc5, 0xb4, 0x91, 0xea, 0xa9, 0xdf, 0x0f, 0x14, 0x20, 0xdc, 0xef, 0x08, 0xef, 0x47, 0x4f, 0x49, 0x20, 0x81, 0xa0, 0xa9, 0x6d, 0x71, 0x79, 0x79, 0xf9, 0x83, 0x7b, 0x59, 0x80, 0xec, 0x7f, 0xe1, 0xc0, 0x2f, 0x4c, 0x30,
+			0x5d, 0x4d, 0x78, 0x30, 0x4c, 0xbc, 0xc1, 0x3b, 0x35, 0xf4, 0x2f, 0x5d, 0xf4, 0x1d, 0xec, 0xb2, 0x63, 0x91, 0x22, 0xf7, 0xdf, 0x39, 0x5d, 0x70, 0x59, 0xdc, 0x27, 0x97, 0x6f, 0x38, 0x69, 0xf4, 0xd0, 0xe3, 0x8b, 0xb1, 0xf6, 0x20, 0x79, 0x3c, 0x4b, 0x04, 0x56, 0x70, 0xb5, 0x6c, 0x5d, 0xfe, 0x8a, 0x3b, 0x25, 0x5b, 0x51, 0xde, 0xd5, 0x8c, 0x73, 0x68, 0xd5, 0xce, 0xc9, 0x41, 0x6a, 0xa6, 0xff, 0x4a, 0x65, 0xf0, 0x77, 0x04, 0xec, 0x27, 0xa2, 0xe0, 0x39, 0x36, 0x54, 0x69, 0x97, 0xdb, 0xd3, 0x75, 0xe4, 0xa1, 0xe8, 0x48, 0x53, 0x5d, 0xe4, 0x8d, 0xa2, 0xe3, 0x71, 0xa7, 0x13, 0xf8,
+			0x05, 0x41, 0xa5, 0xc3, 0xd3, 0x7b, 0x47, 0xc5, 0x03, 0x53, 0x80, 0x9b, 0x35, 0xcf, 0x72, 0xf4, 0xcd, 0x89, 0x55, 0x75, 0x64, 0xd9, 0x8d, 0x52, 0x2f, 0x9a, 0x74, 0x63, 0x1b, 0xf9, 0x9c, 0x35, 0x5e, 0xc8, 0x69, 0xad, 0x73, 0xc2, 0x3f, 0xbb, 0xc6, 0xd3, 0x49, 0x37, 0x64, 0xc3, 0x69, 0x9f, 0xd6, 0xed, 0xe3, 0xc2, 0x73, 0x75, 0xdb, 0x30, 0x64, 0xf8, 0x11, 0xc2, 0xd8, 0x31, 0xd9, 0xc7, 0xda, 0xa8, 0x30, 0xf9, 0x99, 0x6a, 0xed, 0xdb, 0x69, 0x9e, 0xc1, 0xb2, 0x7f, 0xb6, 0xa3, 0xc3, 0xa3, 0x5f, 0xe5, 0x06, 0xdd, 0x8a, 0x07, 0x59, 0xb8, 0x88, 0x0f, 0xef, 0x7e, 0xda, 0x02, 0xd4,
+			0xc3, 0xa3, 0x94, 0x5f, 0xfd, 0xc3, 0x8f, 0xad, 0x69, 0x5a, 0x33, 0xd2, 0x15, 0xe0, 0x0b, 0x47, 0xb0, 0x50, 0x3e, 0x75, 0x92, 0xb9, 0xb9, 0xd9, 0xc8, 0xf2, 0xc9, 0xae, 0x00, 0x33, 0x65, 0x5f, 0x44, 0xf4, 0x86, 0x89, 0x92, 0x3e, 0xbe, 0x45, 0x6f, 0x5c, 0x5f, 0x46, 0x57, 0xab, 0x51, 0xaf, 0xb1, 0xbd, 0x3a, 0x74, 0xc9, 0xce, 0x55, 0xef, 0x7b, 0x88, 0xb1, 0xe7, 0x9c, 0x1d, 0x51, 0x4e, 0xce, 0x28, 0xef, 0x96, 0xdf, 0xf7, 0xe7, 0x90, 0xd1, 0x2b, 0x70, 0x14, 0x4e, 0x4e, 0x36, 0x30, 0x40, 0x9e, 0x56, 0x9b, 0x5e, 0x88, 0xc3, 0x21, 0x52, 0xc7, 0x57, 0xa3, 0xf7, 0x00, 0x4e, 0x71,
+			0xf6, 0x46, 0x88, 0xc4, 0xe9, 0x47, 0x86, 0x17, 0x18, 0x07, 0x73, 0x51, 0x62, 0x0d, 0x6f, 0x7b, 0xca, 0x4c, 0x57, 0xf8, 0x43, 0xf6, 0xf4, 0x3d, 0x90, 0x1c, 0x4f, 0xef, 0x91, 0x0d, 0x59, 0xd9, 0x61, 0xdd, 0xb1, 0xa6, 0x6f, 0x8e, 0xd1, 0x75, 0x67, 0x5b, 0x97, 0x57, 0xfe, 0x93, 0x23, 0x7d, 0xf8, 0xfc, 0x25, 0x37, 0x59, 0x27, 0x08, 0x52, 0xf0, 0xc4, 0xd8, 0x3e, 0x2d, 0xa7, 0x6f, 0x7f, 0xe4, 0x3d, 0x1c, 0x50, 0x86, 0x4e, 0x72, 0xd8, 0x11, 0xbf, 0x8b, 0xe6, 0x14, 0x77, 0xaf, 0x91, 0x8f, 0xdc, 0x09, 0x99, 0x49, 0x6c, 0x2d, 0xdc, 0x86, 0xf4, 0x74, 0x1b, 0x9b, 0x9e, 0x41, 0x8e,
+			0x2b, 0x14, 0x2f, 0xc5, 0x7a, 0xdf, 0xd1, 0xc2, 0xd9, 0x5b, 0x94, 0x64, 0x5b, 0xc5, 0xa4, 0x23, 0x3d, 0xa1, 0xb0, 0xc3, 0x2f, 0x76, 0xe4, 0xbe, 0xc9, 0x2f, 0x77, 0xe9, 0xe9, 0xe7, 0xe9, 0xa4, 0x92, 0x50, 0xbc, 0x03, 0x25, 0xf1, 0x6c, 0xa2, 0xc9, 0x9e, 0x16, 0x19, 0x0d, 0x0c, 0x5d, 0x31, 0xdc, 0xc6, 0xc2, 0x1f, 0x7e, 0x5d, 0xc5, 0xd2, 0x87, 0x95, 0x6d, 0x68, 0xeb, 0xf3, 0x50, 0x76, 0x83, 0xcf, 0xff, 0xad, 0x94, 0x26, 0x7d, 0xfe, 0x77, 0xfc, 0xff, 0x00, 0x0b, 0x68, 0x9d, 0xf3, 0x3f, 0x10, 0x00, 0x00,
+		},
+	},
+	"_views/pipelines.html": &BinaryFile{
+		Name:    "_views/pipelines.html",
+		ModTime: 1792424652,
+		MD5: []byte{
+			0xd4, 0x1d, 0x8c, 0xd9, 0x8f, 0x00, 0xb2, 0x04, 0xe9, 0x80, 0x09, 0x98, 0xec, 0xf8, 0x42, 0x7e,
+		},
+		CompressedContents: []byte{
+			0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x9d, 0x54, 0x4d, 0x8f, 0xd3, 0x30, 0x10, 0x3d, 0x6f, 0x7f, 0x85, 0x65, 0xed, 0x91, 0x24, 0x87, 0x8a, 0x03, 0x28, 0x8d, 0x40, 0xec, 0x05, 0x04, 0x2b, 0x04, 0x12, 0x77, 0x37, 0x9e, 0x34, 0x16, 0x8e, 0x1d, 0x1c, 0x87, 0x76, 0x55, 0xfa, 0xdf, 0x99, 0x71, 0x12, 0xd7, 0xd9, 0x72, 0x00, 0x4e, 0x1e, 0xcf, 0xbc, 0xf9, 0x7a, 0x63, 0xcf, 0xf9, 0xcc, 0x24, 0x34, 0xca, 0x00, 0xe3, 0xbd, 0xea, 0x41, 0xa3, 0x34, 0x70, 0x76, 0xb9, 0x6c, 0xce, 0x67, 0xe6, 0xa1, 0xeb, 0xb5, 0xf0, 0x68, 0x6a, 0x41, 0x48, 0x70, 0x9c, 0xe5, 0x64,
+			0x29, 0xa5, 0xfa, 0xc9, 0x94, 0xdc, 0xf1, 0xda, 0x1a, 0x0f, 0xc6, 0x73, 0x56, 0x6b, 0x31, 0x0c, 0x3b, 0x3e, 0x7e, 0xcf, 0x48, 0x25, 0x30, 0x86, 0x63, 0xe9, 0x25, 0x83, 0x53, 0x2f, 0x8c, 0xe4, 0xd5, 0xe6, 0x2e, 0x38, 0x27, 0xf8, 0x56, 0x69, 0x99, 0x1d, 0x95, 0xf4, 0xed, 0x0c, 0x7a, 0x83, 0xe9, 0xd1, 0x70, 0x70, 0x4a, 0x22, 0x3c, 0xe0, 0xe9, 0xbc, 0x2b, 0x47, 0x9d, 0xf8, 0xed, 0x1d, 0x56, 0x54, 0xbb, 0xb1, 0xdb, 0xf3, 0x60, 0xbd, 0x2b, 0xb5, 0xaa, 0x4a, 0xc1, 0x5a, 0x07, 0xcd, 0x8e, 0x17, 0xbc, 0xfa, 0x60, 0xf7, 0x43, 0x59, 0x88, 0xaa, 0x2c, 0xd0, 0x70, 0x45, 0x0c, 0x98,
+			0xa2, 0xfa, 0xbc, 0x34, 0x5a, 0x16, 0xe1, 0x1e, 0x31, 0x65, 0x31, 0xea, 0x90, 0xb4, 0x98, 0xb2, 0xc6, 0xd3, 0x8b, 0xbd, 0x86, 0x24, 0xfd, 0x74, 0x5f, 0x84, 0x6c, 0xe8, 0x84, 0xd6, 0xd7, 0x2b, 0x3a, 0x29, 0xa2, 0x2b, 0x84, 0xf2, 0xc4, 0xdd, 0x14, 0xdd, 0xbb, 0xb9, 0x12, 0xdf, 0x56, 0x8f, 0xa2, 0x83, 0xb2, 0x40, 0x21, 0x6a, 0xbe, 0x7a, 0x71, 0xa0, 0x92, 0x52, 0xdd, 0x97, 0xd1, 0x18, 0x65, 0x0e, 0x6b, 0xe5, 0x47, 0x31, 0x78, 0x86, 0x96, 0xb5, 0xf6, 0x6d, 0xed, 0x95, 0x35, 0x57, 0x7f, 0x14, 0xdc, 0xd4, 0x4b, 0xac, 0xa0, 0xf4, 0x7b, 0x2b, 0x9f, 0x48, 0xc2, 0xe1, 0x3a, 0x61,
+			0x0e, 0xc0, 0xee, 0x95, 0x91, 0x70, 0x7a, 0xc1, 0xee, 0x97, 0xe1, 0xb3, 0xd7, 0x3b, 0x96, 0x7f, 0x53, 0x70, 0xfc, 0x64, 0x25, 0x68, 0x1a, 0xf7, 0xba, 0x72, 0x99, 0xb0, 0xbc, 0xf8, 0x14, 0x18, 0x2e, 0x06, 0xc8, 0xa9, 0x33, 0xf6, 0x8b, 0x8d, 0x4e, 0x83, 0xa9, 0x31, 0x08, 0xc6, 0xe0, 0xd5, 0x2d, 0xe2, 0x72, 0xa1, 0xf1, 0xa0, 0x5e, 0x35, 0x89, 0xe9, 0x9d, 0x35, 0x8d, 0x3a, 0xe4, 0x0f, 0x30, 0xd4, 0x4e, 0xf5, 0xd4, 0x11, 0x01, 0xd7, 0x6f, 0xc6, 0xc3, 0xc9, 0x27, 0x94, 0xd3, 0xad, 0x1b, 0x3d, 0xc8, 0x67, 0x59, 0xfe, 0x1c, 0x2a, 0x4c, 0x14, 0x71, 0x60, 0x64, 0xb8, 0x7a, 0x79,
+			0xed, 0x0c, 0xd5, 0x58, 0xf3, 0x6d, 0x88, 0x69, 0x32, 0x7f, 0x01, 0x1f, 0x9d, 0xc3, 0xff, 0x70, 0x03, 0x0c, 0x02, 0xa2, 0x8f, 0xca, 0xb7, 0x09, 0x3c, 0xcc, 0x71, 0x22, 0x18, 0x71, 0xff, 0xce, 0x2a, 0x01, 0xf2, 0xf7, 0x0f, 0x0b, 0xbf, 0x54, 0xa6, 0x43, 0x1a, 0x10, 0xe5, 0x9a, 0x7a, 0xbb, 0xdd, 0xbe, 0x9a, 0x39, 0x9e, 0x13, 0xd0, 0x53, 0x4f, 0x58, 0xd4, 0x62, 0x8f, 0xf3, 0x9d, 0xf8, 0x27, 0x57, 0x3f, 0x0e, 0xe8, 0x09, 0x3f, 0x18, 0x1f, 0xc6, 0xba, 0x86, 0x21, 0x6c, 0x81, 0x05, 0x97, 0xcd, 0x3a, 0x22, 0x4e, 0x0f, 0x70, 0xeb, 0x03, 0xce, 0x59, 0x87, 0x23, 0x48, 0x7d, 0x24,
+			0xbd, 0x30, 0xb7, 0xb8, 0x24, 0x86, 0xa3, 0x70, 0xf4, 0xaa, 0xe3, 0x14, 0x62, 0xf9, 0x14, 0x8f, 0x6a, 0x0e, 0xbf, 0x72, 0x61, 0x6d, 0xf6, 0x9e, 0xba, 0xc8, 0xa2, 0x36, 0x78, 0x4e, 0x14, 0xdf, 0x92, 0x4d, 0x74, 0x26, 0xcb, 0x62, 0xf4, 0x1e, 0xa7, 0x1f, 0xa5, 0xac, 0x77, 0xaa, 0x13, 0xee, 0x29, 0xd1, 0x84, 0xe7, 0xc4, 0xff, 0xe3, 0x61, 0x87, 0x7f, 0x38, 0x73, 0x1c, 0x0b, 0x59, 0xfe, 0xde, 0xba, 0x7a, 0xfa, 0x45, 0x58, 0x21, 0xab, 0xad, 0xa6, 0x06, 0x77, 0x2f, 0xab, 0x47, 0xcb, 0xe2, 0xd2, 0x65, 0xda, 0xe2, 0x8e, 0x95, 0x79, 0x08, 0x92, 0x06, 0x58, 0x1a, 0x45, 0xdd, 0xfc,
+			0x7d, 0x51, 0xa2, 0x3d, 0x53, 0x6d, 0xe6, 0xfd, 0xb4, 0x5a, 0xd5, 0x8d, 0xb5, 0x3e, 0xae, 0xea, 0xab, 0xff, 0x6f, 0xd9, 0x01, 0xdc, 0x2a, 0xe8, 0x05, 0x00, 0x00,
+		},
+	},
 	"_views/status/bad_request.html": &BinaryFile{
 		Name:    "_views/status/bad_request.html",
 		ModTime: 1580151029,
@@ -0,0 +1,229 @@
+package jobkit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blend/go-sdk/bufferutil"
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/ex"
+	"github.com/blend/go-sdk/logger"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// NewWorkflowAction returns a new workflow action.
+func NewWorkflowAction(opts ...WorkflowActionOption) WorkflowAction {
+	workflowAction := WorkflowAction{}
+	for _, opt := range opts {
+		opt(&workflowAction)
+	}
+	return workflowAction
+}
+
+// OptWorkflowActionSteps adds steps to the workflow action.
+func OptWorkflowActionSteps(steps ...WorkflowStep) WorkflowActionOption {
+	return func(wa *WorkflowAction) { wa.Steps = append(wa.Steps, steps...) }
+}
+
+// OptWorkflowActionLog sets the workflow action logger.
+func OptWorkflowActionLog(log logger.Log) WorkflowActionOption {
+	return func(wa *WorkflowAction) { wa.Log = log }
+}
+
+// WorkflowActionOption is a mutator for a workflow action.
+type WorkflowActionOption func(*WorkflowAction)
+
+// WorkflowAction is a job body that runs a list of steps in order.
+//
+// The steps share the job invocation, and with it the invocation parameters. Each step
+// writes its output to the invocation output as it runs, and its status, elapsed and
+// output are recorded as a step result on the invocation.
+type WorkflowAction struct {
+	Log   logger.Log
+	Steps []WorkflowStep
+}
+
+// WorkflowStep is a step of a workflow action.
+type WorkflowStep struct {
+	// Name is the name of the step.
+	Name string
+	// Timeout is the timeout for the step, if set.
+	Timeout time.Duration
+	// ContinueOnError lets the workflow continue with the next step if the step fails.
+	ContinueOnError bool
+	// Action is the step body, e.g. the execute method of a shell, http or sql action.
+	Action func(context.Context) error
+}
+
+// WorkflowStepStatus is the status of a workflow step.
+type WorkflowStepStatus string
+
+// WorkflowStepStatus values.
+const (
+	WorkflowStepStatusPending   WorkflowStepStatus = "pending"
+	WorkflowStepStatusRunning   WorkflowStepStatus = "running"
+	WorkflowStepStatusSuccess   WorkflowStepStatus = "success"
+	WorkflowStepStatusErrored   WorkflowStepStatus = "errored"
+	WorkflowStepStatusCancelled WorkflowStepStatus = "cancelled"
+	WorkflowStepStatusSkipped   WorkflowStepStatus = "skipped"
+)
+
+// WorkflowStepResult is the result of a workflow step for an invocation.
+type WorkflowStepResult struct {
+	// Name is the name of the step.
+	Name string `json:"name"`
+	// Status is the status of the step.
+	Status WorkflowStepStatus `json:"status"`
+	// ContinueOnError is set if the workflow continues past the step if it fails.
+	ContinueOnError bool `json:"continueOnError,omitempty"`
+	// Started is when the step started, and is unset if the step did not run.
+	Started time.Time `json:"started,omitempty"`
+	// Complete is when the step completed.
+	Complete time.Time `json:"complete,omitempty"`
+	// Err is the error the step returned, if any.
+	Err string `json:"err,omitempty"`
+	// OutputStart is the offset in the invocation output where the output the step wrote starts.
+	OutputStart int `json:"outputStart,omitempty"`
+	// OutputEnd is the offset in the invocation output where the output the step wrote ends.
+	OutputEnd int `json:"outputEnd,omitempty"`
+	// ExitInfo is set for steps that ran a process.
+	ExitInfo *ExitInfo `json:"exitInfo,omitempty"`
+	// HTTPSummary is set for steps that made an http request.
+	HTTPSummary *HTTPSummary `json:"httpSummary,omitempty"`
+	// SQLSummary is set for steps that executed sql statements.
+	SQLSummary []SQLStatementSummary `json:"sqlSummary,omitempty"`
+}
+
+// Elapsed returns the time the step took to run.
+func (wsr WorkflowStepResult) Elapsed() time.Duration {
+	if wsr.Started.IsZero() || wsr.Complete.IsZero() {
+		return 0
+	}
+	return wsr.Complete.Sub(wsr.Started)
+}
+
+// workflowStepOutputHandler is the output handler id a step uses to forward its output to the invocation output.
+const workflowStepOutputHandler = "workflow"
+
+// Execute is the job body.
+func (wa WorkflowAction) Execute(ctx context.Context) (err error) {
+	ji := cron.GetJobInvocation(ctx)
+	jio := GetJobInvocationOutput(ctx)
+
+	if ji == nil || jio == nil {
+		return fmt.Errorf("workflow action; invocation meta required with the output set")
+	}
+	if len(wa.Steps) == 0 {
+		return ex.New("workflow action; steps unset")
+	}
+
+	results := make([]WorkflowStepResult, len(wa.Steps))
+	for index, step := range wa.Steps {
+		results[index] = WorkflowStepResult{
+			Name:            step.Name,
+			Status:          WorkflowStepStatusPending,
+			ContinueOnError: step.ContinueOnError,
+		}
+	}
+	jio.Steps = results
+
+	skipped := true
+	for index, step := range wa.Steps {
+		if err != nil || ctx.Err() != nil {
+			results[index].Status = WorkflowStepStatusSkipped
+			continue
+		}
+		stepErr := wa.executeStep(ctx, jio, index, step)
+		if results[index].Status != WorkflowStepStatusSkipped {
+			skipped = false
+		}
+		if stepErr != nil && (!step.ContinueOnError || ctx.Err() != nil) {
+			err = stepErr
+		}
+	}
+	if err == nil && ctx.Err() != nil {
+		err = ex.New(ctx.Err())
+	}
+	if err == nil && skipped {
+		jio.Skipped = true
+	}
+	return
+}
+
+// executeStep runs a step with its own invocation output, forwarding the output to the invocation output,
+// and records its result.
+func (wa WorkflowAction) executeStep(ctx context.Context, jio *JobInvocationOutput, index int, step WorkflowStep) (err error) {
+	result := &jio.Steps[index]
+	result.Status = WorkflowStepStatusRunning
+	result.Started = time.Now().UTC()
+	fmt.Fprintf(jio.Output, "==> step %d/%d: %s\n", index+1, len(wa.Steps), step.Name)
+	// the step output is forwarded to the invocation output, so the step result only records where it is.
+	result.OutputStart = len(jio.Output.Bytes())
+
+	stepOutput := NewJobInvocationOutput()
+	stepOutput.OutputHandlers.Add(workflowStepOutputHandler, func(chunk bufferutil.BufferChunk) {
+		_, _ = jio.StreamWriter(stepOutput.OutputStreams.Last()).Write(chunk.Data)
+	})
+	stepCtx := WithJobInvocationOutput(ctx, stepOutput)
+	if step.Timeout > 0 {
+		var cancel context.CancelFunc
+		stepCtx, cancel = context.WithTimeout(stepCtx, step.Timeout)
+		defer cancel()
+	}
+
+	stepCtx, span := startSpan(stepCtx, SpanWorkflowStepExecute,
+		attribute.String("workflow.step.name", step.Name),
+		attribute.Int("workflow.step.index", index),
+	)
+	err = wa.invokeStep(stepCtx, step)
+	if err != nil && ctx.Err() == nil && stepCtx.Err() == context.DeadlineExceeded {
+		err = ex.New(ErrWorkflowStepTimeout, ex.OptMessagef("step: %s; timeout: %v", step.Name, step.Timeout), ex.OptInner(err))
+	}
+	endSpan(span, err)
+
+	result.Complete = time.Now().UTC()
+	result.OutputEnd = len(jio.Output.Bytes())
+	result.ExitInfo = stepOutput.ExitInfo
+	result.HTTPSummary = stepOutput.HTTPSummary
+	result.SQLSummary = stepOutput.SQLSummary
+	jio.Artifacts = append(jio.Artifacts, stepOutput.Artifacts...)
+
+	switch {
+	case err == nil && stepOutput.Skipped:
+		result.Status = WorkflowStepStatusSkipped
+	case err == nil:
+		result.Status = WorkflowStepStatusSuccess
+	case ctx.Err() != nil:
+		result.Status = WorkflowStepStatusCancelled
+	default:
+		result.Status = WorkflowStepStatusErrored
+	}
+	if err != nil {
+		result.Err = err.Error()
+		logger.MaybeWarningfContext(ctx, wa.Log, "workflow step %s %s: %v", step.Name, result.Status, err)
+		fmt.Fprintf(jio.StreamWriter(OutputStreamStderr), "==> step %s %s (%v): %v\n", step.Name, result.Status, result.Elapsed().Round(time.Millisecond), err)
+	} else {
+		fmt.Fprintf(jio.Output, "==> step %s %s (%v)\n", step.Name, result.Status, result.Elapsed().Round(time.Millisecond))
+	}
+	return
+}
+
+// invokeStep calls the step action, recovering panics as errors so later steps and
+// the step result are still recorded.
+func (wa WorkflowAction) invokeStep(ctx context.Context, step WorkflowStep) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = ex.New(r)
+		}
+	}()
+	if step.Action == nil {
+		return ex.New("workflow action; step action unset", ex.OptMessagef("step: %s", step.Name))
+	}
+	return step.Action(ctx)
+}
+
+// Workflow action errors.
+const (
+	ErrWorkflowStepTimeout ex.Class = "workflow step timed out"
+)
@@ -0,0 +1,47 @@
+package jobkit
+
+import (
+	"fmt"
+	"time"
+)
+
+// WorkflowStepConfig is a config for a step of a workflow action.
+//
+// Each step is exactly one of a shell action (exec or script), an http action or a sql action.
+type WorkflowStepConfig struct {
+	ShellActionConfig `yaml:",inline"`
+	// Name is the name of the step, defaulting to its position in the workflow.
+	Name string `yaml:"name"`
+	// Timeout is the timeout for the step, in addition to any timeout for the job.
+	Timeout *time.Duration `yaml:"timeout"`
+	// ContinueOnError lets the workflow continue with the next step if the step fails.
+	ContinueOnError *bool `yaml:"continueOnError"`
+	// HTTP is the http action for the step.
+	HTTP HTTPActionConfig `yaml:"http"`
+	// SQL is the sql action for the step.
+	SQL SQLActionConfig `yaml:"sql"`
+}
+
+// NameOrDefault returns a value or a default.
+func (wsc WorkflowStepConfig) NameOrDefault(index int) string {
+	if wsc.Name != "" {
+		return wsc.Name
+	}
+	return fmt.Sprintf("step %d", index+1)
+}
+
+// TimeoutOrDefault returns a value or a default.
+func (wsc WorkflowStepConfig) TimeoutOrDefault() time.Duration {
+	if wsc.Timeout != nil {
+		return *wsc.Timeout
+	}
+	return 0
+}
+
+// ContinueOnErrorOrDefault returns a value or a default.
+func (wsc WorkflowStepConfig) ContinueOnErrorOrDefault() bool {
+	if wsc.ContinueOnError != nil {
+		return *wsc.ContinueOnError
+	}
+	return DefaultWorkflowStepContinueOnError
+}
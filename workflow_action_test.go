@@ -0,0 +1,174 @@
+package jobkit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/ex"
+)
+
+func TestWorkflowAction(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, jio := createTestShellActionContext()
+	cron.GetJobInvocation(ctx).Parameters = cron.JobParameters{"NAME": "bailey"}
+
+	action := NewWorkflowAction(OptWorkflowActionSteps(
+		WorkflowStep{
+			Name:   "first",
+			Action: NewShellAction([]string{"echo", "${NAME}"}).Execute,
+		},
+		WorkflowStep{
+			Name: "second",
+			Action: func(ctx context.Context) error {
+				fmt.Fprintln(GetJobInvocationOutput(ctx).Output, "second output")
+				return nil
+			},
+		},
+	))
+	assert.Nil(action.Execute(ctx))
+	assert.False(jio.Skipped)
+
+	assert.Len(jio.Steps, 2)
+	assert.Equal("first", jio.Steps[0].Name)
+	assert.Equal(WorkflowStepStatusSuccess, jio.Steps[0].Status)
+	assert.Equal("bailey\n", jio.StepOutput(0))
+	assert.NotNil(jio.Steps[0].ExitInfo)
+	assert.NotZero(jio.Steps[0].Elapsed())
+	assert.Equal(WorkflowStepStatusSuccess, jio.Steps[1].Status)
+	assert.Equal("second output\n", jio.StepOutput(1))
+	assert.Nil(jio.Steps[1].ExitInfo)
+
+	assert.Contains(jio.Output.String(), "==> step 1/2: first")
+	assert.Contains(jio.Output.String(), "bailey\n")
+	assert.Contains(jio.Output.String(), "second output\n")
+}
+
+func TestWorkflowActionStepErrored(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, jio := createTestShellActionContext()
+
+	var ran bool
+	action := NewWorkflowAction(OptWorkflowActionSteps(
+		WorkflowStep{
+			Name:   "fails",
+			Action: func(_ context.Context) error { return fmt.Errorf("this is only a test") },
+		},
+		WorkflowStep{
+			Name:   "never",
+			Action: func(_ context.Context) error { ran = true; return nil },
+		},
+	))
+	err := action.Execute(ctx)
+	assert.NotNil(err)
+	assert.Equal("this is only a test", err.Error())
+	assert.False(ran)
+
+	assert.Equal(WorkflowStepStatusErrored, jio.Steps[0].Status)
+	assert.Equal("this is only a test", jio.Steps[0].Err)
+	assert.Equal(WorkflowStepStatusSkipped, jio.Steps[1].Status)
+	assert.True(jio.Steps[1].Started.IsZero())
+	assert.Equal(OutputStreamStderr, jio.OutputStreams.Last())
+}
+
+func TestWorkflowActionContinueOnError(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, jio := createTestShellActionContext()
+
+	var ran bool
+	action := NewWorkflowAction(OptWorkflowActionSteps(
+		WorkflowStep{
+			Name:            "fails",
+			ContinueOnError: true,
+			Action:          NewShellAction([]string{"false"}).Execute,
+		},
+		WorkflowStep{
+			Name:   "runs",
+			Action: func(_ context.Context) error { ran = true; return nil },
+		},
+	))
+	assert.Nil(action.Execute(ctx))
+	assert.True(ran)
+
+	assert.Equal(WorkflowStepStatusErrored, jio.Steps[0].Status)
+	assert.True(jio.Steps[0].ContinueOnError)
+	assert.Equal(1, jio.Steps[0].ExitInfo.ExitCode)
+	assert.Equal(WorkflowStepStatusSuccess, jio.Steps[1].Status)
+}
+
+func TestWorkflowActionStepTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, jio := createTestShellActionContext()
+
+	action := NewWorkflowAction(OptWorkflowActionSteps(
+		WorkflowStep{
+			Name:    "slow",
+			Timeout: 50 * time.Millisecond,
+			Action: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+		},
+	))
+	err := action.Execute(ctx)
+	assert.True(ex.Is(err, ErrWorkflowStepTimeout))
+	assert.Equal(WorkflowStepStatusErrored, jio.Steps[0].Status)
+}
+
+func TestWorkflowActionCancelled(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, jio := createTestShellActionContext()
+	ctx, cancel := context.WithCancel(ctx)
+
+	action := NewWorkflowAction(OptWorkflowActionSteps(
+		WorkflowStep{
+			Name:            "cancels",
+			ContinueOnError: true,
+			Action: func(ctx context.Context) error {
+				cancel()
+				return ctx.Err()
+			},
+		},
+		WorkflowStep{
+			Name:   "never",
+			Action: func(_ context.Context) error { return nil },
+		},
+	))
+	assert.NotNil(action.Execute(ctx))
+	assert.Equal(WorkflowStepStatusCancelled, jio.Steps[0].Status)
+	assert.Equal(WorkflowStepStatusSkipped, jio.Steps[1].Status)
+}
+
+func TestWorkflowActionSkipped(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, jio := createTestShellActionContext()
+
+	action := NewWorkflowAction(OptWorkflowActionSteps(
+		WorkflowStep{
+			Name: "skips",
+			Action: func(ctx context.Context) error {
+				GetJobInvocationOutput(ctx).Skipped = true
+				return nil
+			},
+		},
+	))
+	assert.Nil(action.Execute(ctx))
+	assert.True(jio.Skipped)
+	assert.Equal(WorkflowStepStatusSkipped, jio.Steps[0].Status)
+}
+
+func TestWorkflowActionStepsUnset(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, _ := createTestShellActionContext()
+	assert.NotNil(NewWorkflowAction().Execute(ctx))
+}